@@ -0,0 +1,95 @@
+// Command snowballgen generates Go stemmers from Snowball algorithm files.
+//
+// It reads every .sbl file of the input directory and writes, for each
+// file name.sbl, a file name.go with a stemmer type such as NameStemmer,
+// plus a stemmers.go file that maps the algorithm names to constructors.
+// It is meant to be run by go generate:
+//
+//	//go:generate go run github.com/machine23/ugu-stemmer/cmd/snowballgen -in . -pkg algorithms
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/machine23/ugu-stemmer/snowball/gogen"
+	"github.com/machine23/ugu-stemmer/snowball/sbl"
+)
+
+func main() {
+	in := flag.String("in", ".", "directory of the .sbl files")
+	out := flag.String("out", "", "output directory (default: the input directory)")
+	pkg := flag.String("pkg", "", "Go package name (default: the output directory name)")
+	runtime := flag.String("runtime", gogen.DefaultRuntime, "import path of the runtime package")
+	flag.Parse()
+
+	if *out == "" {
+		*out = *in
+	}
+	if *pkg == "" {
+		abs, err := filepath.Abs(*out)
+		if err != nil {
+			fail(err)
+		}
+		*pkg = filepath.Base(abs)
+	}
+
+	if err := run(*in, *out, *pkg, *runtime); err != nil {
+		fail(err)
+	}
+}
+
+func run(in, out, pkg, runtime string) error {
+	files, err := filepath.Glob(filepath.Join(in, "*.sbl"))
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return fmt.Errorf("no .sbl files in %s", in)
+	}
+	sort.Strings(files)
+	if err := os.MkdirAll(out, 0o755); err != nil {
+		return err
+	}
+
+	var names []string
+	for _, file := range files {
+		name := strings.TrimSuffix(filepath.Base(file), ".sbl")
+		src, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		prog, err := sbl.Parse(filepath.Base(file), src)
+		if err != nil {
+			return err
+		}
+		code, err := gogen.Generate(prog, gogen.Config{
+			Package: pkg,
+			Name:    name,
+			Source:  filepath.Base(file),
+			Runtime: runtime,
+		})
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(filepath.Join(out, name+".go"), code, 0o644); err != nil {
+			return err
+		}
+		names = append(names, name)
+	}
+
+	code, err := gogen.GenerateRegistry(pkg, names)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(out, "stemmers.go"), code, 0o644)
+}
+
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, "snowballgen:", err)
+	os.Exit(1)
+}
@@ -0,0 +1,92 @@
+package algorithms
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStemmers(t *testing.T) {
+	require.Len(t, Stemmers, 4)
+	for name, factory := range Stemmers {
+		require.NotNil(t, factory(), name)
+	}
+}
+
+func TestPorterStemmer_Stem(t *testing.T) {
+	s := NewPorterStemmer()
+
+	f := func(word, stem string) {
+		t.Helper()
+		require.Equal(t, stem, s.Stem(word))
+	}
+
+	f("caresses", "caress")
+	f("ponies", "poni")
+	f("agreed", "agre")
+	f("hopping", "hop")
+	f("relational", "relat")
+	f("generalization", "gener")
+	f("electrical", "electr")
+	f("adjustment", "adjust")
+	f("", "")
+}
+
+func TestEnglishStemmer_Stem(t *testing.T) {
+	s := NewEnglishStemmer()
+
+	f := func(word, stem string) {
+		t.Helper()
+		require.Equal(t, stem, s.Stem(word))
+	}
+
+	f("generously", "generous")
+	f("happiness", "happi")
+	f("conditional", "condit")
+	f("hopping", "hop")
+	f("sized", "size")
+	f("Agreed", "agre")
+	f("skies", "sky")
+	f("dying", "die")
+	f("news", "news")
+	f("succeeding", "succeed")
+	f("", "")
+}
+
+func TestRussianStemmer_Stem(t *testing.T) {
+	s := NewRussianStemmer()
+
+	f := func(word, stem string) {
+		t.Helper()
+		require.Equal(t, stem, s.Stem(word))
+	}
+
+	f("вечерний", "вечерн")
+	f("бегавшая", "бега")
+	f("ёлками", "елк")
+	f("красивейшими", "красив")
+	f("написанного", "написа")
+	f("образовательность", "образовательн")
+	f("умывшись", "ум")
+	f("", "")
+}
+
+func TestItalianStemmer_Stem(t *testing.T) {
+	s := NewItalianStemmer()
+
+	f := func(word, stem string) {
+		t.Helper()
+		require.Equal(t, stem, s.Stem(word))
+	}
+
+	f("mangiandolo", "mang")
+	f("parlargli", "parl")
+	f("dicendogliene", "dic")
+	f("dargli", "dargl")
+	f("abbandonata", "abbandon")
+	f("abitazione", "abit")
+	f("città", "citt")
+	f("amichevolmente", "amichevol")
+	f("Perché", "perc")
+	f("", "")
+}
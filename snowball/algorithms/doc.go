@@ -0,0 +1,6 @@
+// Package algorithms holds the stemmers generated from the Snowball
+// algorithm files of this directory. To add a language, drop its .sbl file
+// here and run go generate.
+package algorithms
+
+//go:generate go run ../../cmd/snowballgen -in . -pkg algorithms
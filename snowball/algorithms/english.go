@@ -0,0 +1,1041 @@
+// Code generated by snowballgen from english.sbl. DO NOT EDIT.
+
+package algorithms
+
+import (
+	"strings"
+
+	"github.com/machine23/ugu-stemmer/snowball"
+)
+
+// EnglishStemmer is the stemmer generated from english.sbl.
+type EnglishStemmer struct{}
+
+// NewEnglishStemmer creates a new EnglishStemmer.
+func NewEnglishStemmer() *EnglishStemmer {
+	return &EnglishStemmer{}
+}
+
+// Stem returns the stem of the given word.
+func (s EnglishStemmer) Stem(word string) string {
+	env := snowball.NewEnv(strings.ToLower(word))
+	english_r_stem(env, &englishContext{})
+	return env.Current()
+}
+
+// englishContext holds the variables of one run of the stemmer.
+type englishContext struct {
+	i_p1      int
+	i_p2      int
+	b_Y_found bool
+}
+
+var (
+	english_g_v        = snowball.NewGrouping("aeiouy")
+	english_g_v_WXY    = snowball.NewGrouping("aeiouywxY")
+	english_g_valid_LI = snowball.NewGrouping("cdeghkmnrt")
+	english_a_0        = snowball.NewAmong([]snowball.AmongEntry{
+		{S: "gener", Result: 1},
+		{S: "commun", Result: 1},
+		{S: "arsen", Result: 1},
+	})
+	english_a_1 = snowball.NewAmong([]snowball.AmongEntry{
+		{S: "'", Result: 1},
+		{S: "'s", Result: 1},
+		{S: "'s'", Result: 1},
+	})
+	english_a_2 = snowball.NewAmong([]snowball.AmongEntry{
+		{S: "sses", Result: 1},
+		{S: "ied", Result: 2},
+		{S: "ies", Result: 2},
+		{S: "s", Result: 3},
+		{S: "us", Result: 4},
+		{S: "ss", Result: 4},
+	})
+	english_a_3 = snowball.NewAmong([]snowball.AmongEntry{
+		{S: "eed", Result: 1},
+		{S: "eedly", Result: 1},
+		{S: "ed", Result: 2},
+		{S: "edly", Result: 2},
+		{S: "ing", Result: 2},
+		{S: "ingly", Result: 2},
+	})
+	english_a_4 = snowball.NewAmong([]snowball.AmongEntry{
+		{S: "at", Result: 1},
+		{S: "bl", Result: 1},
+		{S: "iz", Result: 1},
+		{S: "bb", Result: 2},
+		{S: "dd", Result: 2},
+		{S: "ff", Result: 2},
+		{S: "gg", Result: 2},
+		{S: "mm", Result: 2},
+		{S: "nn", Result: 2},
+		{S: "pp", Result: 2},
+		{S: "rr", Result: 2},
+		{S: "tt", Result: 2},
+		{S: "", Result: 3},
+	})
+	english_a_5 = snowball.NewAmong([]snowball.AmongEntry{
+		{S: "tional", Result: 1},
+		{S: "enci", Result: 2},
+		{S: "anci", Result: 3},
+		{S: "abli", Result: 4},
+		{S: "entli", Result: 5},
+		{S: "izer", Result: 6},
+		{S: "ization", Result: 6},
+		{S: "ational", Result: 7},
+		{S: "ation", Result: 7},
+		{S: "ator", Result: 7},
+		{S: "alism", Result: 8},
+		{S: "aliti", Result: 8},
+		{S: "alli", Result: 8},
+		{S: "fulness", Result: 9},
+		{S: "ousli", Result: 10},
+		{S: "ousness", Result: 10},
+		{S: "iveness", Result: 11},
+		{S: "iviti", Result: 11},
+		{S: "biliti", Result: 12},
+		{S: "bli", Result: 12},
+		{S: "ogi", Result: 13},
+		{S: "fulli", Result: 14},
+		{S: "lessli", Result: 15},
+		{S: "li", Result: 16},
+	})
+	english_a_6 = snowball.NewAmong([]snowball.AmongEntry{
+		{S: "tional", Result: 1},
+		{S: "ational", Result: 2},
+		{S: "alize", Result: 3},
+		{S: "icate", Result: 4},
+		{S: "iciti", Result: 4},
+		{S: "ical", Result: 4},
+		{S: "ful", Result: 5},
+		{S: "ness", Result: 5},
+		{S: "ative", Result: 6},
+	})
+	english_a_7 = snowball.NewAmong([]snowball.AmongEntry{
+		{S: "al", Result: 1},
+		{S: "ance", Result: 1},
+		{S: "ence", Result: 1},
+		{S: "er", Result: 1},
+		{S: "ic", Result: 1},
+		{S: "able", Result: 1},
+		{S: "ible", Result: 1},
+		{S: "ant", Result: 1},
+		{S: "ement", Result: 1},
+		{S: "ment", Result: 1},
+		{S: "ent", Result: 1},
+		{S: "ism", Result: 1},
+		{S: "ate", Result: 1},
+		{S: "iti", Result: 1},
+		{S: "ous", Result: 1},
+		{S: "ive", Result: 1},
+		{S: "ize", Result: 1},
+		{S: "ion", Result: 2},
+	})
+	english_a_8 = snowball.NewAmong([]snowball.AmongEntry{
+		{S: "e", Result: 1},
+		{S: "l", Result: 2},
+	})
+	english_a_9 = snowball.NewAmong([]snowball.AmongEntry{
+		{S: "inning", Result: 1},
+		{S: "outing", Result: 1},
+		{S: "canning", Result: 1},
+		{S: "herring", Result: 1},
+		{S: "earring", Result: 1},
+		{S: "proceed", Result: 1},
+		{S: "exceed", Result: 1},
+		{S: "succeed", Result: 1},
+	})
+	english_a_10 = snowball.NewAmong([]snowball.AmongEntry{
+		{S: "skis", Result: 1},
+		{S: "skies", Result: 2},
+		{S: "dying", Result: 3},
+		{S: "lying", Result: 4},
+		{S: "tying", Result: 5},
+		{S: "idly", Result: 6},
+		{S: "gently", Result: 7},
+		{S: "ugly", Result: 8},
+		{S: "early", Result: 9},
+		{S: "only", Result: 10},
+		{S: "singly", Result: 11},
+		{S: "sky", Result: 12},
+		{S: "news", Result: 12},
+		{S: "howe", Result: 12},
+		{S: "atlas", Result: 12},
+		{S: "cosmos", Result: 12},
+		{S: "bias", Result: 12},
+		{S: "andes", Result: 12},
+	})
+)
+
+func english_r_prelude(env *snowball.Env, ctx interface{}) bool {
+	context := ctx.(*englishContext)
+	context.b_Y_found = false
+	v1 := env.Cursor
+lab2:
+	for {
+		env.Bra = env.Cursor
+		if !env.EqS("'") {
+			break lab2
+		}
+		env.Ket = env.Cursor
+		if !env.SliceDel() {
+			break lab2
+		}
+		break lab2
+	}
+	env.Cursor = v1
+	v3 := env.Cursor
+lab4:
+	for {
+		env.Bra = env.Cursor
+		if !env.EqS("y") {
+			break lab4
+		}
+		env.Ket = env.Cursor
+		if !env.SliceFrom("Y") {
+			break lab4
+		}
+		context.b_Y_found = true
+		break lab4
+	}
+	env.Cursor = v3
+	v5 := env.Cursor
+lab7:
+	for {
+		v8 := env.Cursor
+	lab9:
+		for {
+			v10 := env.Cursor
+		lab11:
+			for {
+				if !env.InGrouping(english_g_v) {
+					env.Cursor = v10
+					break lab11
+				}
+				env.Bra = env.Cursor
+				if !env.EqS("y") {
+					env.Cursor = v10
+					break lab11
+				}
+				env.Ket = env.Cursor
+				env.Cursor = v10
+				break lab9
+			}
+			if !env.Next() {
+				env.Cursor = v8
+				break lab7
+			}
+		}
+		if !env.SliceFrom("Y") {
+			env.Cursor = v8
+			break lab7
+		}
+		context.b_Y_found = true
+	}
+	env.Cursor = v5
+	return true
+}
+
+func english_r_mark_regions(env *snowball.Env, ctx interface{}) bool {
+	context := ctx.(*englishContext)
+	var amongVar int
+	context.i_p1 = env.Limit
+	context.i_p2 = env.Limit
+	v12 := env.Cursor
+lab13:
+	for {
+	lab14:
+		for {
+			v15 := env.Cursor
+		lab16:
+			for {
+				amongVar = env.FindAmong(english_a_0, ctx)
+				if amongVar == 0 {
+					env.Cursor = v15
+					break lab16
+				}
+				break lab14
+			}
+		lab17:
+			for {
+				v18 := env.Cursor
+			lab19:
+				for {
+					if !env.InGrouping(english_g_v) {
+						env.Cursor = v18
+						break lab19
+					}
+					break lab17
+				}
+				if !env.Next() {
+					break lab13
+				}
+			}
+		lab20:
+			for {
+				v21 := env.Cursor
+			lab22:
+				for {
+					if !env.OutGrouping(english_g_v) {
+						env.Cursor = v21
+						break lab22
+					}
+					break lab20
+				}
+				if !env.Next() {
+					break lab13
+				}
+			}
+			break lab14
+		}
+		context.i_p1 = env.Cursor
+	lab23:
+		for {
+			v24 := env.Cursor
+		lab25:
+			for {
+				if !env.InGrouping(english_g_v) {
+					env.Cursor = v24
+					break lab25
+				}
+				break lab23
+			}
+			if !env.Next() {
+				break lab13
+			}
+		}
+	lab26:
+		for {
+			v27 := env.Cursor
+		lab28:
+			for {
+				if !env.OutGrouping(english_g_v) {
+					env.Cursor = v27
+					break lab28
+				}
+				break lab26
+			}
+			if !env.Next() {
+				break lab13
+			}
+		}
+		context.i_p2 = env.Cursor
+		break lab13
+	}
+	env.Cursor = v12
+	return true
+}
+
+func english_r_shortv(env *snowball.Env, ctx interface{}) bool {
+lab29:
+	for {
+		v30 := env.Limit - env.Cursor
+	lab31:
+		for {
+			if !env.OutGroupingB(english_g_v_WXY) {
+				env.Cursor = env.Limit - v30
+				break lab31
+			}
+			if !env.InGroupingB(english_g_v) {
+				env.Cursor = env.Limit - v30
+				break lab31
+			}
+			if !env.OutGroupingB(english_g_v) {
+				env.Cursor = env.Limit - v30
+				break lab31
+			}
+			break lab29
+		}
+		if !env.OutGroupingB(english_g_v) {
+			return false
+		}
+		if !env.InGroupingB(english_g_v) {
+			return false
+		}
+		if env.Cursor > env.LimitBackward {
+			return false
+		}
+		break lab29
+	}
+	return true
+}
+
+func english_r_R1(env *snowball.Env, ctx interface{}) bool {
+	context := ctx.(*englishContext)
+	if !(context.i_p1 <= env.Cursor) {
+		return false
+	}
+	return true
+}
+
+func english_r_R2(env *snowball.Env, ctx interface{}) bool {
+	context := ctx.(*englishContext)
+	if !(context.i_p2 <= env.Cursor) {
+		return false
+	}
+	return true
+}
+
+func english_r_Step_1a(env *snowball.Env, ctx interface{}) bool {
+	var amongVar int
+	v32 := env.Limit - env.Cursor
+lab33:
+	for {
+		env.Ket = env.Cursor
+		amongVar = env.FindAmongB(english_a_1, ctx)
+		if amongVar == 0 {
+			env.Cursor = env.Limit - v32
+			break lab33
+		}
+		env.Bra = env.Cursor
+		switch amongVar {
+		case 1:
+			if !env.SliceDel() {
+				env.Cursor = env.Limit - v32
+				break lab33
+			}
+		}
+		break lab33
+	}
+	env.Ket = env.Cursor
+	amongVar = env.FindAmongB(english_a_2, ctx)
+	if amongVar == 0 {
+		return false
+	}
+	env.Bra = env.Cursor
+	switch amongVar {
+	case 1:
+		if !env.SliceFrom("ss") {
+			return false
+		}
+	case 2:
+	lab34:
+		for {
+			v35 := env.Limit - env.Cursor
+		lab36:
+			for {
+				if !env.HopBack(2) {
+					env.Cursor = env.Limit - v35
+					break lab36
+				}
+				if !env.SliceFrom("i") {
+					env.Cursor = env.Limit - v35
+					break lab36
+				}
+				break lab34
+			}
+			if !env.SliceFrom("ie") {
+				return false
+			}
+			break lab34
+		}
+	case 3:
+		if !env.Prev() {
+			return false
+		}
+	lab37:
+		for {
+			v38 := env.Limit - env.Cursor
+		lab39:
+			for {
+				if !env.InGroupingB(english_g_v) {
+					env.Cursor = env.Limit - v38
+					break lab39
+				}
+				break lab37
+			}
+			if !env.Prev() {
+				return false
+			}
+		}
+		if !env.SliceDel() {
+			return false
+		}
+	}
+	return true
+}
+
+func english_r_Step_1b(env *snowball.Env, ctx interface{}) bool {
+	context := ctx.(*englishContext)
+	var amongVar int
+	env.Ket = env.Cursor
+	amongVar = env.FindAmongB(english_a_3, ctx)
+	if amongVar == 0 {
+		return false
+	}
+	env.Bra = env.Cursor
+	switch amongVar {
+	case 1:
+		if !english_r_R1(env, ctx) {
+			return false
+		}
+		if !env.SliceFrom("ee") {
+			return false
+		}
+	case 2:
+		v40 := env.Limit - env.Cursor
+	lab41:
+		for {
+			v42 := env.Limit - env.Cursor
+		lab43:
+			for {
+				if !env.InGroupingB(english_g_v) {
+					env.Cursor = env.Limit - v42
+					break lab43
+				}
+				break lab41
+			}
+			if !env.Prev() {
+				return false
+			}
+		}
+		env.Cursor = env.Limit - v40
+		if !env.SliceDel() {
+			return false
+		}
+		v44 := env.Limit - env.Cursor
+		amongVar = env.FindAmongB(english_a_4, ctx)
+		if amongVar == 0 {
+			return false
+		}
+		env.Cursor = env.Limit - v44
+		switch amongVar {
+		case 1:
+			c45 := env.Cursor
+			env.Insert(env.Cursor, env.Cursor, "e")
+			env.Cursor = c45
+		case 2:
+			env.Ket = env.Cursor
+			if !env.Prev() {
+				return false
+			}
+			env.Bra = env.Cursor
+			if !env.SliceDel() {
+				return false
+			}
+		case 3:
+			if env.Cursor != context.i_p1 {
+				return false
+			}
+			v46 := env.Limit - env.Cursor
+			if !english_r_shortv(env, ctx) {
+				return false
+			}
+			env.Cursor = env.Limit - v46
+			c47 := env.Cursor
+			env.Insert(env.Cursor, env.Cursor, "e")
+			env.Cursor = c47
+		}
+	}
+	return true
+}
+
+func english_r_Step_1c(env *snowball.Env, ctx interface{}) bool {
+	env.Ket = env.Cursor
+lab48:
+	for {
+		v49 := env.Limit - env.Cursor
+	lab50:
+		for {
+			if !env.EqSB("y") {
+				env.Cursor = env.Limit - v49
+				break lab50
+			}
+			break lab48
+		}
+		if !env.EqSB("Y") {
+			return false
+		}
+		break lab48
+	}
+	env.Bra = env.Cursor
+	if !env.OutGroupingB(english_g_v) {
+		return false
+	}
+	v51 := env.Limit - env.Cursor
+lab52:
+	for {
+		if env.Cursor > env.LimitBackward {
+			break lab52
+		}
+		return false
+	}
+	env.Cursor = env.Limit - v51
+	if !env.SliceFrom("i") {
+		return false
+	}
+	return true
+}
+
+func english_r_Step_2(env *snowball.Env, ctx interface{}) bool {
+	var amongVar int
+	env.Ket = env.Cursor
+	amongVar = env.FindAmongB(english_a_5, ctx)
+	if amongVar == 0 {
+		return false
+	}
+	env.Bra = env.Cursor
+	if !english_r_R1(env, ctx) {
+		return false
+	}
+	switch amongVar {
+	case 1:
+		if !env.SliceFrom("tion") {
+			return false
+		}
+	case 2:
+		if !env.SliceFrom("ence") {
+			return false
+		}
+	case 3:
+		if !env.SliceFrom("ance") {
+			return false
+		}
+	case 4:
+		if !env.SliceFrom("able") {
+			return false
+		}
+	case 5:
+		if !env.SliceFrom("ent") {
+			return false
+		}
+	case 6:
+		if !env.SliceFrom("ize") {
+			return false
+		}
+	case 7:
+		if !env.SliceFrom("ate") {
+			return false
+		}
+	case 8:
+		if !env.SliceFrom("al") {
+			return false
+		}
+	case 9:
+		if !env.SliceFrom("ful") {
+			return false
+		}
+	case 10:
+		if !env.SliceFrom("ous") {
+			return false
+		}
+	case 11:
+		if !env.SliceFrom("ive") {
+			return false
+		}
+	case 12:
+		if !env.SliceFrom("ble") {
+			return false
+		}
+	case 13:
+		if !env.EqSB("l") {
+			return false
+		}
+		if !env.SliceFrom("og") {
+			return false
+		}
+	case 14:
+		if !env.SliceFrom("ful") {
+			return false
+		}
+	case 15:
+		if !env.SliceFrom("less") {
+			return false
+		}
+	case 16:
+		if !env.InGroupingB(english_g_valid_LI) {
+			return false
+		}
+		if !env.SliceDel() {
+			return false
+		}
+	}
+	return true
+}
+
+func english_r_Step_3(env *snowball.Env, ctx interface{}) bool {
+	var amongVar int
+	env.Ket = env.Cursor
+	amongVar = env.FindAmongB(english_a_6, ctx)
+	if amongVar == 0 {
+		return false
+	}
+	env.Bra = env.Cursor
+	if !english_r_R1(env, ctx) {
+		return false
+	}
+	switch amongVar {
+	case 1:
+		if !env.SliceFrom("tion") {
+			return false
+		}
+	case 2:
+		if !env.SliceFrom("ate") {
+			return false
+		}
+	case 3:
+		if !env.SliceFrom("al") {
+			return false
+		}
+	case 4:
+		if !env.SliceFrom("ic") {
+			return false
+		}
+	case 5:
+		if !env.SliceDel() {
+			return false
+		}
+	case 6:
+		if !english_r_R2(env, ctx) {
+			return false
+		}
+		if !env.SliceDel() {
+			return false
+		}
+	}
+	return true
+}
+
+func english_r_Step_4(env *snowball.Env, ctx interface{}) bool {
+	var amongVar int
+	env.Ket = env.Cursor
+	amongVar = env.FindAmongB(english_a_7, ctx)
+	if amongVar == 0 {
+		return false
+	}
+	env.Bra = env.Cursor
+	if !english_r_R2(env, ctx) {
+		return false
+	}
+	switch amongVar {
+	case 1:
+		if !env.SliceDel() {
+			return false
+		}
+	case 2:
+	lab53:
+		for {
+			v54 := env.Limit - env.Cursor
+		lab55:
+			for {
+				if !env.EqSB("s") {
+					env.Cursor = env.Limit - v54
+					break lab55
+				}
+				break lab53
+			}
+			if !env.EqSB("t") {
+				return false
+			}
+			break lab53
+		}
+		if !env.SliceDel() {
+			return false
+		}
+	}
+	return true
+}
+
+func english_r_Step_5(env *snowball.Env, ctx interface{}) bool {
+	var amongVar int
+	env.Ket = env.Cursor
+	amongVar = env.FindAmongB(english_a_8, ctx)
+	if amongVar == 0 {
+		return false
+	}
+	env.Bra = env.Cursor
+	switch amongVar {
+	case 1:
+	lab56:
+		for {
+			v57 := env.Limit - env.Cursor
+		lab58:
+			for {
+				if !english_r_R2(env, ctx) {
+					env.Cursor = env.Limit - v57
+					break lab58
+				}
+				break lab56
+			}
+			if !english_r_R1(env, ctx) {
+				return false
+			}
+			v59 := env.Limit - env.Cursor
+		lab60:
+			for {
+				if !english_r_shortv(env, ctx) {
+					break lab60
+				}
+				return false
+			}
+			env.Cursor = env.Limit - v59
+			break lab56
+		}
+		if !env.SliceDel() {
+			return false
+		}
+	case 2:
+		if !english_r_R2(env, ctx) {
+			return false
+		}
+		if !env.EqSB("l") {
+			return false
+		}
+		if !env.SliceDel() {
+			return false
+		}
+	}
+	return true
+}
+
+func english_r_exception2(env *snowball.Env, ctx interface{}) bool {
+	var amongVar int
+	env.Ket = env.Cursor
+	amongVar = env.FindAmongB(english_a_9, ctx)
+	if amongVar == 0 {
+		return false
+	}
+	env.Bra = env.Cursor
+	if env.Cursor > env.LimitBackward {
+		return false
+	}
+	return true
+}
+
+func english_r_exception1(env *snowball.Env, ctx interface{}) bool {
+	var amongVar int
+	env.Bra = env.Cursor
+	amongVar = env.FindAmong(english_a_10, ctx)
+	if amongVar == 0 {
+		return false
+	}
+	env.Ket = env.Cursor
+	if env.Cursor < env.Limit {
+		return false
+	}
+	switch amongVar {
+	case 1:
+		if !env.SliceFrom("ski") {
+			return false
+		}
+	case 2:
+		if !env.SliceFrom("sky") {
+			return false
+		}
+	case 3:
+		if !env.SliceFrom("die") {
+			return false
+		}
+	case 4:
+		if !env.SliceFrom("lie") {
+			return false
+		}
+	case 5:
+		if !env.SliceFrom("tie") {
+			return false
+		}
+	case 6:
+		if !env.SliceFrom("idl") {
+			return false
+		}
+	case 7:
+		if !env.SliceFrom("gentl") {
+			return false
+		}
+	case 8:
+		if !env.SliceFrom("ugli") {
+			return false
+		}
+	case 9:
+		if !env.SliceFrom("earli") {
+			return false
+		}
+	case 10:
+		if !env.SliceFrom("onli") {
+			return false
+		}
+	case 11:
+		if !env.SliceFrom("singl") {
+			return false
+		}
+	}
+	return true
+}
+
+func english_r_postlude(env *snowball.Env, ctx interface{}) bool {
+	context := ctx.(*englishContext)
+	if !context.b_Y_found {
+		return false
+	}
+lab61:
+	for {
+		v62 := env.Cursor
+	lab63:
+		for {
+			v64 := env.Cursor
+		lab65:
+			for {
+				env.Bra = env.Cursor
+				if !env.EqS("Y") {
+					env.Cursor = v64
+					break lab65
+				}
+				env.Ket = env.Cursor
+				env.Cursor = v64
+				break lab63
+			}
+			if !env.Next() {
+				env.Cursor = v62
+				break lab61
+			}
+		}
+		if !env.SliceFrom("y") {
+			env.Cursor = v62
+			break lab61
+		}
+	}
+	return true
+}
+
+func english_r_stem(env *snowball.Env, ctx interface{}) bool {
+lab66:
+	for {
+		v67 := env.Cursor
+	lab68:
+		for {
+		lab69:
+			for {
+				v70 := env.Cursor
+			lab71:
+				for {
+					if !english_r_exception1(env, ctx) {
+						env.Cursor = v70
+						break lab71
+					}
+					break lab69
+				}
+				v72 := env.Cursor
+			lab73:
+				for {
+					if !env.Hop(3) {
+						break lab73
+					}
+					env.Cursor = v67
+					break lab68
+				}
+				env.Cursor = v72
+				break lab69
+			}
+			break lab66
+		}
+		v74 := env.Cursor
+	lab75:
+		for {
+			if !english_r_prelude(env, ctx) {
+				break lab75
+			}
+			break lab75
+		}
+		env.Cursor = v74
+		v76 := env.Cursor
+	lab77:
+		for {
+			if !english_r_mark_regions(env, ctx) {
+				break lab77
+			}
+			break lab77
+		}
+		env.Cursor = v76
+		env.LimitBackward = env.Cursor
+		env.Cursor = env.Limit
+		v78 := env.Limit - env.Cursor
+	lab79:
+		for {
+			if !english_r_Step_1a(env, ctx) {
+				break lab79
+			}
+			break lab79
+		}
+		env.Cursor = env.Limit - v78
+	lab80:
+		for {
+			v81 := env.Limit - env.Cursor
+		lab82:
+			for {
+				if !english_r_exception2(env, ctx) {
+					env.Cursor = env.Limit - v81
+					break lab82
+				}
+				break lab80
+			}
+			v83 := env.Limit - env.Cursor
+		lab84:
+			for {
+				if !english_r_Step_1b(env, ctx) {
+					break lab84
+				}
+				break lab84
+			}
+			env.Cursor = env.Limit - v83
+			v85 := env.Limit - env.Cursor
+		lab86:
+			for {
+				if !english_r_Step_1c(env, ctx) {
+					break lab86
+				}
+				break lab86
+			}
+			env.Cursor = env.Limit - v85
+			v87 := env.Limit - env.Cursor
+		lab88:
+			for {
+				if !english_r_Step_2(env, ctx) {
+					break lab88
+				}
+				break lab88
+			}
+			env.Cursor = env.Limit - v87
+			v89 := env.Limit - env.Cursor
+		lab90:
+			for {
+				if !english_r_Step_3(env, ctx) {
+					break lab90
+				}
+				break lab90
+			}
+			env.Cursor = env.Limit - v89
+			v91 := env.Limit - env.Cursor
+		lab92:
+			for {
+				if !english_r_Step_4(env, ctx) {
+					break lab92
+				}
+				break lab92
+			}
+			env.Cursor = env.Limit - v91
+			v93 := env.Limit - env.Cursor
+		lab94:
+			for {
+				if !english_r_Step_5(env, ctx) {
+					break lab94
+				}
+				break lab94
+			}
+			env.Cursor = env.Limit - v93
+			break lab80
+		}
+		env.Cursor = env.LimitBackward
+		v95 := env.Cursor
+	lab96:
+		for {
+			if !english_r_postlude(env, ctx) {
+				break lab96
+			}
+			break lab96
+		}
+		env.Cursor = v95
+		break lab66
+	}
+	return true
+}
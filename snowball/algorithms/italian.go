@@ -0,0 +1,981 @@
+// Code generated by snowballgen from italian.sbl. DO NOT EDIT.
+
+package algorithms
+
+import (
+	"strings"
+
+	"github.com/machine23/ugu-stemmer/snowball"
+)
+
+// ItalianStemmer is the stemmer generated from italian.sbl.
+type ItalianStemmer struct{}
+
+// NewItalianStemmer creates a new ItalianStemmer.
+func NewItalianStemmer() *ItalianStemmer {
+	return &ItalianStemmer{}
+}
+
+// Stem returns the stem of the given word.
+func (s ItalianStemmer) Stem(word string) string {
+	env := snowball.NewEnv(strings.ToLower(word))
+	italian_r_stem(env, &italianContext{})
+	return env.Current()
+}
+
+// italianContext holds the variables of one run of the stemmer.
+type italianContext struct {
+	i_pV int
+	i_p1 int
+	i_p2 int
+}
+
+var (
+	italian_g_v    = snowball.NewGrouping("aeiouàèìòù")
+	italian_g_AEIO = snowball.NewGrouping("aeioàèìò")
+	italian_g_CG   = snowball.NewGrouping("cg")
+	italian_a_0    = snowball.NewAmong([]snowball.AmongEntry{
+		{S: "á", Result: 1},
+		{S: "é", Result: 2},
+		{S: "í", Result: 3},
+		{S: "ó", Result: 4},
+		{S: "ú", Result: 5},
+		{S: "qu", Result: 6},
+		{S: "", Result: 7},
+	})
+	italian_a_1 = snowball.NewAmong([]snowball.AmongEntry{
+		{S: "I", Result: 1},
+		{S: "U", Result: 2},
+		{S: "", Result: 3},
+	})
+	italian_a_2 = snowball.NewAmong([]snowball.AmongEntry{
+		{S: "ci", Result: 1},
+		{S: "gli", Result: 1},
+		{S: "la", Result: 1},
+		{S: "le", Result: 1},
+		{S: "li", Result: 1},
+		{S: "lo", Result: 1},
+		{S: "mi", Result: 1},
+		{S: "ne", Result: 1},
+		{S: "si", Result: 1},
+		{S: "ti", Result: 1},
+		{S: "vi", Result: 1},
+		{S: "sene", Result: 1},
+		{S: "gliela", Result: 1},
+		{S: "gliele", Result: 1},
+		{S: "glieli", Result: 1},
+		{S: "glielo", Result: 1},
+		{S: "gliene", Result: 1},
+		{S: "mela", Result: 1},
+		{S: "mele", Result: 1},
+		{S: "meli", Result: 1},
+		{S: "melo", Result: 1},
+		{S: "mene", Result: 1},
+		{S: "tela", Result: 1},
+		{S: "tele", Result: 1},
+		{S: "teli", Result: 1},
+		{S: "telo", Result: 1},
+		{S: "tene", Result: 1},
+		{S: "cela", Result: 1},
+		{S: "cele", Result: 1},
+		{S: "celi", Result: 1},
+		{S: "celo", Result: 1},
+		{S: "cene", Result: 1},
+		{S: "vela", Result: 1},
+		{S: "vele", Result: 1},
+		{S: "veli", Result: 1},
+		{S: "velo", Result: 1},
+		{S: "vene", Result: 1},
+	})
+	italian_a_3 = snowball.NewAmong([]snowball.AmongEntry{
+		{S: "ando", Result: 1},
+		{S: "endo", Result: 1},
+		{S: "ar", Result: 2},
+		{S: "er", Result: 2},
+		{S: "ir", Result: 2},
+	})
+	italian_a_4 = snowball.NewAmong([]snowball.AmongEntry{
+		{S: "anza", Result: 1},
+		{S: "anze", Result: 1},
+		{S: "ico", Result: 1},
+		{S: "ici", Result: 1},
+		{S: "ica", Result: 1},
+		{S: "ice", Result: 1},
+		{S: "iche", Result: 1},
+		{S: "ichi", Result: 1},
+		{S: "ismo", Result: 1},
+		{S: "ismi", Result: 1},
+		{S: "abile", Result: 1},
+		{S: "abili", Result: 1},
+		{S: "ibile", Result: 1},
+		{S: "ibili", Result: 1},
+		{S: "ista", Result: 1},
+		{S: "iste", Result: 1},
+		{S: "isti", Result: 1},
+		{S: "istà", Result: 1},
+		{S: "istè", Result: 1},
+		{S: "istì", Result: 1},
+		{S: "oso", Result: 1},
+		{S: "osi", Result: 1},
+		{S: "osa", Result: 1},
+		{S: "ose", Result: 1},
+		{S: "mente", Result: 1},
+		{S: "atrice", Result: 1},
+		{S: "atrici", Result: 1},
+		{S: "ante", Result: 1},
+		{S: "anti", Result: 1},
+		{S: "azione", Result: 2},
+		{S: "azioni", Result: 2},
+		{S: "atore", Result: 2},
+		{S: "atori", Result: 2},
+		{S: "logia", Result: 3},
+		{S: "logie", Result: 3},
+		{S: "uzione", Result: 4},
+		{S: "uzioni", Result: 4},
+		{S: "usione", Result: 4},
+		{S: "usioni", Result: 4},
+		{S: "enza", Result: 5},
+		{S: "enze", Result: 5},
+		{S: "amento", Result: 6},
+		{S: "amenti", Result: 6},
+		{S: "imento", Result: 6},
+		{S: "imenti", Result: 6},
+		{S: "amente", Result: 7},
+		{S: "ità", Result: 8},
+		{S: "ivo", Result: 9},
+		{S: "ivi", Result: 9},
+		{S: "iva", Result: 9},
+		{S: "ive", Result: 9},
+	})
+	italian_a_5 = snowball.NewAmong([]snowball.AmongEntry{
+		{S: "iv", Result: 1},
+		{S: "os", Result: 2},
+		{S: "ic", Result: 2},
+		{S: "abil", Result: 2},
+	})
+	italian_a_6 = snowball.NewAmong([]snowball.AmongEntry{
+		{S: "abil", Result: 1},
+		{S: "ic", Result: 1},
+		{S: "iv", Result: 1},
+	})
+	italian_a_7 = snowball.NewAmong([]snowball.AmongEntry{
+		{S: "ammo", Result: 1},
+		{S: "ando", Result: 1},
+		{S: "ano", Result: 1},
+		{S: "are", Result: 1},
+		{S: "arono", Result: 1},
+		{S: "asse", Result: 1},
+		{S: "assero", Result: 1},
+		{S: "assi", Result: 1},
+		{S: "assimo", Result: 1},
+		{S: "ata", Result: 1},
+		{S: "ate", Result: 1},
+		{S: "ati", Result: 1},
+		{S: "ato", Result: 1},
+		{S: "ava", Result: 1},
+		{S: "avamo", Result: 1},
+		{S: "avano", Result: 1},
+		{S: "avate", Result: 1},
+		{S: "avi", Result: 1},
+		{S: "avo", Result: 1},
+		{S: "emmo", Result: 1},
+		{S: "enda", Result: 1},
+		{S: "ende", Result: 1},
+		{S: "endi", Result: 1},
+		{S: "endo", Result: 1},
+		{S: "erà", Result: 1},
+		{S: "erai", Result: 1},
+		{S: "eranno", Result: 1},
+		{S: "ere", Result: 1},
+		{S: "erebbe", Result: 1},
+		{S: "erebbero", Result: 1},
+		{S: "erei", Result: 1},
+		{S: "eremmo", Result: 1},
+		{S: "eremo", Result: 1},
+		{S: "ereste", Result: 1},
+		{S: "eresti", Result: 1},
+		{S: "erete", Result: 1},
+		{S: "erò", Result: 1},
+		{S: "erono", Result: 1},
+		{S: "essero", Result: 1},
+		{S: "ete", Result: 1},
+		{S: "eva", Result: 1},
+		{S: "evamo", Result: 1},
+		{S: "evano", Result: 1},
+		{S: "evate", Result: 1},
+		{S: "evi", Result: 1},
+		{S: "evo", Result: 1},
+		{S: "Yamo", Result: 1},
+		{S: "iamo", Result: 1},
+		{S: "immo", Result: 1},
+		{S: "irà", Result: 1},
+		{S: "irai", Result: 1},
+		{S: "iranno", Result: 1},
+		{S: "ire", Result: 1},
+		{S: "irebbe", Result: 1},
+		{S: "irebbero", Result: 1},
+		{S: "irei", Result: 1},
+		{S: "iremmo", Result: 1},
+		{S: "iremo", Result: 1},
+		{S: "ireste", Result: 1},
+		{S: "iresti", Result: 1},
+		{S: "irete", Result: 1},
+		{S: "irò", Result: 1},
+		{S: "irono", Result: 1},
+		{S: "isca", Result: 1},
+		{S: "iscano", Result: 1},
+		{S: "isce", Result: 1},
+		{S: "isci", Result: 1},
+		{S: "isco", Result: 1},
+		{S: "iscono", Result: 1},
+		{S: "issero", Result: 1},
+		{S: "ita", Result: 1},
+		{S: "ite", Result: 1},
+		{S: "iti", Result: 1},
+		{S: "ito", Result: 1},
+		{S: "iva", Result: 1},
+		{S: "ivamo", Result: 1},
+		{S: "ivano", Result: 1},
+		{S: "ivate", Result: 1},
+		{S: "ivi", Result: 1},
+		{S: "ivo", Result: 1},
+		{S: "ono", Result: 1},
+		{S: "uta", Result: 1},
+		{S: "ute", Result: 1},
+		{S: "uti", Result: 1},
+		{S: "uto", Result: 1},
+		{S: "ar", Result: 1},
+		{S: "ir", Result: 1},
+	})
+)
+
+func italian_r_prelude(env *snowball.Env, ctx interface{}) bool {
+	var amongVar int
+	v1 := env.Cursor
+lab2:
+	for {
+		v3 := env.Cursor
+		env.Bra = env.Cursor
+		amongVar = env.FindAmong(italian_a_0, ctx)
+		if amongVar == 0 {
+			env.Cursor = v3
+			break lab2
+		}
+		env.Ket = env.Cursor
+		switch amongVar {
+		case 1:
+			if !env.SliceFrom("à") {
+				env.Cursor = v3
+				break lab2
+			}
+		case 2:
+			if !env.SliceFrom("è") {
+				env.Cursor = v3
+				break lab2
+			}
+		case 3:
+			if !env.SliceFrom("ì") {
+				env.Cursor = v3
+				break lab2
+			}
+		case 4:
+			if !env.SliceFrom("ò") {
+				env.Cursor = v3
+				break lab2
+			}
+		case 5:
+			if !env.SliceFrom("ù") {
+				env.Cursor = v3
+				break lab2
+			}
+		case 6:
+			if !env.SliceFrom("qU") {
+				env.Cursor = v3
+				break lab2
+			}
+		case 7:
+			if !env.Next() {
+				env.Cursor = v3
+				break lab2
+			}
+		}
+	}
+	env.Cursor = v1
+lab4:
+	for {
+		v5 := env.Cursor
+	lab6:
+		for {
+			v7 := env.Cursor
+		lab8:
+			for {
+				if !env.InGrouping(italian_g_v) {
+					env.Cursor = v7
+					break lab8
+				}
+				env.Bra = env.Cursor
+			lab9:
+				for {
+					v10 := env.Cursor
+				lab11:
+					for {
+						if !env.EqS("u") {
+							env.Cursor = v10
+							break lab11
+						}
+						env.Ket = env.Cursor
+						if !env.InGrouping(italian_g_v) {
+							env.Cursor = v10
+							break lab11
+						}
+						if !env.SliceFrom("U") {
+							env.Cursor = v10
+							break lab11
+						}
+						break lab9
+					}
+					if !env.EqS("i") {
+						env.Cursor = v7
+						break lab8
+					}
+					env.Ket = env.Cursor
+					if !env.InGrouping(italian_g_v) {
+						env.Cursor = v7
+						break lab8
+					}
+					if !env.SliceFrom("I") {
+						env.Cursor = v7
+						break lab8
+					}
+					break lab9
+				}
+				env.Cursor = v7
+				break lab6
+			}
+			if !env.Next() {
+				env.Cursor = v5
+				break lab4
+			}
+		}
+	}
+	return true
+}
+
+func italian_r_mark_regions(env *snowball.Env, ctx interface{}) bool {
+	context := ctx.(*italianContext)
+	context.i_pV = env.Limit
+	context.i_p1 = env.Limit
+	context.i_p2 = env.Limit
+	v12 := env.Cursor
+lab13:
+	for {
+	lab14:
+		for {
+			v15 := env.Cursor
+		lab16:
+			for {
+				if !env.InGrouping(italian_g_v) {
+					env.Cursor = v15
+					break lab16
+				}
+			lab17:
+				for {
+					v18 := env.Cursor
+				lab19:
+					for {
+						if !env.OutGrouping(italian_g_v) {
+							env.Cursor = v18
+							break lab19
+						}
+					lab20:
+						for {
+							v21 := env.Cursor
+						lab22:
+							for {
+								if !env.InGrouping(italian_g_v) {
+									env.Cursor = v21
+									break lab22
+								}
+								break lab20
+							}
+							if !env.Next() {
+								env.Cursor = v18
+								break lab19
+							}
+						}
+						break lab17
+					}
+					if !env.InGrouping(italian_g_v) {
+						env.Cursor = v15
+						break lab16
+					}
+				lab23:
+					for {
+						v24 := env.Cursor
+					lab25:
+						for {
+							if !env.OutGrouping(italian_g_v) {
+								env.Cursor = v24
+								break lab25
+							}
+							break lab23
+						}
+						if !env.Next() {
+							env.Cursor = v15
+							break lab16
+						}
+					}
+					break lab17
+				}
+				break lab14
+			}
+			if !env.OutGrouping(italian_g_v) {
+				break lab13
+			}
+		lab26:
+			for {
+				v27 := env.Cursor
+			lab28:
+				for {
+					if !env.OutGrouping(italian_g_v) {
+						env.Cursor = v27
+						break lab28
+					}
+				lab29:
+					for {
+						v30 := env.Cursor
+					lab31:
+						for {
+							if !env.InGrouping(italian_g_v) {
+								env.Cursor = v30
+								break lab31
+							}
+							break lab29
+						}
+						if !env.Next() {
+							env.Cursor = v27
+							break lab28
+						}
+					}
+					break lab26
+				}
+				if !env.InGrouping(italian_g_v) {
+					break lab13
+				}
+				if !env.Next() {
+					break lab13
+				}
+				break lab26
+			}
+			break lab14
+		}
+		context.i_pV = env.Cursor
+		break lab13
+	}
+	env.Cursor = v12
+	v32 := env.Cursor
+lab33:
+	for {
+	lab34:
+		for {
+			v35 := env.Cursor
+		lab36:
+			for {
+				if !env.InGrouping(italian_g_v) {
+					env.Cursor = v35
+					break lab36
+				}
+				break lab34
+			}
+			if !env.Next() {
+				break lab33
+			}
+		}
+	lab37:
+		for {
+			v38 := env.Cursor
+		lab39:
+			for {
+				if !env.OutGrouping(italian_g_v) {
+					env.Cursor = v38
+					break lab39
+				}
+				break lab37
+			}
+			if !env.Next() {
+				break lab33
+			}
+		}
+		context.i_p1 = env.Cursor
+	lab40:
+		for {
+			v41 := env.Cursor
+		lab42:
+			for {
+				if !env.InGrouping(italian_g_v) {
+					env.Cursor = v41
+					break lab42
+				}
+				break lab40
+			}
+			if !env.Next() {
+				break lab33
+			}
+		}
+	lab43:
+		for {
+			v44 := env.Cursor
+		lab45:
+			for {
+				if !env.OutGrouping(italian_g_v) {
+					env.Cursor = v44
+					break lab45
+				}
+				break lab43
+			}
+			if !env.Next() {
+				break lab33
+			}
+		}
+		context.i_p2 = env.Cursor
+		break lab33
+	}
+	env.Cursor = v32
+	return true
+}
+
+func italian_r_postlude(env *snowball.Env, ctx interface{}) bool {
+	var amongVar int
+lab46:
+	for {
+		v47 := env.Cursor
+		env.Bra = env.Cursor
+		amongVar = env.FindAmong(italian_a_1, ctx)
+		if amongVar == 0 {
+			env.Cursor = v47
+			break lab46
+		}
+		env.Ket = env.Cursor
+		switch amongVar {
+		case 1:
+			if !env.SliceFrom("i") {
+				env.Cursor = v47
+				break lab46
+			}
+		case 2:
+			if !env.SliceFrom("u") {
+				env.Cursor = v47
+				break lab46
+			}
+		case 3:
+			if !env.Next() {
+				env.Cursor = v47
+				break lab46
+			}
+		}
+	}
+	return true
+}
+
+func italian_r_RV(env *snowball.Env, ctx interface{}) bool {
+	context := ctx.(*italianContext)
+	if !(context.i_pV <= env.Cursor) {
+		return false
+	}
+	return true
+}
+
+func italian_r_R1(env *snowball.Env, ctx interface{}) bool {
+	context := ctx.(*italianContext)
+	if !(context.i_p1 <= env.Cursor) {
+		return false
+	}
+	return true
+}
+
+func italian_r_R2(env *snowball.Env, ctx interface{}) bool {
+	context := ctx.(*italianContext)
+	if !(context.i_p2 <= env.Cursor) {
+		return false
+	}
+	return true
+}
+
+func italian_r_attached_pronoun(env *snowball.Env, ctx interface{}) bool {
+	var amongVar int
+	env.Ket = env.Cursor
+	amongVar = env.FindAmongB(italian_a_2, ctx)
+	if amongVar == 0 {
+		return false
+	}
+	env.Bra = env.Cursor
+	amongVar = env.FindAmongB(italian_a_3, ctx)
+	if amongVar == 0 {
+		return false
+	}
+	if !italian_r_RV(env, ctx) {
+		return false
+	}
+	switch amongVar {
+	case 1:
+		if !env.SliceDel() {
+			return false
+		}
+	case 2:
+		if !env.SliceFrom("e") {
+			return false
+		}
+	}
+	return true
+}
+
+func italian_r_standard_suffix(env *snowball.Env, ctx interface{}) bool {
+	var amongVar int
+	env.Ket = env.Cursor
+	amongVar = env.FindAmongB(italian_a_4, ctx)
+	if amongVar == 0 {
+		return false
+	}
+	env.Bra = env.Cursor
+	switch amongVar {
+	case 1:
+		if !italian_r_R2(env, ctx) {
+			return false
+		}
+		if !env.SliceDel() {
+			return false
+		}
+	case 2:
+		if !italian_r_R2(env, ctx) {
+			return false
+		}
+		if !env.SliceDel() {
+			return false
+		}
+		v48 := env.Limit - env.Cursor
+	lab49:
+		for {
+			env.Ket = env.Cursor
+			if !env.EqSB("ic") {
+				env.Cursor = env.Limit - v48
+				break lab49
+			}
+			env.Bra = env.Cursor
+			if !italian_r_R2(env, ctx) {
+				env.Cursor = env.Limit - v48
+				break lab49
+			}
+			if !env.SliceDel() {
+				env.Cursor = env.Limit - v48
+				break lab49
+			}
+			break lab49
+		}
+	case 3:
+		if !italian_r_R2(env, ctx) {
+			return false
+		}
+		if !env.SliceFrom("log") {
+			return false
+		}
+	case 4:
+		if !italian_r_R2(env, ctx) {
+			return false
+		}
+		if !env.SliceFrom("u") {
+			return false
+		}
+	case 5:
+		if !italian_r_R2(env, ctx) {
+			return false
+		}
+		if !env.SliceFrom("ente") {
+			return false
+		}
+	case 6:
+		if !italian_r_RV(env, ctx) {
+			return false
+		}
+		if !env.SliceDel() {
+			return false
+		}
+	case 7:
+		if !italian_r_R1(env, ctx) {
+			return false
+		}
+		if !env.SliceDel() {
+			return false
+		}
+		v50 := env.Limit - env.Cursor
+	lab51:
+		for {
+			env.Ket = env.Cursor
+			amongVar = env.FindAmongB(italian_a_5, ctx)
+			if amongVar == 0 {
+				env.Cursor = env.Limit - v50
+				break lab51
+			}
+			env.Bra = env.Cursor
+			if !italian_r_R2(env, ctx) {
+				env.Cursor = env.Limit - v50
+				break lab51
+			}
+			if !env.SliceDel() {
+				env.Cursor = env.Limit - v50
+				break lab51
+			}
+			switch amongVar {
+			case 1:
+				env.Ket = env.Cursor
+				if !env.EqSB("at") {
+					env.Cursor = env.Limit - v50
+					break lab51
+				}
+				env.Bra = env.Cursor
+				if !italian_r_R2(env, ctx) {
+					env.Cursor = env.Limit - v50
+					break lab51
+				}
+				if !env.SliceDel() {
+					env.Cursor = env.Limit - v50
+					break lab51
+				}
+			}
+			break lab51
+		}
+	case 8:
+		if !italian_r_R2(env, ctx) {
+			return false
+		}
+		if !env.SliceDel() {
+			return false
+		}
+		v52 := env.Limit - env.Cursor
+	lab53:
+		for {
+			env.Ket = env.Cursor
+			amongVar = env.FindAmongB(italian_a_6, ctx)
+			if amongVar == 0 {
+				env.Cursor = env.Limit - v52
+				break lab53
+			}
+			env.Bra = env.Cursor
+			switch amongVar {
+			case 1:
+				if !italian_r_R2(env, ctx) {
+					env.Cursor = env.Limit - v52
+					break lab53
+				}
+				if !env.SliceDel() {
+					env.Cursor = env.Limit - v52
+					break lab53
+				}
+			}
+			break lab53
+		}
+	case 9:
+		if !italian_r_R2(env, ctx) {
+			return false
+		}
+		if !env.SliceDel() {
+			return false
+		}
+		v54 := env.Limit - env.Cursor
+	lab55:
+		for {
+			env.Ket = env.Cursor
+			if !env.EqSB("at") {
+				env.Cursor = env.Limit - v54
+				break lab55
+			}
+			env.Bra = env.Cursor
+			if !italian_r_R2(env, ctx) {
+				env.Cursor = env.Limit - v54
+				break lab55
+			}
+			if !env.SliceDel() {
+				env.Cursor = env.Limit - v54
+				break lab55
+			}
+			env.Ket = env.Cursor
+			if !env.EqSB("ic") {
+				env.Cursor = env.Limit - v54
+				break lab55
+			}
+			env.Bra = env.Cursor
+			if !italian_r_R2(env, ctx) {
+				env.Cursor = env.Limit - v54
+				break lab55
+			}
+			if !env.SliceDel() {
+				env.Cursor = env.Limit - v54
+				break lab55
+			}
+			break lab55
+		}
+	}
+	return true
+}
+
+func italian_r_verb_suffix(env *snowball.Env, ctx interface{}) bool {
+	context := ctx.(*italianContext)
+	var amongVar int
+	v56 := env.Limit - env.Cursor
+	if env.Cursor < context.i_pV {
+		return false
+	}
+	env.Cursor = context.i_pV
+	limit57 := env.LimitBackward
+	env.LimitBackward = env.Cursor
+	env.Cursor = env.Limit - v56
+	env.Ket = env.Cursor
+	amongVar = env.FindAmongB(italian_a_7, ctx)
+	if amongVar == 0 {
+		env.LimitBackward = limit57
+		return false
+	}
+	env.Bra = env.Cursor
+	switch amongVar {
+	case 1:
+		if !env.SliceDel() {
+			env.LimitBackward = limit57
+			return false
+		}
+	}
+	env.LimitBackward = limit57
+	return true
+}
+
+func italian_r_vowel_suffix(env *snowball.Env, ctx interface{}) bool {
+	v58 := env.Limit - env.Cursor
+lab59:
+	for {
+		env.Ket = env.Cursor
+		if !env.InGroupingB(italian_g_AEIO) {
+			env.Cursor = env.Limit - v58
+			break lab59
+		}
+		env.Bra = env.Cursor
+		if !italian_r_RV(env, ctx) {
+			env.Cursor = env.Limit - v58
+			break lab59
+		}
+		if !env.SliceDel() {
+			env.Cursor = env.Limit - v58
+			break lab59
+		}
+		env.Ket = env.Cursor
+		if !env.EqSB("i") {
+			env.Cursor = env.Limit - v58
+			break lab59
+		}
+		env.Bra = env.Cursor
+		if !italian_r_RV(env, ctx) {
+			env.Cursor = env.Limit - v58
+			break lab59
+		}
+		if !env.SliceDel() {
+			env.Cursor = env.Limit - v58
+			break lab59
+		}
+		break lab59
+	}
+	v60 := env.Limit - env.Cursor
+lab61:
+	for {
+		env.Ket = env.Cursor
+		if !env.EqSB("h") {
+			env.Cursor = env.Limit - v60
+			break lab61
+		}
+		env.Bra = env.Cursor
+		if !env.InGroupingB(italian_g_CG) {
+			env.Cursor = env.Limit - v60
+			break lab61
+		}
+		if !italian_r_RV(env, ctx) {
+			env.Cursor = env.Limit - v60
+			break lab61
+		}
+		if !env.SliceDel() {
+			env.Cursor = env.Limit - v60
+			break lab61
+		}
+		break lab61
+	}
+	return true
+}
+
+func italian_r_stem(env *snowball.Env, ctx interface{}) bool {
+	v62 := env.Cursor
+lab63:
+	for {
+		if !italian_r_prelude(env, ctx) {
+			break lab63
+		}
+		break lab63
+	}
+	env.Cursor = v62
+	v64 := env.Cursor
+lab65:
+	for {
+		if !italian_r_mark_regions(env, ctx) {
+			break lab65
+		}
+		break lab65
+	}
+	env.Cursor = v64
+	env.LimitBackward = env.Cursor
+	env.Cursor = env.Limit
+	v66 := env.Limit - env.Cursor
+lab67:
+	for {
+		if !italian_r_attached_pronoun(env, ctx) {
+			break lab67
+		}
+		break lab67
+	}
+	env.Cursor = env.Limit - v66
+	v68 := env.Limit - env.Cursor
+lab69:
+	for {
+	lab70:
+		for {
+			v71 := env.Limit - env.Cursor
+		lab72:
+			for {
+				if !italian_r_standard_suffix(env, ctx) {
+					env.Cursor = env.Limit - v71
+					break lab72
+				}
+				break lab70
+			}
+			if !italian_r_verb_suffix(env, ctx) {
+				break lab69
+			}
+			break lab70
+		}
+		break lab69
+	}
+	env.Cursor = env.Limit - v68
+	v73 := env.Limit - env.Cursor
+lab74:
+	for {
+		if !italian_r_vowel_suffix(env, ctx) {
+			break lab74
+		}
+		break lab74
+	}
+	env.Cursor = env.Limit - v73
+	env.Cursor = env.LimitBackward
+	v75 := env.Cursor
+lab76:
+	for {
+		if !italian_r_postlude(env, ctx) {
+			break lab76
+		}
+		break lab76
+	}
+	env.Cursor = v75
+	return true
+}
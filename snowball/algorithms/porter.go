@@ -0,0 +1,714 @@
+// Code generated by snowballgen from porter.sbl. DO NOT EDIT.
+
+package algorithms
+
+import (
+	"strings"
+
+	"github.com/machine23/ugu-stemmer/snowball"
+)
+
+// PorterStemmer is the stemmer generated from porter.sbl.
+type PorterStemmer struct{}
+
+// NewPorterStemmer creates a new PorterStemmer.
+func NewPorterStemmer() *PorterStemmer {
+	return &PorterStemmer{}
+}
+
+// Stem returns the stem of the given word.
+func (s PorterStemmer) Stem(word string) string {
+	env := snowball.NewEnv(strings.ToLower(word))
+	porter_r_stem(env, &porterContext{})
+	return env.Current()
+}
+
+// porterContext holds the variables of one run of the stemmer.
+type porterContext struct {
+	i_p1      int
+	i_p2      int
+	b_Y_found bool
+}
+
+var (
+	porter_g_v     = snowball.NewGrouping("aeiouy")
+	porter_g_v_WXY = snowball.NewGrouping("aeiouywxY")
+	porter_a_0     = snowball.NewAmong([]snowball.AmongEntry{
+		{S: "sses", Result: 1},
+		{S: "ies", Result: 2},
+		{S: "ss", Result: 3},
+		{S: "s", Result: 4},
+	})
+	porter_a_1 = snowball.NewAmong([]snowball.AmongEntry{
+		{S: "eed", Result: 1},
+		{S: "ed", Result: 2},
+		{S: "ing", Result: 2},
+	})
+	porter_a_2 = snowball.NewAmong([]snowball.AmongEntry{
+		{S: "at", Result: 1},
+		{S: "bl", Result: 1},
+		{S: "iz", Result: 1},
+		{S: "bb", Result: 2},
+		{S: "dd", Result: 2},
+		{S: "ff", Result: 2},
+		{S: "gg", Result: 2},
+		{S: "mm", Result: 2},
+		{S: "nn", Result: 2},
+		{S: "pp", Result: 2},
+		{S: "rr", Result: 2},
+		{S: "tt", Result: 2},
+		{S: "", Result: 3},
+	})
+	porter_a_3 = snowball.NewAmong([]snowball.AmongEntry{
+		{S: "tional", Result: 1},
+		{S: "enci", Result: 2},
+		{S: "anci", Result: 3},
+		{S: "abli", Result: 4},
+		{S: "entli", Result: 5},
+		{S: "eli", Result: 6},
+		{S: "izer", Result: 7},
+		{S: "ization", Result: 7},
+		{S: "ational", Result: 8},
+		{S: "ation", Result: 8},
+		{S: "ator", Result: 8},
+		{S: "alli", Result: 9},
+		{S: "alism", Result: 10},
+		{S: "aliti", Result: 10},
+		{S: "fulness", Result: 11},
+		{S: "ousli", Result: 12},
+		{S: "ousness", Result: 12},
+		{S: "iveness", Result: 13},
+		{S: "iviti", Result: 13},
+		{S: "biliti", Result: 14},
+	})
+	porter_a_4 = snowball.NewAmong([]snowball.AmongEntry{
+		{S: "alize", Result: 1},
+		{S: "icate", Result: 2},
+		{S: "iciti", Result: 2},
+		{S: "ical", Result: 2},
+		{S: "ative", Result: 3},
+		{S: "ful", Result: 3},
+		{S: "ness", Result: 3},
+	})
+	porter_a_5 = snowball.NewAmong([]snowball.AmongEntry{
+		{S: "al", Result: 1},
+		{S: "ance", Result: 1},
+		{S: "ence", Result: 1},
+		{S: "er", Result: 1},
+		{S: "ic", Result: 1},
+		{S: "able", Result: 1},
+		{S: "ible", Result: 1},
+		{S: "ant", Result: 1},
+		{S: "ement", Result: 1},
+		{S: "ment", Result: 1},
+		{S: "ent", Result: 1},
+		{S: "ou", Result: 1},
+		{S: "ism", Result: 1},
+		{S: "ate", Result: 1},
+		{S: "iti", Result: 1},
+		{S: "ous", Result: 1},
+		{S: "ive", Result: 1},
+		{S: "ize", Result: 1},
+		{S: "ion", Result: 2},
+	})
+)
+
+func porter_r_shortv(env *snowball.Env, ctx interface{}) bool {
+	if !env.OutGroupingB(porter_g_v_WXY) {
+		return false
+	}
+	if !env.InGroupingB(porter_g_v) {
+		return false
+	}
+	if !env.OutGroupingB(porter_g_v) {
+		return false
+	}
+	return true
+}
+
+func porter_r_R1(env *snowball.Env, ctx interface{}) bool {
+	context := ctx.(*porterContext)
+	if !(context.i_p1 <= env.Cursor) {
+		return false
+	}
+	return true
+}
+
+func porter_r_R2(env *snowball.Env, ctx interface{}) bool {
+	context := ctx.(*porterContext)
+	if !(context.i_p2 <= env.Cursor) {
+		return false
+	}
+	return true
+}
+
+func porter_r_Step_1a(env *snowball.Env, ctx interface{}) bool {
+	var amongVar int
+	env.Ket = env.Cursor
+	amongVar = env.FindAmongB(porter_a_0, ctx)
+	if amongVar == 0 {
+		return false
+	}
+	env.Bra = env.Cursor
+	switch amongVar {
+	case 1:
+		if !env.SliceFrom("ss") {
+			return false
+		}
+	case 2:
+		if !env.SliceFrom("i") {
+			return false
+		}
+	case 4:
+		if !env.SliceDel() {
+			return false
+		}
+	}
+	return true
+}
+
+func porter_r_Step_1b(env *snowball.Env, ctx interface{}) bool {
+	context := ctx.(*porterContext)
+	var amongVar int
+	env.Ket = env.Cursor
+	amongVar = env.FindAmongB(porter_a_1, ctx)
+	if amongVar == 0 {
+		return false
+	}
+	env.Bra = env.Cursor
+	switch amongVar {
+	case 1:
+		if !porter_r_R1(env, ctx) {
+			return false
+		}
+		if !env.SliceFrom("ee") {
+			return false
+		}
+	case 2:
+		v1 := env.Limit - env.Cursor
+	lab2:
+		for {
+			v3 := env.Limit - env.Cursor
+		lab4:
+			for {
+				if !env.InGroupingB(porter_g_v) {
+					env.Cursor = env.Limit - v3
+					break lab4
+				}
+				break lab2
+			}
+			if !env.Prev() {
+				return false
+			}
+		}
+		env.Cursor = env.Limit - v1
+		if !env.SliceDel() {
+			return false
+		}
+		v5 := env.Limit - env.Cursor
+		amongVar = env.FindAmongB(porter_a_2, ctx)
+		if amongVar == 0 {
+			return false
+		}
+		env.Cursor = env.Limit - v5
+		switch amongVar {
+		case 1:
+			c6 := env.Cursor
+			env.Insert(env.Cursor, env.Cursor, "e")
+			env.Cursor = c6
+		case 2:
+			env.Ket = env.Cursor
+			if !env.Prev() {
+				return false
+			}
+			env.Bra = env.Cursor
+			if !env.SliceDel() {
+				return false
+			}
+		case 3:
+			if env.Cursor != context.i_p1 {
+				return false
+			}
+			v7 := env.Limit - env.Cursor
+			if !porter_r_shortv(env, ctx) {
+				return false
+			}
+			env.Cursor = env.Limit - v7
+			c8 := env.Cursor
+			env.Insert(env.Cursor, env.Cursor, "e")
+			env.Cursor = c8
+		}
+	}
+	return true
+}
+
+func porter_r_Step_1c(env *snowball.Env, ctx interface{}) bool {
+	env.Ket = env.Cursor
+lab9:
+	for {
+		v10 := env.Limit - env.Cursor
+	lab11:
+		for {
+			if !env.EqSB("y") {
+				env.Cursor = env.Limit - v10
+				break lab11
+			}
+			break lab9
+		}
+		if !env.EqSB("Y") {
+			return false
+		}
+		break lab9
+	}
+	env.Bra = env.Cursor
+lab12:
+	for {
+		v13 := env.Limit - env.Cursor
+	lab14:
+		for {
+			if !env.InGroupingB(porter_g_v) {
+				env.Cursor = env.Limit - v13
+				break lab14
+			}
+			break lab12
+		}
+		if !env.Prev() {
+			return false
+		}
+	}
+	if !env.SliceFrom("i") {
+		return false
+	}
+	return true
+}
+
+func porter_r_Step_2(env *snowball.Env, ctx interface{}) bool {
+	var amongVar int
+	env.Ket = env.Cursor
+	amongVar = env.FindAmongB(porter_a_3, ctx)
+	if amongVar == 0 {
+		return false
+	}
+	env.Bra = env.Cursor
+	if !porter_r_R1(env, ctx) {
+		return false
+	}
+	switch amongVar {
+	case 1:
+		if !env.SliceFrom("tion") {
+			return false
+		}
+	case 2:
+		if !env.SliceFrom("ence") {
+			return false
+		}
+	case 3:
+		if !env.SliceFrom("ance") {
+			return false
+		}
+	case 4:
+		if !env.SliceFrom("able") {
+			return false
+		}
+	case 5:
+		if !env.SliceFrom("ent") {
+			return false
+		}
+	case 6:
+		if !env.SliceFrom("e") {
+			return false
+		}
+	case 7:
+		if !env.SliceFrom("ize") {
+			return false
+		}
+	case 8:
+		if !env.SliceFrom("ate") {
+			return false
+		}
+	case 9:
+		if !env.SliceFrom("al") {
+			return false
+		}
+	case 10:
+		if !env.SliceFrom("al") {
+			return false
+		}
+	case 11:
+		if !env.SliceFrom("ful") {
+			return false
+		}
+	case 12:
+		if !env.SliceFrom("ous") {
+			return false
+		}
+	case 13:
+		if !env.SliceFrom("ive") {
+			return false
+		}
+	case 14:
+		if !env.SliceFrom("ble") {
+			return false
+		}
+	}
+	return true
+}
+
+func porter_r_Step_3(env *snowball.Env, ctx interface{}) bool {
+	var amongVar int
+	env.Ket = env.Cursor
+	amongVar = env.FindAmongB(porter_a_4, ctx)
+	if amongVar == 0 {
+		return false
+	}
+	env.Bra = env.Cursor
+	if !porter_r_R1(env, ctx) {
+		return false
+	}
+	switch amongVar {
+	case 1:
+		if !env.SliceFrom("al") {
+			return false
+		}
+	case 2:
+		if !env.SliceFrom("ic") {
+			return false
+		}
+	case 3:
+		if !env.SliceDel() {
+			return false
+		}
+	}
+	return true
+}
+
+func porter_r_Step_4(env *snowball.Env, ctx interface{}) bool {
+	var amongVar int
+	env.Ket = env.Cursor
+	amongVar = env.FindAmongB(porter_a_5, ctx)
+	if amongVar == 0 {
+		return false
+	}
+	env.Bra = env.Cursor
+	if !porter_r_R2(env, ctx) {
+		return false
+	}
+	switch amongVar {
+	case 1:
+		if !env.SliceDel() {
+			return false
+		}
+	case 2:
+	lab15:
+		for {
+			v16 := env.Limit - env.Cursor
+		lab17:
+			for {
+				if !env.EqSB("s") {
+					env.Cursor = env.Limit - v16
+					break lab17
+				}
+				break lab15
+			}
+			if !env.EqSB("t") {
+				return false
+			}
+			break lab15
+		}
+		if !env.SliceDel() {
+			return false
+		}
+	}
+	return true
+}
+
+func porter_r_Step_5a(env *snowball.Env, ctx interface{}) bool {
+	env.Ket = env.Cursor
+	if !env.EqSB("e") {
+		return false
+	}
+	env.Bra = env.Cursor
+lab18:
+	for {
+		v19 := env.Limit - env.Cursor
+	lab20:
+		for {
+			if !porter_r_R2(env, ctx) {
+				env.Cursor = env.Limit - v19
+				break lab20
+			}
+			break lab18
+		}
+		if !porter_r_R1(env, ctx) {
+			return false
+		}
+		v21 := env.Limit - env.Cursor
+	lab22:
+		for {
+			if !porter_r_shortv(env, ctx) {
+				break lab22
+			}
+			return false
+		}
+		env.Cursor = env.Limit - v21
+		break lab18
+	}
+	if !env.SliceDel() {
+		return false
+	}
+	return true
+}
+
+func porter_r_Step_5b(env *snowball.Env, ctx interface{}) bool {
+	env.Ket = env.Cursor
+	if !env.EqSB("l") {
+		return false
+	}
+	env.Bra = env.Cursor
+	if !porter_r_R2(env, ctx) {
+		return false
+	}
+	if !env.EqSB("l") {
+		return false
+	}
+	if !env.SliceDel() {
+		return false
+	}
+	return true
+}
+
+func porter_r_stem(env *snowball.Env, ctx interface{}) bool {
+	context := ctx.(*porterContext)
+	context.b_Y_found = false
+	v23 := env.Cursor
+lab24:
+	for {
+		env.Bra = env.Cursor
+		if !env.EqS("y") {
+			break lab24
+		}
+		env.Ket = env.Cursor
+		if !env.SliceFrom("Y") {
+			break lab24
+		}
+		context.b_Y_found = true
+		break lab24
+	}
+	env.Cursor = v23
+	v25 := env.Cursor
+lab27:
+	for {
+		v28 := env.Cursor
+	lab29:
+		for {
+			v30 := env.Cursor
+		lab31:
+			for {
+				if !env.InGrouping(porter_g_v) {
+					env.Cursor = v30
+					break lab31
+				}
+				env.Bra = env.Cursor
+				if !env.EqS("y") {
+					env.Cursor = v30
+					break lab31
+				}
+				env.Ket = env.Cursor
+				env.Cursor = v30
+				break lab29
+			}
+			if !env.Next() {
+				env.Cursor = v28
+				break lab27
+			}
+		}
+		if !env.SliceFrom("Y") {
+			env.Cursor = v28
+			break lab27
+		}
+		context.b_Y_found = true
+	}
+	env.Cursor = v25
+	context.i_p1 = env.Limit
+	context.i_p2 = env.Limit
+	v32 := env.Cursor
+lab33:
+	for {
+	lab34:
+		for {
+			v35 := env.Cursor
+		lab36:
+			for {
+				if !env.InGrouping(porter_g_v) {
+					env.Cursor = v35
+					break lab36
+				}
+				break lab34
+			}
+			if !env.Next() {
+				break lab33
+			}
+		}
+	lab37:
+		for {
+			v38 := env.Cursor
+		lab39:
+			for {
+				if !env.OutGrouping(porter_g_v) {
+					env.Cursor = v38
+					break lab39
+				}
+				break lab37
+			}
+			if !env.Next() {
+				break lab33
+			}
+		}
+		context.i_p1 = env.Cursor
+	lab40:
+		for {
+			v41 := env.Cursor
+		lab42:
+			for {
+				if !env.InGrouping(porter_g_v) {
+					env.Cursor = v41
+					break lab42
+				}
+				break lab40
+			}
+			if !env.Next() {
+				break lab33
+			}
+		}
+	lab43:
+		for {
+			v44 := env.Cursor
+		lab45:
+			for {
+				if !env.OutGrouping(porter_g_v) {
+					env.Cursor = v44
+					break lab45
+				}
+				break lab43
+			}
+			if !env.Next() {
+				break lab33
+			}
+		}
+		context.i_p2 = env.Cursor
+		break lab33
+	}
+	env.Cursor = v32
+	env.LimitBackward = env.Cursor
+	env.Cursor = env.Limit
+	v46 := env.Limit - env.Cursor
+lab47:
+	for {
+		if !porter_r_Step_1a(env, ctx) {
+			break lab47
+		}
+		break lab47
+	}
+	env.Cursor = env.Limit - v46
+	v48 := env.Limit - env.Cursor
+lab49:
+	for {
+		if !porter_r_Step_1b(env, ctx) {
+			break lab49
+		}
+		break lab49
+	}
+	env.Cursor = env.Limit - v48
+	v50 := env.Limit - env.Cursor
+lab51:
+	for {
+		if !porter_r_Step_1c(env, ctx) {
+			break lab51
+		}
+		break lab51
+	}
+	env.Cursor = env.Limit - v50
+	v52 := env.Limit - env.Cursor
+lab53:
+	for {
+		if !porter_r_Step_2(env, ctx) {
+			break lab53
+		}
+		break lab53
+	}
+	env.Cursor = env.Limit - v52
+	v54 := env.Limit - env.Cursor
+lab55:
+	for {
+		if !porter_r_Step_3(env, ctx) {
+			break lab55
+		}
+		break lab55
+	}
+	env.Cursor = env.Limit - v54
+	v56 := env.Limit - env.Cursor
+lab57:
+	for {
+		if !porter_r_Step_4(env, ctx) {
+			break lab57
+		}
+		break lab57
+	}
+	env.Cursor = env.Limit - v56
+	v58 := env.Limit - env.Cursor
+lab59:
+	for {
+		if !porter_r_Step_5a(env, ctx) {
+			break lab59
+		}
+		break lab59
+	}
+	env.Cursor = env.Limit - v58
+	v60 := env.Limit - env.Cursor
+lab61:
+	for {
+		if !porter_r_Step_5b(env, ctx) {
+			break lab61
+		}
+		break lab61
+	}
+	env.Cursor = env.Limit - v60
+	env.Cursor = env.LimitBackward
+	v62 := env.Cursor
+lab63:
+	for {
+		if !context.b_Y_found {
+			break lab63
+		}
+	lab64:
+		for {
+			v65 := env.Cursor
+		lab66:
+			for {
+				v67 := env.Cursor
+			lab68:
+				for {
+					env.Bra = env.Cursor
+					if !env.EqS("Y") {
+						env.Cursor = v67
+						break lab68
+					}
+					env.Ket = env.Cursor
+					env.Cursor = v67
+					break lab66
+				}
+				if !env.Next() {
+					env.Cursor = v65
+					break lab64
+				}
+			}
+			if !env.SliceFrom("y") {
+				env.Cursor = v65
+				break lab64
+			}
+		}
+		break lab63
+	}
+	env.Cursor = v62
+	return true
+}
@@ -0,0 +1,644 @@
+// Code generated by snowballgen from russian.sbl. DO NOT EDIT.
+
+package algorithms
+
+import (
+	"strings"
+
+	"github.com/machine23/ugu-stemmer/snowball"
+)
+
+// RussianStemmer is the stemmer generated from russian.sbl.
+type RussianStemmer struct{}
+
+// NewRussianStemmer creates a new RussianStemmer.
+func NewRussianStemmer() *RussianStemmer {
+	return &RussianStemmer{}
+}
+
+// Stem returns the stem of the given word.
+func (s RussianStemmer) Stem(word string) string {
+	env := snowball.NewEnv(strings.ToLower(word))
+	russian_r_stem(env, &russianContext{})
+	return env.Current()
+}
+
+// russianContext holds the variables of one run of the stemmer.
+type russianContext struct {
+	i_pV int
+	i_p2 int
+}
+
+var (
+	russian_g_v = snowball.NewGrouping("аеиоуыэюя")
+	russian_a_0 = snowball.NewAmong([]snowball.AmongEntry{
+		{S: "в", Result: 1},
+		{S: "вши", Result: 1},
+		{S: "вшись", Result: 1},
+		{S: "ив", Result: 2},
+		{S: "ивши", Result: 2},
+		{S: "ившись", Result: 2},
+		{S: "ыв", Result: 2},
+		{S: "ывши", Result: 2},
+		{S: "ывшись", Result: 2},
+	})
+	russian_a_1 = snowball.NewAmong([]snowball.AmongEntry{
+		{S: "ее", Result: 1},
+		{S: "ие", Result: 1},
+		{S: "ые", Result: 1},
+		{S: "ое", Result: 1},
+		{S: "ими", Result: 1},
+		{S: "ыми", Result: 1},
+		{S: "ей", Result: 1},
+		{S: "ий", Result: 1},
+		{S: "ый", Result: 1},
+		{S: "ой", Result: 1},
+		{S: "ем", Result: 1},
+		{S: "им", Result: 1},
+		{S: "ым", Result: 1},
+		{S: "ом", Result: 1},
+		{S: "его", Result: 1},
+		{S: "ого", Result: 1},
+		{S: "ему", Result: 1},
+		{S: "ому", Result: 1},
+		{S: "их", Result: 1},
+		{S: "ых", Result: 1},
+		{S: "ую", Result: 1},
+		{S: "юю", Result: 1},
+		{S: "ая", Result: 1},
+		{S: "яя", Result: 1},
+		{S: "ою", Result: 1},
+		{S: "ею", Result: 1},
+	})
+	russian_a_2 = snowball.NewAmong([]snowball.AmongEntry{
+		{S: "ем", Result: 1},
+		{S: "нн", Result: 1},
+		{S: "вш", Result: 1},
+		{S: "ющ", Result: 1},
+		{S: "щ", Result: 1},
+		{S: "ивш", Result: 2},
+		{S: "ывш", Result: 2},
+		{S: "ующ", Result: 2},
+	})
+	russian_a_3 = snowball.NewAmong([]snowball.AmongEntry{
+		{S: "ся", Result: 1},
+		{S: "сь", Result: 1},
+	})
+	russian_a_4 = snowball.NewAmong([]snowball.AmongEntry{
+		{S: "ла", Result: 1},
+		{S: "на", Result: 1},
+		{S: "ете", Result: 1},
+		{S: "йте", Result: 1},
+		{S: "ли", Result: 1},
+		{S: "й", Result: 1},
+		{S: "л", Result: 1},
+		{S: "ем", Result: 1},
+		{S: "н", Result: 1},
+		{S: "ло", Result: 1},
+		{S: "но", Result: 1},
+		{S: "ет", Result: 1},
+		{S: "ют", Result: 1},
+		{S: "ны", Result: 1},
+		{S: "ть", Result: 1},
+		{S: "ешь", Result: 1},
+		{S: "нно", Result: 1},
+		{S: "ила", Result: 2},
+		{S: "ыла", Result: 2},
+		{S: "ена", Result: 2},
+		{S: "ейте", Result: 2},
+		{S: "уйте", Result: 2},
+		{S: "ите", Result: 2},
+		{S: "или", Result: 2},
+		{S: "ыли", Result: 2},
+		{S: "ей", Result: 2},
+		{S: "уй", Result: 2},
+		{S: "ил", Result: 2},
+		{S: "ыл", Result: 2},
+		{S: "им", Result: 2},
+		{S: "ым", Result: 2},
+		{S: "ен", Result: 2},
+		{S: "ило", Result: 2},
+		{S: "ыло", Result: 2},
+		{S: "ено", Result: 2},
+		{S: "ят", Result: 2},
+		{S: "ует", Result: 2},
+		{S: "уют", Result: 2},
+		{S: "ит", Result: 2},
+		{S: "ыт", Result: 2},
+		{S: "ены", Result: 2},
+		{S: "ить", Result: 2},
+		{S: "ыть", Result: 2},
+		{S: "ишь", Result: 2},
+		{S: "ую", Result: 2},
+		{S: "ю", Result: 2},
+	})
+	russian_a_5 = snowball.NewAmong([]snowball.AmongEntry{
+		{S: "а", Result: 1},
+		{S: "ев", Result: 1},
+		{S: "ов", Result: 1},
+		{S: "ие", Result: 1},
+		{S: "ье", Result: 1},
+		{S: "е", Result: 1},
+		{S: "иями", Result: 1},
+		{S: "ями", Result: 1},
+		{S: "ами", Result: 1},
+		{S: "еи", Result: 1},
+		{S: "ии", Result: 1},
+		{S: "и", Result: 1},
+		{S: "ией", Result: 1},
+		{S: "ей", Result: 1},
+		{S: "ой", Result: 1},
+		{S: "ий", Result: 1},
+		{S: "й", Result: 1},
+		{S: "иям", Result: 1},
+		{S: "ям", Result: 1},
+		{S: "ием", Result: 1},
+		{S: "ем", Result: 1},
+		{S: "ам", Result: 1},
+		{S: "ом", Result: 1},
+		{S: "о", Result: 1},
+		{S: "у", Result: 1},
+		{S: "ах", Result: 1},
+		{S: "иях", Result: 1},
+		{S: "ях", Result: 1},
+		{S: "ы", Result: 1},
+		{S: "ь", Result: 1},
+		{S: "ию", Result: 1},
+		{S: "ью", Result: 1},
+		{S: "ю", Result: 1},
+		{S: "ия", Result: 1},
+		{S: "ья", Result: 1},
+		{S: "я", Result: 1},
+	})
+	russian_a_6 = snowball.NewAmong([]snowball.AmongEntry{
+		{S: "ост", Result: 1},
+		{S: "ость", Result: 1},
+	})
+	russian_a_7 = snowball.NewAmong([]snowball.AmongEntry{
+		{S: "ейш", Result: 1},
+		{S: "ейше", Result: 1},
+		{S: "н", Result: 2},
+		{S: "ь", Result: 3},
+	})
+)
+
+func russian_r_mark_regions(env *snowball.Env, ctx interface{}) bool {
+	context := ctx.(*russianContext)
+	context.i_pV = env.Limit
+	context.i_p2 = env.Limit
+	v1 := env.Cursor
+lab2:
+	for {
+	lab3:
+		for {
+			v4 := env.Cursor
+		lab5:
+			for {
+				if !env.InGrouping(russian_g_v) {
+					env.Cursor = v4
+					break lab5
+				}
+				break lab3
+			}
+			if !env.Next() {
+				break lab2
+			}
+		}
+		context.i_pV = env.Cursor
+	lab6:
+		for {
+			v7 := env.Cursor
+		lab8:
+			for {
+				if !env.OutGrouping(russian_g_v) {
+					env.Cursor = v7
+					break lab8
+				}
+				break lab6
+			}
+			if !env.Next() {
+				break lab2
+			}
+		}
+	lab9:
+		for {
+			v10 := env.Cursor
+		lab11:
+			for {
+				if !env.InGrouping(russian_g_v) {
+					env.Cursor = v10
+					break lab11
+				}
+				break lab9
+			}
+			if !env.Next() {
+				break lab2
+			}
+		}
+	lab12:
+		for {
+			v13 := env.Cursor
+		lab14:
+			for {
+				if !env.OutGrouping(russian_g_v) {
+					env.Cursor = v13
+					break lab14
+				}
+				break lab12
+			}
+			if !env.Next() {
+				break lab2
+			}
+		}
+		context.i_p2 = env.Cursor
+		break lab2
+	}
+	env.Cursor = v1
+	return true
+}
+
+func russian_r_R2(env *snowball.Env, ctx interface{}) bool {
+	context := ctx.(*russianContext)
+	if !(context.i_p2 <= env.Cursor) {
+		return false
+	}
+	return true
+}
+
+func russian_r_perfective_gerund(env *snowball.Env, ctx interface{}) bool {
+	var amongVar int
+	env.Ket = env.Cursor
+	amongVar = env.FindAmongB(russian_a_0, ctx)
+	if amongVar == 0 {
+		return false
+	}
+	env.Bra = env.Cursor
+	switch amongVar {
+	case 1:
+	lab15:
+		for {
+			v16 := env.Limit - env.Cursor
+		lab17:
+			for {
+				if !env.EqSB("а") {
+					env.Cursor = env.Limit - v16
+					break lab17
+				}
+				break lab15
+			}
+			if !env.EqSB("я") {
+				return false
+			}
+			break lab15
+		}
+		if !env.SliceDel() {
+			return false
+		}
+	case 2:
+		if !env.SliceDel() {
+			return false
+		}
+	}
+	return true
+}
+
+func russian_r_adjective(env *snowball.Env, ctx interface{}) bool {
+	var amongVar int
+	env.Ket = env.Cursor
+	amongVar = env.FindAmongB(russian_a_1, ctx)
+	if amongVar == 0 {
+		return false
+	}
+	env.Bra = env.Cursor
+	switch amongVar {
+	case 1:
+		if !env.SliceDel() {
+			return false
+		}
+	}
+	return true
+}
+
+func russian_r_adjectival(env *snowball.Env, ctx interface{}) bool {
+	var amongVar int
+	if !russian_r_adjective(env, ctx) {
+		return false
+	}
+	v18 := env.Limit - env.Cursor
+lab19:
+	for {
+		env.Ket = env.Cursor
+		amongVar = env.FindAmongB(russian_a_2, ctx)
+		if amongVar == 0 {
+			env.Cursor = env.Limit - v18
+			break lab19
+		}
+		env.Bra = env.Cursor
+		switch amongVar {
+		case 1:
+		lab20:
+			for {
+				v21 := env.Limit - env.Cursor
+			lab22:
+				for {
+					if !env.EqSB("а") {
+						env.Cursor = env.Limit - v21
+						break lab22
+					}
+					break lab20
+				}
+				if !env.EqSB("я") {
+					env.Cursor = env.Limit - v18
+					break lab19
+				}
+				break lab20
+			}
+			if !env.SliceDel() {
+				env.Cursor = env.Limit - v18
+				break lab19
+			}
+		case 2:
+			if !env.SliceDel() {
+				env.Cursor = env.Limit - v18
+				break lab19
+			}
+		}
+		break lab19
+	}
+	return true
+}
+
+func russian_r_reflexive(env *snowball.Env, ctx interface{}) bool {
+	var amongVar int
+	env.Ket = env.Cursor
+	amongVar = env.FindAmongB(russian_a_3, ctx)
+	if amongVar == 0 {
+		return false
+	}
+	env.Bra = env.Cursor
+	switch amongVar {
+	case 1:
+		if !env.SliceDel() {
+			return false
+		}
+	}
+	return true
+}
+
+func russian_r_verb(env *snowball.Env, ctx interface{}) bool {
+	var amongVar int
+	env.Ket = env.Cursor
+	amongVar = env.FindAmongB(russian_a_4, ctx)
+	if amongVar == 0 {
+		return false
+	}
+	env.Bra = env.Cursor
+	switch amongVar {
+	case 1:
+	lab23:
+		for {
+			v24 := env.Limit - env.Cursor
+		lab25:
+			for {
+				if !env.EqSB("а") {
+					env.Cursor = env.Limit - v24
+					break lab25
+				}
+				break lab23
+			}
+			if !env.EqSB("я") {
+				return false
+			}
+			break lab23
+		}
+		if !env.SliceDel() {
+			return false
+		}
+	case 2:
+		if !env.SliceDel() {
+			return false
+		}
+	}
+	return true
+}
+
+func russian_r_noun(env *snowball.Env, ctx interface{}) bool {
+	var amongVar int
+	env.Ket = env.Cursor
+	amongVar = env.FindAmongB(russian_a_5, ctx)
+	if amongVar == 0 {
+		return false
+	}
+	env.Bra = env.Cursor
+	switch amongVar {
+	case 1:
+		if !env.SliceDel() {
+			return false
+		}
+	}
+	return true
+}
+
+func russian_r_derivational(env *snowball.Env, ctx interface{}) bool {
+	var amongVar int
+	env.Ket = env.Cursor
+	amongVar = env.FindAmongB(russian_a_6, ctx)
+	if amongVar == 0 {
+		return false
+	}
+	env.Bra = env.Cursor
+	if !russian_r_R2(env, ctx) {
+		return false
+	}
+	switch amongVar {
+	case 1:
+		if !env.SliceDel() {
+			return false
+		}
+	}
+	return true
+}
+
+func russian_r_tidy_up(env *snowball.Env, ctx interface{}) bool {
+	var amongVar int
+	env.Ket = env.Cursor
+	amongVar = env.FindAmongB(russian_a_7, ctx)
+	if amongVar == 0 {
+		return false
+	}
+	env.Bra = env.Cursor
+	switch amongVar {
+	case 1:
+		if !env.SliceDel() {
+			return false
+		}
+		env.Ket = env.Cursor
+		if !env.EqSB("н") {
+			return false
+		}
+		env.Bra = env.Cursor
+		if !env.EqSB("н") {
+			return false
+		}
+		if !env.SliceDel() {
+			return false
+		}
+	case 2:
+		if !env.EqSB("н") {
+			return false
+		}
+		if !env.SliceDel() {
+			return false
+		}
+	case 3:
+		if !env.SliceDel() {
+			return false
+		}
+	}
+	return true
+}
+
+func russian_r_stem(env *snowball.Env, ctx interface{}) bool {
+	context := ctx.(*russianContext)
+	v26 := env.Cursor
+lab28:
+	for {
+		v29 := env.Cursor
+	lab30:
+		for {
+			v31 := env.Cursor
+		lab32:
+			for {
+				env.Bra = env.Cursor
+				if !env.EqS("ё") {
+					env.Cursor = v31
+					break lab32
+				}
+				env.Ket = env.Cursor
+				env.Cursor = v31
+				break lab30
+			}
+			if !env.Next() {
+				env.Cursor = v29
+				break lab28
+			}
+		}
+		if !env.SliceFrom("е") {
+			env.Cursor = v29
+			break lab28
+		}
+	}
+	env.Cursor = v26
+	v33 := env.Cursor
+lab34:
+	for {
+		if !russian_r_mark_regions(env, ctx) {
+			break lab34
+		}
+		break lab34
+	}
+	env.Cursor = v33
+	env.LimitBackward = env.Cursor
+	env.Cursor = env.Limit
+	v35 := env.Limit - env.Cursor
+	if env.Cursor < context.i_pV {
+		return false
+	}
+	env.Cursor = context.i_pV
+	limit36 := env.LimitBackward
+	env.LimitBackward = env.Cursor
+	env.Cursor = env.Limit - v35
+	v37 := env.Limit - env.Cursor
+lab38:
+	for {
+	lab39:
+		for {
+			v40 := env.Limit - env.Cursor
+		lab41:
+			for {
+				if !russian_r_perfective_gerund(env, ctx) {
+					env.Cursor = env.Limit - v40
+					break lab41
+				}
+				break lab39
+			}
+			v42 := env.Limit - env.Cursor
+		lab43:
+			for {
+				if !russian_r_reflexive(env, ctx) {
+					env.Cursor = env.Limit - v42
+					break lab43
+				}
+				break lab43
+			}
+		lab44:
+			for {
+				v45 := env.Limit - env.Cursor
+			lab46:
+				for {
+				lab47:
+					for {
+						v48 := env.Limit - env.Cursor
+					lab49:
+						for {
+							if !russian_r_adjectival(env, ctx) {
+								env.Cursor = env.Limit - v48
+								break lab49
+							}
+							break lab47
+						}
+						if !russian_r_verb(env, ctx) {
+							env.Cursor = env.Limit - v45
+							break lab46
+						}
+						break lab47
+					}
+					break lab44
+				}
+				if !russian_r_noun(env, ctx) {
+					break lab38
+				}
+				break lab44
+			}
+			break lab39
+		}
+		break lab38
+	}
+	env.Cursor = env.Limit - v37
+	v50 := env.Limit - env.Cursor
+lab51:
+	for {
+		env.Ket = env.Cursor
+		if !env.EqSB("и") {
+			env.Cursor = env.Limit - v50
+			break lab51
+		}
+		env.Bra = env.Cursor
+		if !env.SliceDel() {
+			env.Cursor = env.Limit - v50
+			break lab51
+		}
+		break lab51
+	}
+	v52 := env.Limit - env.Cursor
+lab53:
+	for {
+		if !russian_r_derivational(env, ctx) {
+			break lab53
+		}
+		break lab53
+	}
+	env.Cursor = env.Limit - v52
+	v54 := env.Limit - env.Cursor
+lab55:
+	for {
+		if !russian_r_tidy_up(env, ctx) {
+			break lab55
+		}
+		break lab55
+	}
+	env.Cursor = env.Limit - v54
+	env.LimitBackward = limit36
+	env.Cursor = env.LimitBackward
+	return true
+}
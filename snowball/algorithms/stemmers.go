@@ -0,0 +1,16 @@
+// Code generated by snowballgen. DO NOT EDIT.
+
+package algorithms
+
+// Stemmer is implemented by every generated stemmer.
+type Stemmer interface {
+	Stem(word string) string
+}
+
+// Stemmers maps the name of every generated algorithm to a constructor.
+var Stemmers = map[string]func() Stemmer{
+	"english": func() Stemmer { return NewEnglishStemmer() },
+	"italian": func() Stemmer { return NewItalianStemmer() },
+	"porter":  func() Stemmer { return NewPorterStemmer() },
+	"russian": func() Stemmer { return NewRussianStemmer() },
+}
@@ -0,0 +1,122 @@
+package snowball
+
+import (
+	"slices"
+	"strings"
+)
+
+// AmongCond is a routine that must succeed for an among entry to match. It
+// is called with the cursor just past the matched string.
+type AmongCond func(env *Env, ctx interface{}) bool
+
+// AmongEntry is one string of an among table.
+type AmongEntry struct {
+	S string
+	// Result is what FindAmong returns when S matches; it must be positive.
+	Result int
+	// Cond, if set, must also succeed for the entry to match.
+	Cond AmongCond
+}
+
+// Among is a table of strings that are matched at once, the longest
+// match winning.
+type Among struct {
+	entries []AmongEntry
+	// forward and backward index entries by their first and last byte.
+	// Each list is ordered from the longest entry to the shortest, and
+	// empty entries, which match anywhere, are appended to every list.
+	forward  [256][]int
+	backward [256][]int
+	// empty lists the empty entries alone, for a cursor at the limit.
+	empty []int
+}
+
+// NewAmong creates an Among from entries.
+func NewAmong(entries []AmongEntry) *Among {
+	a := &Among{entries: slices.Clone(entries)}
+	slices.SortStableFunc(a.entries, func(x, y AmongEntry) int {
+		return len(y.S) - len(x.S)
+	})
+
+	for i, e := range a.entries {
+		if e.S == "" {
+			a.empty = append(a.empty, i)
+			continue
+		}
+		a.forward[e.S[0]] = append(a.forward[e.S[0]], i)
+		a.backward[e.S[len(e.S)-1]] = append(a.backward[e.S[len(e.S)-1]], i)
+	}
+	if len(a.empty) > 0 {
+		for b := range a.forward {
+			a.forward[b] = append(a.forward[b], a.empty...)
+			a.backward[b] = append(a.backward[b], a.empty...)
+		}
+	}
+	return a
+}
+
+// SetCond sets the condition of every entry matching s. Generated code uses
+// it to attach routines after package initialisation.
+func (a *Among) SetCond(s string, cond AmongCond) {
+	for i := range a.entries {
+		if a.entries[i].S == s {
+			a.entries[i].Cond = cond
+		}
+	}
+}
+
+// FindAmong matches the longest entry of a at the cursor whose condition
+// holds, moves the cursor past it and returns its result. It returns 0 if
+// nothing matches.
+func (env *Env) FindAmong(a *Among, ctx interface{}) int {
+	c := env.Cursor
+	end := env.end()
+	candidates := a.empty
+	if c < end {
+		candidates = a.forward[env.current[c]]
+	}
+	for _, i := range candidates {
+		e := &a.entries[i]
+		if end-c < len(e.S) || !strings.HasPrefix(env.current[c:], e.S) {
+			continue
+		}
+		env.Cursor = c + len(e.S)
+		if e.Cond == nil {
+			return e.Result
+		}
+		ok := e.Cond(env, ctx)
+		env.Cursor = c + len(e.S)
+		if ok {
+			return e.Result
+		}
+	}
+	env.Cursor = c
+	return 0
+}
+
+// FindAmongB is the backward-mode counterpart of FindAmong: it matches
+// entries that end at the cursor and moves the cursor before them.
+func (env *Env) FindAmongB(a *Among, ctx interface{}) int {
+	c := env.Cursor
+	candidates := a.empty
+	if c > env.LimitBackward {
+		candidates = a.backward[env.current[c-1]]
+	}
+	for _, i := range candidates {
+		e := &a.entries[i]
+		if c-env.LimitBackward < len(e.S) || !strings.HasSuffix(env.current[:c], e.S) {
+			continue
+		}
+		env.Cursor = c - len(e.S)
+		if e.Cond == nil {
+			return e.Result
+		}
+		ok := e.Cond(env, ctx)
+		env.Cursor = c - len(e.S)
+		if ok {
+			return e.Result
+		}
+	}
+	env.Cursor = c
+	return 0
+}
@@ -0,0 +1,70 @@
+package snowball
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnv_FindAmong(t *testing.T) {
+	a := NewAmong([]AmongEntry{
+		{S: "un", Result: 1},
+		{S: "under", Result: 2},
+		{S: "", Result: 3},
+	})
+
+	f := func(s string, result, cursor int) {
+		t.Helper()
+		env := NewEnv(s)
+		require.Equal(t, result, env.FindAmong(a, nil))
+		require.Equal(t, cursor, env.Cursor)
+	}
+
+	f("understand", 2, 5)
+	f("undo", 1, 2)
+	f("do", 3, 0)
+	f("", 3, 0)
+}
+
+func TestEnv_FindAmongB(t *testing.T) {
+	a := NewAmong([]AmongEntry{
+		{S: "s", Result: 1},
+		{S: "ies", Result: 2},
+		{S: "sses", Result: 3},
+	})
+
+	f := func(s string, result, cursor int) {
+		t.Helper()
+		env := NewEnv(s)
+		env.Cursor = env.Limit
+		require.Equal(t, result, env.FindAmongB(a, nil))
+		require.Equal(t, cursor, env.Cursor)
+	}
+
+	f("caresses", 3, 4)
+	f("ponies", 2, 3)
+	f("cats", 1, 3)
+	f("cat", 0, 3)
+	f("", 0, 0)
+}
+
+func TestAmong_SetCond(t *testing.T) {
+	a := NewAmong([]AmongEntry{
+		{S: "s", Result: 1},
+		{S: "ies", Result: 2},
+	})
+	a.SetCond("ies", func(env *Env, ctx interface{}) bool {
+		return env.Cursor > *ctx.(*int)
+	})
+
+	env := NewEnv("ties")
+	env.Cursor = env.Limit
+	start := 1
+	require.Equal(t, 1, env.FindAmongB(a, &start))
+	require.Equal(t, 3, env.Cursor)
+
+	env.Cursor = env.Limit
+	start = 0
+	require.Equal(t, 2, env.FindAmongB(a, &start))
+	require.Equal(t, 1, env.Cursor)
+}
@@ -0,0 +1,265 @@
+// Package snowball is the runtime support for stemmers written in the
+// Snowball language. Both the Go code emitted by snowballgen and the
+// interpreter in package interp run on an Env, which holds the string
+// being stemmed together with the cursor, limits and slice markers of the
+// Snowball machine.
+//
+// All positions are byte offsets into the current string. Commands that
+// move by characters step over whole UTF-8 sequences.
+package snowball
+
+import (
+	"math"
+	"strings"
+	"unicode/utf8"
+)
+
+// MaxInt and MinInt are the values of the Snowball maxint and minint.
+const (
+	MaxInt = math.MaxInt32
+	MinInt = math.MinInt32
+)
+
+// Env is the state of a Snowball program while it runs on one string.
+type Env struct {
+	current string
+
+	// Cursor is the current position.
+	Cursor int
+	// Limit is the forward limit, LimitBackward the backward one.
+	Limit         int
+	LimitBackward int
+	// Bra and Ket delimit the slice used by delete, <- and ->.
+	Bra int
+	Ket int
+}
+
+// NewEnv creates an Env on s with the cursor at the start and the limits
+// at both ends.
+func NewEnv(s string) *Env {
+	env := &Env{}
+	env.SetCurrent(s)
+	return env
+}
+
+// Current returns the string in its present state.
+func (env *Env) Current() string {
+	return env.current
+}
+
+// SetCurrent replaces the string and resets the cursor, limits and slice.
+func (env *Env) SetCurrent(s string) {
+	env.current = s
+	env.Cursor = 0
+	env.Limit = len(s)
+	env.LimitBackward = 0
+	env.Bra = 0
+	env.Ket = len(s)
+}
+
+// Size returns the length of the current string in bytes.
+func (env *Env) Size() int {
+	return len(env.current)
+}
+
+// Len returns the length of the current string in characters.
+func (env *Env) Len() int {
+	return utf8.RuneCountInString(env.current)
+}
+
+// LenOf returns the length of s in characters, the Snowball lenof.
+func LenOf(s string) int {
+	return utf8.RuneCountInString(s)
+}
+
+// end returns the forward limit, or the end of the string if a faulty
+// program has left the limit beyond it.
+func (env *Env) end() int {
+	return min(env.Limit, len(env.current))
+}
+
+// Next moves the cursor forward by one character. It fails at the limit.
+func (env *Env) Next() bool {
+	if env.Cursor >= env.end() {
+		return false
+	}
+	_, size := utf8.DecodeRuneInString(env.current[env.Cursor:])
+	env.Cursor += size
+	return true
+}
+
+// Prev moves the cursor backward by one character. It fails at the
+// backward limit.
+func (env *Env) Prev() bool {
+	if env.Cursor <= env.LimitBackward {
+		return false
+	}
+	_, size := utf8.DecodeLastRuneInString(env.current[:env.Cursor])
+	env.Cursor -= size
+	return true
+}
+
+// Hop moves the cursor forward by n characters. It fails, leaving the
+// cursor alone, if n is negative or the limit is in the way.
+func (env *Env) Hop(n int) bool {
+	if n < 0 {
+		return false
+	}
+	c := env.Cursor
+	for ; n > 0; n-- {
+		if c >= env.end() {
+			return false
+		}
+		_, size := utf8.DecodeRuneInString(env.current[c:])
+		c += size
+	}
+	env.Cursor = c
+	return true
+}
+
+// HopBack moves the cursor backward by n characters, the backward-mode
+// counterpart of Hop.
+func (env *Env) HopBack(n int) bool {
+	if n < 0 {
+		return false
+	}
+	c := env.Cursor
+	for ; n > 0; n-- {
+		if c <= env.LimitBackward {
+			return false
+		}
+		_, size := utf8.DecodeLastRuneInString(env.current[:c])
+		c -= size
+	}
+	env.Cursor = c
+	return true
+}
+
+// EqS matches s at the cursor and moves the cursor past it.
+func (env *Env) EqS(s string) bool {
+	if env.Cursor > env.end() || env.end()-env.Cursor < len(s) || !strings.HasPrefix(env.current[env.Cursor:], s) {
+		return false
+	}
+	env.Cursor += len(s)
+	return true
+}
+
+// EqSB matches s just before the cursor and moves the cursor before it.
+func (env *Env) EqSB(s string) bool {
+	if env.Cursor-env.LimitBackward < len(s) || !strings.HasSuffix(env.current[:env.Cursor], s) {
+		return false
+	}
+	env.Cursor -= len(s)
+	return true
+}
+
+// InGrouping matches a character of g at the cursor.
+func (env *Env) InGrouping(g *Grouping) bool {
+	if env.Cursor >= env.end() {
+		return false
+	}
+	r, size := utf8.DecodeRuneInString(env.current[env.Cursor:])
+	if !g.Contains(r) {
+		return false
+	}
+	env.Cursor += size
+	return true
+}
+
+// InGroupingB matches a character of g just before the cursor.
+func (env *Env) InGroupingB(g *Grouping) bool {
+	if env.Cursor <= env.LimitBackward {
+		return false
+	}
+	r, size := utf8.DecodeLastRuneInString(env.current[:env.Cursor])
+	if !g.Contains(r) {
+		return false
+	}
+	env.Cursor -= size
+	return true
+}
+
+// OutGrouping matches a character at the cursor that is not in g.
+func (env *Env) OutGrouping(g *Grouping) bool {
+	if env.Cursor >= env.end() {
+		return false
+	}
+	r, size := utf8.DecodeRuneInString(env.current[env.Cursor:])
+	if g.Contains(r) {
+		return false
+	}
+	env.Cursor += size
+	return true
+}
+
+// OutGroupingB matches a character just before the cursor that is not in g.
+func (env *Env) OutGroupingB(g *Grouping) bool {
+	if env.Cursor <= env.LimitBackward {
+		return false
+	}
+	r, size := utf8.DecodeLastRuneInString(env.current[:env.Cursor])
+	if g.Contains(r) {
+		return false
+	}
+	env.Cursor -= size
+	return true
+}
+
+// replace replaces the bytes between bra and ket with s and moves the
+// forward limit and the cursor to follow the change. It returns the change
+// in length.
+func (env *Env) replace(bra, ket int, s string) int {
+	adjustment := len(s) - (ket - bra)
+	env.current = env.current[:bra] + s + env.current[ket:]
+	env.Limit += adjustment
+	if env.Cursor >= ket {
+		env.Cursor += adjustment
+	} else if env.Cursor > bra {
+		env.Cursor = bra
+	}
+	return adjustment
+}
+
+// validSlice reports whether Bra and Ket delimit a slice of the string.
+func (env *Env) validSlice() bool {
+	return 0 <= env.Bra && env.Bra <= env.Ket && env.Ket <= env.Limit && env.Limit <= len(env.current)
+}
+
+// SliceFrom replaces the slice with s. It fails if the slice is invalid.
+func (env *Env) SliceFrom(s string) bool {
+	if !env.validSlice() {
+		return false
+	}
+	env.replace(env.Bra, env.Ket, s)
+	return true
+}
+
+// SliceDel deletes the slice. It fails if the slice is invalid.
+func (env *Env) SliceDel() bool {
+	return env.SliceFrom("")
+}
+
+// SliceTo returns the contents of the slice.
+func (env *Env) SliceTo() string {
+	if !env.validSlice() {
+		return ""
+	}
+	return env.current[env.Bra:env.Ket]
+}
+
+// AssignTo returns the string up to the forward limit.
+func (env *Env) AssignTo() string {
+	return env.current[:env.end()]
+}
+
+// Insert inserts s between bra and ket, replacing what was there, and
+// shifts the slice markers that follow.
+func (env *Env) Insert(bra, ket int, s string) {
+	adjustment := env.replace(bra, ket, s)
+	if bra <= env.Bra {
+		env.Bra += adjustment
+	}
+	if bra <= env.Ket {
+		env.Ket += adjustment
+	}
+}
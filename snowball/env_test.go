@@ -0,0 +1,150 @@
+package snowball
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEnv(t *testing.T) {
+	env := NewEnv("ёлка")
+	require.Equal(t, "ёлка", env.Current())
+	require.Equal(t, 0, env.Cursor)
+	require.Equal(t, 8, env.Limit)
+	require.Equal(t, 8, env.Size())
+	require.Equal(t, 4, env.Len())
+}
+
+func TestEnv_Next(t *testing.T) {
+	env := NewEnv("ёж")
+	require.True(t, env.Next())
+	require.Equal(t, 2, env.Cursor)
+	require.True(t, env.Next())
+	require.False(t, env.Next())
+	require.Equal(t, 4, env.Cursor)
+
+	require.True(t, env.Prev())
+	require.Equal(t, 2, env.Cursor)
+	env.LimitBackward = 2
+	require.False(t, env.Prev())
+}
+
+func TestEnv_Hop(t *testing.T) {
+	env := NewEnv("ёлка")
+	require.True(t, env.Hop(2))
+	require.Equal(t, 4, env.Cursor)
+	require.False(t, env.Hop(3))
+	require.Equal(t, 4, env.Cursor)
+	require.False(t, env.Hop(-1))
+
+	require.True(t, env.HopBack(1))
+	require.Equal(t, 2, env.Cursor)
+	require.False(t, env.HopBack(2))
+	require.Equal(t, 2, env.Cursor)
+}
+
+func TestEnv_EqS(t *testing.T) {
+	env := NewEnv("running")
+	require.False(t, env.EqS("run!"))
+	require.True(t, env.EqS("run"))
+	require.Equal(t, 3, env.Cursor)
+
+	env.Cursor = env.Limit
+	require.False(t, env.EqSB("run"))
+	require.True(t, env.EqSB("ing"))
+	require.Equal(t, 4, env.Cursor)
+
+	env.LimitBackward = 3
+	require.False(t, env.EqSB("un"))
+}
+
+func TestEnv_InGrouping(t *testing.T) {
+	v := NewGrouping("aeiou")
+	env := NewEnv("tea")
+	require.False(t, env.InGrouping(v))
+	require.True(t, env.OutGrouping(v))
+	require.True(t, env.InGrouping(v))
+	require.Equal(t, 2, env.Cursor)
+
+	env.Cursor = env.Limit
+	require.False(t, env.OutGroupingB(v))
+	require.True(t, env.InGroupingB(v))
+	require.True(t, env.InGroupingB(v))
+	require.True(t, env.OutGroupingB(v))
+	require.False(t, env.OutGroupingB(v))
+}
+
+func TestEnv_SliceFrom(t *testing.T) {
+	env := NewEnv("hopping")
+	env.Bra, env.Ket = 3, 7
+	env.Cursor = 7
+	require.Equal(t, "ping", env.SliceTo())
+	require.True(t, env.SliceFrom("e"))
+	require.Equal(t, "hope", env.Current())
+	require.Equal(t, 4, env.Limit)
+	require.Equal(t, 4, env.Cursor)
+	require.Equal(t, "hope", env.AssignTo())
+
+	env.Bra, env.Ket = 2, 4
+	require.True(t, env.SliceDel())
+	require.Equal(t, "ho", env.Current())
+
+	env.Bra, env.Ket = 1, 5
+	require.False(t, env.SliceFrom("x"))
+	require.Equal(t, "", env.SliceTo())
+}
+
+func TestEnv_Insert(t *testing.T) {
+	env := NewEnv("hop")
+	env.Bra, env.Ket = 1, 3
+	env.Cursor = 3
+	env.Insert(3, 3, "e")
+	require.Equal(t, "hope", env.Current())
+	require.Equal(t, 4, env.Cursor)
+	require.Equal(t, 1, env.Bra)
+	require.Equal(t, 4, env.Ket)
+
+	env.Insert(0, 0, "s")
+	require.Equal(t, "shope", env.Current())
+	require.Equal(t, 2, env.Bra)
+	require.Equal(t, 5, env.Ket)
+}
+
+func TestEnv_setlimit(t *testing.T) {
+	// setlimit hop 2 for ( ['a'] delete ) repeat next, as generated code
+	// runs it: the old limit is kept relative to the new one.
+	env := NewEnv("abcd")
+	v := env.Cursor
+	require.True(t, env.Hop(2))
+	limit := env.Limit - env.Cursor
+	env.Limit = env.Cursor
+	env.Cursor = v
+	env.Bra = env.Cursor
+	require.True(t, env.EqS("a"))
+	env.Ket = env.Cursor
+	require.True(t, env.SliceDel())
+	env.Limit += limit
+	require.Equal(t, "bcd", env.Current())
+	require.Equal(t, 3, env.Limit)
+
+	steps := 0
+	for env.Next() {
+		steps++
+	}
+	require.Equal(t, 3, steps)
+}
+
+func TestEnv_limitPastEnd(t *testing.T) {
+	env := NewEnv("ab")
+	env.Limit = 5
+	require.True(t, env.Hop(2))
+	require.False(t, env.Next())
+	require.False(t, env.Hop(1))
+	require.False(t, env.EqS("c"))
+	require.False(t, env.InGrouping(NewGrouping("abc")))
+	require.Equal(t, 0, env.FindAmong(NewAmong([]AmongEntry{{S: "c", Result: 1}}), nil))
+	require.Equal(t, "ab", env.AssignTo())
+
+	env.Cursor = 4
+	require.False(t, env.EqS(""))
+}
@@ -0,0 +1,836 @@
+// Package gogen generates Go stemmers from Snowball programs parsed by
+// package sbl. The generated code runs on the snowball runtime package.
+//
+// Programs using reverse, or backwards inside backward mode, are rejected
+// by Generate.
+package gogen
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/machine23/ugu-stemmer/snowball/sbl"
+)
+
+// DefaultRuntime is the import path of the runtime package.
+const DefaultRuntime = "github.com/machine23/ugu-stemmer/snowball"
+
+// Config describes the Go file to generate.
+type Config struct {
+	// Package is the name of the Go package.
+	Package string
+	// Name is the name of the algorithm, such as "porter". It becomes the
+	// prefix of the generated identifiers and names the stemmer type.
+	Name string
+	// Source is the name of the Snowball file, mentioned in comments.
+	Source string
+	// Runtime is the import path of the runtime package. DefaultRuntime is
+	// used if it is empty.
+	Runtime string
+}
+
+// TypeName returns the name of the stemmer type generated for the
+// algorithm name, such as PorterStemmer for "porter".
+func TypeName(name string) string {
+	var b strings.Builder
+	for _, part := range strings.FieldsFunc(name, isSeparator) {
+		r := []rune(part)
+		b.WriteString(strings.ToUpper(string(r[0])) + string(r[1:]))
+	}
+	return b.String() + "Stemmer"
+}
+
+// prefixName returns the lower camel case prefix of the identifiers
+// generated for the algorithm name.
+func prefixName(name string) string {
+	t := strings.TrimSuffix(TypeName(name), "Stemmer")
+	r := []rune(t)
+	return strings.ToLower(string(r[0])) + string(r[1:])
+}
+
+func isSeparator(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+}
+
+// Generate returns the formatted Go source of a stemmer for prog.
+func Generate(prog *sbl.Program, cfg Config) ([]byte, error) {
+	if cfg.Runtime == "" {
+		cfg.Runtime = DefaultRuntime
+	}
+	if len(strings.FieldsFunc(cfg.Name, isSeparator)) == 0 {
+		return nil, fmt.Errorf("gogen: bad algorithm name %q", cfg.Name)
+	}
+
+	g := &generator{
+		prog:   prog,
+		cfg:    cfg,
+		prefix: prefixName(cfg.Name),
+		buf:    &bytes.Buffer{},
+		used:   map[string]bool{},
+	}
+	if err := g.file(); err != nil {
+		return nil, err
+	}
+
+	src, err := format.Source(g.buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("gogen: formatting generated code for %s: %w", cfg.Source, err)
+	}
+	return src, nil
+}
+
+// GenerateRegistry returns the Go source of a file that maps every
+// algorithm name to the constructor of its generated stemmer.
+func GenerateRegistry(pkg string, names []string) ([]byte, error) {
+	names = append([]string(nil), names...)
+	sort.Strings(names)
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by snowballgen. DO NOT EDIT.\n\npackage %s\n\n", pkg)
+	b.WriteString("// Stemmer is implemented by every generated stemmer.\n")
+	b.WriteString("type Stemmer interface {\nStem(word string) string\n}\n\n")
+	b.WriteString("// Stemmers maps the name of every generated algorithm to a constructor.\n")
+	b.WriteString("var Stemmers = map[string]func() Stemmer{\n")
+	for _, name := range names {
+		fmt.Fprintf(&b, "%q: func() Stemmer { return New%s() },\n", name, TypeName(name))
+	}
+	b.WriteString("}\n")
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("gogen: formatting registry: %w", err)
+	}
+	return src, nil
+}
+
+// label is a breakable block of generated code.
+type label struct {
+	name string
+	used bool
+}
+
+// failure says what generated code does when a command fails: run the
+// cleanup functions, then leave the block of label, or return false from
+// the routine if label is nil.
+type failure struct {
+	cleanup []func()
+	label   *label
+}
+
+// with returns a failure that runs fn before f.
+func (f *failure) with(fn func()) *failure {
+	return &failure{cleanup: append([]func(){fn}, f.cleanup...), label: f.label}
+}
+
+// unsupported is raised with panic for constructs the generator cannot
+// translate, and turned into an error by Generate.
+type unsupported struct {
+	msg string
+}
+
+type generator struct {
+	prog   *sbl.Program
+	cfg    Config
+	prefix string
+	buf    *bytes.Buffer
+
+	// backward is set while generating backward-mode code.
+	backward bool
+	// terminated is set after an unconditional jump, so that no
+	// unreachable code follows it.
+	terminated bool
+	// counter numbers labels and variables.
+	counter int
+	// used records the saved-cursor variables that are read.
+	used map[string]bool
+	// usesContext is set when the current routine reads its variables.
+	usesContext bool
+}
+
+func (g *generator) line(format string, args ...interface{}) {
+	fmt.Fprintf(g.buf, format, args...)
+	g.buf.WriteByte('\n')
+	g.terminated = false
+}
+
+// capture runs fn with a fresh buffer and returns what it generated and
+// whether it ended with an unconditional jump.
+func (g *generator) capture(fn func()) (string, bool) {
+	saved := g.buf
+	g.buf = &bytes.Buffer{}
+	fn()
+	code := g.buf.String()
+	g.buf = saved
+	return code, g.terminated
+}
+
+func (g *generator) raw(code string, terminated bool) {
+	g.buf.WriteString(code)
+	g.terminated = terminated
+}
+
+func (g *generator) newLabel() *label {
+	g.counter++
+	return &label{name: "lab" + strconv.Itoa(g.counter)}
+}
+
+func (g *generator) newVar(prefix string) string {
+	g.counter++
+	return prefix + strconv.Itoa(g.counter)
+}
+
+// fail emits the jump of f.
+func (g *generator) fail(f *failure) {
+	for _, fn := range f.cleanup {
+		fn()
+	}
+	if f.label == nil {
+		g.line("return false")
+	} else {
+		f.label.used = true
+		g.line("break %s", f.label.name)
+	}
+	g.terminated = true
+}
+
+// failIf emits a test that fails with f if cond holds.
+func (g *generator) failIf(cond string, f *failure) {
+	g.line("if %s {", cond)
+	g.fail(f)
+	g.line("}")
+}
+
+// block emits the code of body inside a block that its failures with the
+// label passed to it leave.
+func (g *generator) block(body func(l *label)) {
+	l := g.newLabel()
+	code, terminated := g.capture(func() { body(l) })
+	if !l.used {
+		g.raw(code, terminated)
+		return
+	}
+	g.line("%s:", l.name)
+	g.line("for {")
+	g.raw(code, terminated)
+	if !terminated {
+		g.line("break %s", l.name)
+	}
+	g.line("}")
+}
+
+// saved emits body, which may save the cursor in the variable passed to it
+// and restore it with restore. The variable is only declared if it is read.
+func (g *generator) saved(body func(v string)) {
+	v := g.newVar("v")
+	code, terminated := g.capture(func() { body(v) })
+	if g.used[v] {
+		if g.backward {
+			g.line("%s := env.Limit - env.Cursor", v)
+		} else {
+			g.line("%s := env.Cursor", v)
+		}
+	}
+	g.raw(code, terminated)
+}
+
+// restore emits code that moves the cursor back to the position saved in v.
+func (g *generator) restore(v string) {
+	g.used[v] = true
+	if g.backward {
+		g.line("env.Cursor = env.Limit - %s", v)
+	} else {
+		g.line("env.Cursor = %s", v)
+	}
+}
+
+func (g *generator) context(field string) string {
+	g.usesContext = true
+	return "context." + field
+}
+
+func (g *generator) routineName(name string) string {
+	return g.prefix + "_r_" + name
+}
+
+func (g *generator) groupingName(name string) string {
+	return g.prefix + "_g_" + name
+}
+
+func (g *generator) amongName(id int) string {
+	return g.prefix + "_a_" + strconv.Itoa(id)
+}
+
+func (g *generator) contextType() string {
+	return g.prefix + "Context"
+}
+
+func (g *generator) file() (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			u, ok := r.(unsupported)
+			if !ok {
+				panic(r)
+			}
+			err = fmt.Errorf("gogen: %s: %s", g.cfg.Source, u.msg)
+		}
+	}()
+
+	stem := g.prog.Entry()
+	if stem == nil {
+		panic(unsupported{"no external routine to call"})
+	}
+	typeName := TypeName(g.cfg.Name)
+
+	g.line("// Code generated by snowballgen from %s. DO NOT EDIT.", g.cfg.Source)
+	g.line("")
+	g.line("package %s", g.cfg.Package)
+	g.line("")
+	g.line("import (")
+	g.line("%q", "strings")
+	g.line("")
+	g.line("%q", g.cfg.Runtime)
+	g.line(")")
+	g.line("")
+	g.line("// %s is the stemmer generated from %s.", typeName, g.cfg.Source)
+	g.line("type %s struct{}", typeName)
+	g.line("")
+	g.line("// New%s creates a new %s.", typeName, typeName)
+	g.line("func New%s() *%s {", typeName, typeName)
+	g.line("return &%s{}", typeName)
+	g.line("}")
+	g.line("")
+	g.line("// Stem returns the stem of the given word.")
+	g.line("func (s %s) Stem(word string) string {", typeName)
+	g.line("env := snowball.NewEnv(strings.ToLower(word))")
+	g.line("%s(env, &%s{})", g.routineName(stem.Name), g.contextType())
+	g.line("return env.Current()")
+	g.line("}")
+	g.line("")
+
+	g.contextStruct()
+	g.tables()
+	for _, r := range g.prog.Routines {
+		g.routine(r)
+	}
+	return nil
+}
+
+func (g *generator) contextStruct() {
+	g.line("// %s holds the variables of one run of the stemmer.", g.contextType())
+	g.line("type %s struct {", g.contextType())
+	for _, name := range g.prog.Strings {
+		g.line("s_%s string", name)
+	}
+	for _, name := range g.prog.Integers {
+		g.line("i_%s int", name)
+	}
+	for _, name := range g.prog.Booleans {
+		g.line("b_%s bool", name)
+	}
+	g.line("}")
+	g.line("")
+}
+
+// tables emits the groupings and among tables. Among conditions are set in
+// an init function, because routines may refer to the tables themselves.
+func (g *generator) tables() {
+	if len(g.prog.Groupings) == 0 && len(g.prog.Amongs) == 0 {
+		return
+	}
+
+	var conds []string
+	g.line("var (")
+	for _, gr := range g.prog.Groupings {
+		g.line("%s = snowball.NewGrouping(%s)", g.groupingName(gr.Name), strconv.Quote(gr.Chars))
+	}
+	for _, a := range g.prog.Amongs {
+		g.line("%s = snowball.NewAmong([]snowball.AmongEntry{", g.amongName(a.ID))
+		for _, e := range a.Entries {
+			g.line("{S: %s, Result: %d},", strconv.Quote(e.S), amongResult(a, e))
+			if e.Routine != "" {
+				conds = append(conds, fmt.Sprintf("%s.SetCond(%s, %s)", g.amongName(a.ID), strconv.Quote(e.S), g.routineName(e.Routine)))
+			}
+		}
+		g.line("})")
+	}
+	g.line(")")
+	g.line("")
+
+	if len(conds) > 0 {
+		g.line("func init() {")
+		for _, c := range conds {
+			g.line("%s", c)
+		}
+		g.line("}")
+		g.line("")
+	}
+}
+
+// amongResult returns what the runtime reports when e matches: the number
+// of its action, or one past the last action if it has none.
+func amongResult(a *sbl.Among, e sbl.AmongEntry) int {
+	if e.Action < 0 {
+		return len(a.Actions) + 1
+	}
+	return e.Action + 1
+}
+
+func (g *generator) routine(r *sbl.Routine) {
+	g.backward = r.Backward
+	g.usesContext = false
+	usesAmong := containsAmong(r.Body)
+
+	body, terminated := g.capture(func() {
+		g.command(r.Body, &failure{})
+	})
+
+	g.line("func %s(env *snowball.Env, ctx interface{}) bool {", g.routineName(r.Name))
+	if g.usesContext {
+		g.line("context := ctx.(*%s)", g.contextType())
+	}
+	if usesAmong {
+		g.line("var amongVar int")
+	}
+	g.raw(body, terminated)
+	if !terminated {
+		g.line("return true")
+	}
+	g.line("}")
+	g.line("")
+}
+
+// containsAmong reports whether c contains an among or a substring.
+func containsAmong(c sbl.Command) bool {
+	found := false
+	walk(c, func(c sbl.Command) {
+		switch c.(type) {
+		case *sbl.Among, *sbl.Substring:
+			found = true
+		}
+	})
+	return found
+}
+
+// walk calls fn for c and every command inside it.
+func walk(c sbl.Command, fn func(sbl.Command)) {
+	fn(c)
+	switch c := c.(type) {
+	case *sbl.Seq:
+		for _, sub := range c.List {
+			walk(sub, fn)
+		}
+	case *sbl.Or:
+		walk(c.Left, fn)
+		walk(c.Right, fn)
+	case *sbl.And:
+		walk(c.Left, fn)
+		walk(c.Right, fn)
+	case *sbl.Unary:
+		walk(c.C, fn)
+	case *sbl.Loop:
+		walk(c.C, fn)
+	case *sbl.Atleast:
+		walk(c.C, fn)
+	case *sbl.Setlimit:
+		walk(c.Limit, fn)
+		walk(c.C, fn)
+	case *sbl.Among:
+		if c.Starter != nil {
+			walk(c.Starter, fn)
+		}
+		for _, a := range c.Actions {
+			walk(a, fn)
+		}
+	}
+}
+
+// command emits the code of c; when c fails, the code fails with f.
+func (g *generator) command(c sbl.Command, f *failure) {
+	if g.terminated {
+		return
+	}
+
+	switch c := c.(type) {
+	case *sbl.Seq:
+		for _, sub := range c.List {
+			g.command(sub, f)
+		}
+	case *sbl.Or:
+		g.or(c, f)
+	case *sbl.And:
+		g.saved(func(v string) {
+			g.command(c.Left, f)
+			if !g.terminated {
+				g.restore(v)
+			}
+			g.command(c.Right, f)
+		})
+	case *sbl.Unary:
+		g.unary(c, f)
+	case *sbl.Loop:
+		i := g.newVar("i")
+		g.line("for %s := %s; %s > 0; %s-- {", i, g.expr(c.Count), i, i)
+		g.command(c.C, f)
+		g.line("}")
+	case *sbl.Atleast:
+		i := g.newVar("i")
+		g.line("%s := %s", i, g.expr(c.Count))
+		g.repeat(c.C, func() { g.line("%s--", i) })
+		g.failIf(i+" > 0", f)
+	case *sbl.Setlimit:
+		g.setlimit(c, f)
+	case *sbl.Simple:
+		g.simple(c.Op, f)
+	case *sbl.Literal:
+		if c.S != "" {
+			g.failIf(fmt.Sprintf("!env.%s(%s)", g.dir("EqS"), strconv.Quote(c.S)), f)
+		}
+	case *sbl.Ref:
+		g.ref(c, f)
+	case *sbl.SliceFrom:
+		g.failIf(fmt.Sprintf("!env.SliceFrom(%s)", g.str(c.S)), f)
+	case *sbl.Insert:
+		g.insert(c)
+	case *sbl.SliceTo:
+		g.line("%s = env.SliceTo()", g.context("s_"+c.Var))
+	case *sbl.AssignTo:
+		g.line("%s = env.AssignTo()", g.context("s_"+c.Var))
+	case *sbl.SetBool:
+		g.line("%s = %t", g.context("b_"+c.Var), c.Value)
+	case *sbl.Hop:
+		g.failIf(fmt.Sprintf("!env.%s(%s)", g.pick("Hop", "HopBack"), g.expr(c.N)), f)
+	case *sbl.Tomark:
+		pos := g.expr(c.Pos)
+		g.failIf(fmt.Sprintf("env.Cursor %s %s", g.pick(">", "<"), pos), f)
+		g.line("env.Cursor = %s", pos)
+	case *sbl.Atmark:
+		g.failIf("env.Cursor != "+g.expr(c.Pos), f)
+	case *sbl.Setmark:
+		g.line("%s = env.Cursor", g.context("i_"+c.Var))
+	case *sbl.IntAssign:
+		g.line("%s %s %s", g.context("i_"+c.Var), c.Op, g.expr(c.X))
+	case *sbl.IntTest:
+		g.failIf(fmt.Sprintf("!(%s %s %s)", g.expr(c.Left), c.Op, g.expr(c.Right)), f)
+	case *sbl.Substring:
+		g.findAmong(c.Among, f)
+	case *sbl.Among:
+		if !c.Substring {
+			g.findAmong(c, f)
+		}
+		if c.Starter != nil {
+			g.command(c.Starter, f)
+		}
+		g.dispatch(c, f)
+	default:
+		panic(unsupported{fmt.Sprintf("unknown command %T", c)})
+	}
+}
+
+// pick returns forward or backward depending on the mode.
+func (g *generator) pick(forward, backward string) string {
+	if g.backward {
+		return backward
+	}
+	return forward
+}
+
+// dir returns the name of a runtime method, with the B suffix of its
+// backward-mode variant when needed.
+func (g *generator) dir(method string) string {
+	return g.pick(method, method+"B")
+}
+
+func (g *generator) or(c *sbl.Or, f *failure) {
+	done := g.newLabel()
+	code, terminated := g.capture(func() {
+		g.saved(func(v string) {
+			var leftFails bool
+			g.block(func(l *label) {
+				g.command(c.Left, &failure{cleanup: []func(){func() { g.restore(v) }}, label: l})
+				if !g.terminated {
+					done.used = true
+					g.line("break %s", done.name)
+					g.terminated = true
+				}
+				leftFails = l.used
+			})
+			if leftFails {
+				g.command(c.Right, f)
+			}
+		})
+	})
+	if !done.used {
+		g.raw(code, terminated)
+		return
+	}
+	g.line("%s:", done.name)
+	g.line("for {")
+	g.raw(code, terminated)
+	if !terminated {
+		g.line("break %s", done.name)
+	}
+	g.line("}")
+}
+
+func (g *generator) unary(c *sbl.Unary, f *failure) {
+	switch c.Op {
+	case "not":
+		g.saved(func(v string) {
+			g.block(func(l *label) {
+				g.command(c.C, &failure{label: l})
+				g.fail(f)
+			})
+			if !g.terminated {
+				g.restore(v)
+			}
+		})
+	case "test":
+		g.saved(func(v string) {
+			g.command(c.C, f)
+			if !g.terminated {
+				g.restore(v)
+			}
+		})
+	case "try":
+		g.saved(func(v string) {
+			g.block(func(l *label) {
+				g.command(c.C, &failure{cleanup: []func(){func() { g.restore(v) }}, label: l})
+			})
+		})
+	case "do":
+		g.saved(func(v string) {
+			g.block(func(l *label) {
+				g.command(c.C, &failure{label: l})
+			})
+			if !g.terminated {
+				g.restore(v)
+			}
+		})
+	case "fail":
+		g.command(c.C, f)
+		g.fail(f)
+	case "goto", "gopast":
+		g.goTo(c, f)
+	case "repeat":
+		g.repeat(c.C, nil)
+	case "backwards":
+		if g.backward {
+			panic(unsupported{"backwards inside backward mode"})
+		}
+		g.line("env.LimitBackward = env.Cursor")
+		g.line("env.Cursor = env.Limit")
+		g.backward = true
+		g.command(c.C, f)
+		g.backward = false
+		if !g.terminated {
+			g.line("env.Cursor = env.LimitBackward")
+		}
+	default:
+		panic(unsupported{c.Op + " is not supported"})
+	}
+}
+
+// goTo emits goto C and gopast C: try C at every position from the cursor
+// on, leaving the cursor before the match for goto and after it for gopast.
+func (g *generator) goTo(c *sbl.Unary, f *failure) {
+	loop := g.newLabel()
+	code, _ := g.capture(func() {
+		g.saved(func(v string) {
+			g.block(func(l *label) {
+				g.command(c.C, &failure{cleanup: []func(){func() { g.restore(v) }}, label: l})
+				if g.terminated {
+					return
+				}
+				if c.Op == "goto" {
+					g.restore(v)
+				}
+				loop.used = true
+				g.line("break %s", loop.name)
+				g.terminated = true
+			})
+			if !g.terminated {
+				g.failIf(fmt.Sprintf("!env.%s()", g.pick("Next", "Prev")), f)
+			}
+		})
+	})
+	if loop.used {
+		g.line("%s:", loop.name)
+	}
+	g.line("for {")
+	g.raw(code, false)
+	g.line("}")
+}
+
+// repeat emits repeat C, running each after every successful run of C.
+func (g *generator) repeat(c sbl.Command, each func()) {
+	loop := g.newLabel()
+	code, _ := g.capture(func() {
+		g.saved(func(v string) {
+			g.command(c, &failure{cleanup: []func(){func() { g.restore(v) }}, label: loop})
+			if each != nil && !g.terminated {
+				each()
+			}
+		})
+	})
+	if loop.used {
+		g.line("%s:", loop.name)
+	}
+	g.line("for {")
+	g.raw(code, false)
+	g.line("}")
+}
+
+func (g *generator) setlimit(c *sbl.Setlimit, f *failure) {
+	g.saved(func(v string) {
+		g.command(c.Limit, f)
+		if g.terminated {
+			return
+		}
+		// The backward limit stays put while the string changes, but the
+		// forward limit moves with every edit, so it is restored relative
+		// to the limit set here.
+		old := g.newVar("limit")
+		var reset func()
+		if g.backward {
+			g.line("%s := env.LimitBackward", old)
+			g.line("env.LimitBackward = env.Cursor")
+			reset = func() { g.line("env.LimitBackward = %s", old) }
+		} else {
+			g.line("%s := env.Limit - env.Cursor", old)
+			g.line("env.Limit = env.Cursor")
+			reset = func() { g.line("env.Limit += %s", old) }
+		}
+		g.restore(v)
+		g.command(c.C, f.with(reset))
+		if !g.terminated {
+			reset()
+		}
+	})
+}
+
+func (g *generator) simple(op string, f *failure) {
+	switch op {
+	case "[":
+		g.line("env.%s = env.Cursor", g.pick("Bra", "Ket"))
+	case "]":
+		g.line("env.%s = env.Cursor", g.pick("Ket", "Bra"))
+	case "delete":
+		g.failIf("!env.SliceDel()", f)
+	case "next":
+		g.failIf(fmt.Sprintf("!env.%s()", g.pick("Next", "Prev")), f)
+	case "atlimit":
+		g.failIf(g.pick("env.Cursor < env.Limit", "env.Cursor > env.LimitBackward"), f)
+	case "tolimit":
+		g.line("env.Cursor = %s", g.pick("env.Limit", "env.LimitBackward"))
+	case "true", "?":
+	case "false":
+		g.fail(f)
+	default:
+		panic(unsupported{op + " is not supported"})
+	}
+}
+
+func (g *generator) ref(c *sbl.Ref, f *failure) {
+	switch c.Kind {
+	case sbl.KindRoutine, sbl.KindExternal:
+		g.failIf(fmt.Sprintf("!%s(env, ctx)", g.routineName(c.Name)), f)
+	case sbl.KindGrouping:
+		method := "InGrouping"
+		if c.Non {
+			method = "OutGrouping"
+		}
+		g.failIf(fmt.Sprintf("!env.%s(%s)", g.dir(method), g.groupingName(c.Name)), f)
+	case sbl.KindBoolean:
+		g.failIf("!"+g.context("b_"+c.Name), f)
+	case sbl.KindString:
+		g.failIf(fmt.Sprintf("!env.%s(%s)", g.dir("EqS"), g.context("s_"+c.Name)), f)
+	default:
+		panic(unsupported{fmt.Sprintf("%s %s used as a command", c.Kind, c.Name)})
+	}
+}
+
+// insert emits insert, <+ and attach. Insert leaves the cursor after the
+// new text in forward mode and attach in backward mode; otherwise the
+// cursor stays where it was.
+func (g *generator) insert(c *sbl.Insert) {
+	if c.Attach == g.backward {
+		g.line("env.Insert(env.Cursor, env.Cursor, %s)", g.str(c.S))
+		return
+	}
+	v := g.newVar("c")
+	g.line("%s := env.Cursor", v)
+	g.line("env.Insert(env.Cursor, env.Cursor, %s)", g.str(c.S))
+	g.line("env.Cursor = %s", v)
+}
+
+func (g *generator) str(s sbl.Str) string {
+	if s.Var != "" {
+		return g.context("s_" + s.Var)
+	}
+	return strconv.Quote(s.Lit)
+}
+
+func (g *generator) findAmong(a *sbl.Among, f *failure) {
+	g.line("amongVar = env.%s(%s, ctx)", g.dir("FindAmong"), g.amongName(a.ID))
+	g.failIf("amongVar == 0", f)
+}
+
+// dispatch emits the actions of a, run according to the matched entry.
+func (g *generator) dispatch(a *sbl.Among, f *failure) {
+	var cases []string
+	for i, action := range a.Actions {
+		code, _ := g.capture(func() { g.command(action, f) })
+		if code == "" {
+			continue
+		}
+		cases = append(cases, fmt.Sprintf("case %d:\n%s", i+1, code))
+	}
+	if len(cases) == 0 {
+		return
+	}
+	g.line("switch amongVar {")
+	for _, c := range cases {
+		g.buf.WriteString(c)
+	}
+	g.line("}")
+}
+
+// expr returns the Go expression for x.
+func (g *generator) expr(x sbl.Expr) string {
+	switch x := x.(type) {
+	case *sbl.Num:
+		return strconv.Itoa(x.Value)
+	case *sbl.Var:
+		return g.context("i_" + x.Name)
+	case *sbl.Builtin:
+		switch x.Name {
+		case "cursor":
+			return "env.Cursor"
+		case "limit":
+			return g.pick("env.Limit", "env.LimitBackward")
+		case "size":
+			return "env.Size()"
+		case "len":
+			return "env.Len()"
+		case "maxint":
+			return "snowball.MaxInt"
+		case "minint":
+			return "snowball.MinInt"
+		}
+	case *sbl.SizeOf:
+		if x.Chars {
+			return fmt.Sprintf("snowball.LenOf(%s)", g.context("s_"+x.Var))
+		}
+		return fmt.Sprintf("len(%s)", g.context("s_"+x.Var))
+	case *sbl.Binary:
+		return fmt.Sprintf("(%s %s %s)", g.expr(x.X), x.Op, g.expr(x.Y))
+	case *sbl.Neg:
+		return fmt.Sprintf("(-%s)", g.expr(x.X))
+	}
+	panic(unsupported{fmt.Sprintf("unknown expression %T", x)})
+}
@@ -0,0 +1,94 @@
+package gogen
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/machine23/ugu-stemmer/snowball/sbl"
+)
+
+func TestTypeName(t *testing.T) {
+	f := func(name, typeName string) {
+		t.Helper()
+		require.Equal(t, typeName, TypeName(name))
+	}
+
+	f("porter", "PorterStemmer")
+	f("kraaij_pohlmann", "KraaijPohlmannStemmer")
+	f("english-light", "EnglishLightStemmer")
+}
+
+func TestGenerate(t *testing.T) {
+	src := []byte(`
+routines ( Step )
+externals ( stem )
+define stem as ( backwards Step )
+backwardmode ( define Step as ( ['s'] delete ) )
+`)
+	prog, err := sbl.Parse("plural.sbl", src)
+	require.NoError(t, err)
+
+	code, err := Generate(prog, Config{Package: "plural", Name: "plural", Source: "plural.sbl"})
+	require.NoError(t, err)
+	require.Contains(t, string(code), "// Code generated by snowballgen from plural.sbl. DO NOT EDIT.")
+	require.Contains(t, string(code), "type PluralStemmer struct{}")
+	require.Contains(t, string(code), `"`+DefaultRuntime+`"`)
+	require.Contains(t, string(code), "func plural_r_Step(env *snowball.Env, ctx interface{}) bool {")
+
+	_, err = Generate(prog, Config{Package: "plural", Name: "--", Source: "plural.sbl"})
+	require.Error(t, err)
+
+	prog, err = sbl.Parse("none.sbl", []byte("routines ( r )\ndefine r as true"))
+	require.NoError(t, err)
+	_, err = Generate(prog, Config{Package: "none", Name: "none", Source: "none.sbl"})
+	require.EqualError(t, err, "gogen: none.sbl: no external routine to call")
+}
+
+func TestGenerate_setlimit(t *testing.T) {
+	prog, err := sbl.Parse("limit.sbl", []byte(`
+externals ( stem )
+define stem as ( setlimit hop 2 for ( ['a'] delete ) repeat next )
+`))
+	require.NoError(t, err)
+
+	code, err := Generate(prog, Config{Package: "limit", Name: "limit", Source: "limit.sbl"})
+	require.NoError(t, err)
+	require.Contains(t, string(code), "limit2 := env.Limit - env.Cursor")
+	require.Contains(t, string(code), "env.Limit += limit2")
+	require.NotContains(t, string(code), "env.Limit = limit2")
+}
+
+// TestGenerate_algorithms checks that the generated algorithms are up to
+// date with their Snowball sources.
+func TestGenerate_algorithms(t *testing.T) {
+	files, err := filepath.Glob("../algorithms/*.sbl")
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+
+	var names []string
+	for _, file := range files {
+		name := strings.TrimSuffix(filepath.Base(file), ".sbl")
+		names = append(names, name)
+
+		src, err := os.ReadFile(file)
+		require.NoError(t, err)
+		prog, err := sbl.Parse(filepath.Base(file), src)
+		require.NoError(t, err)
+		code, err := Generate(prog, Config{Package: "algorithms", Name: name, Source: filepath.Base(file)})
+		require.NoError(t, err)
+
+		want, err := os.ReadFile(filepath.Join("../algorithms", name+".go"))
+		require.NoError(t, err)
+		require.Equal(t, string(want), string(code), "%s.go is out of date, run go generate", name)
+	}
+
+	code, err := GenerateRegistry("algorithms", names)
+	require.NoError(t, err)
+	want, err := os.ReadFile("../algorithms/stemmers.go")
+	require.NoError(t, err)
+	require.Equal(t, string(want), string(code), "stemmers.go is out of date, run go generate")
+}
@@ -0,0 +1,41 @@
+package snowball
+
+// Grouping is a set of characters defined with define g '...'.
+type Grouping struct {
+	min, max rune
+	bits     []uint64
+}
+
+// NewGrouping creates a Grouping of the characters in chars.
+func NewGrouping(chars string) *Grouping {
+	g := &Grouping{}
+	first := true
+	for _, r := range chars {
+		if first || r < g.min {
+			g.min = r
+		}
+		if first || r > g.max {
+			g.max = r
+		}
+		first = false
+	}
+	if first {
+		return g
+	}
+
+	g.bits = make([]uint64, (g.max-g.min)/64+1)
+	for _, r := range chars {
+		i := r - g.min
+		g.bits[i/64] |= 1 << (i % 64)
+	}
+	return g
+}
+
+// Contains reports whether r belongs to g.
+func (g *Grouping) Contains(r rune) bool {
+	if len(g.bits) == 0 || r < g.min || r > g.max {
+		return false
+	}
+	i := r - g.min
+	return g.bits[i/64]&(1<<(i%64)) != 0
+}
@@ -0,0 +1,19 @@
+package snowball
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGrouping_Contains(t *testing.T) {
+	g := NewGrouping("aeiouyаеиоуыэюя")
+	require.True(t, g.Contains('a'))
+	require.True(t, g.Contains('y'))
+	require.True(t, g.Contains('я'))
+	require.False(t, g.Contains('b'))
+	require.False(t, g.Contains('б'))
+	require.False(t, g.Contains('ё'))
+
+	require.False(t, NewGrouping("").Contains('a'))
+}
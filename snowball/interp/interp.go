@@ -0,0 +1,163 @@
+// Package interp runs Snowball programs without generating Go code. It
+// parses a .sbl file at runtime and returns a Stemmer that executes it on
+// the snowball runtime, so that variants of an algorithm can be tried by
+// configuration. Programs using reverse are rejected by New.
+package interp
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/machine23/ugu-stemmer/snowball"
+	"github.com/machine23/ugu-stemmer/snowball/sbl"
+)
+
+// Stemmer stems words with a parsed Snowball program. It is safe for
+// concurrent use.
+type Stemmer struct {
+	prog      *sbl.Program
+	entry     *sbl.Routine
+	routines  map[string]*sbl.Routine
+	groupings map[string]*snowball.Grouping
+	// amongs holds the runtime table of every among, indexed by its ID.
+	amongs []*snowball.Among
+	// ints, strs and bools map variable names to their index in a machine.
+	ints  map[string]int
+	strs  map[string]int
+	bools map[string]int
+}
+
+// New parses the Snowball program src and returns a Stemmer running it.
+// The file name is only used in error messages.
+func New(file string, src []byte) (*Stemmer, error) {
+	prog, err := sbl.Parse(file, src)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := prog.Entry()
+	if entry == nil {
+		return nil, fmt.Errorf("interp: %s: no external routine to call", file)
+	}
+	for _, r := range prog.Routines {
+		if err := check(r.Body); err != nil {
+			return nil, fmt.Errorf("interp: %s: %s: %w", file, r.Name, err)
+		}
+	}
+
+	s := &Stemmer{
+		prog:      prog,
+		entry:     entry,
+		routines:  map[string]*sbl.Routine{},
+		groupings: map[string]*snowball.Grouping{},
+		ints:      indexOf(prog.Integers),
+		strs:      indexOf(prog.Strings),
+		bools:     indexOf(prog.Booleans),
+	}
+	for _, r := range prog.Routines {
+		s.routines[r.Name] = r
+	}
+	for _, g := range prog.Groupings {
+		s.groupings[g.Name] = snowball.NewGrouping(g.Chars)
+	}
+	for _, a := range prog.Amongs {
+		s.amongs = append(s.amongs, s.among(a))
+	}
+	return s, nil
+}
+
+// NewFromFile reads the Snowball program in the named file and returns a
+// Stemmer running it.
+func NewFromFile(path string) (*Stemmer, error) {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return New(filepath.Base(path), src)
+}
+
+// Stem returns the stem of the given word.
+func (s *Stemmer) Stem(word string) string {
+	m := &machine{
+		s:     s,
+		env:   snowball.NewEnv(strings.ToLower(word)),
+		ints:  make([]int, len(s.ints)),
+		strs:  make([]string, len(s.strs)),
+		bools: make([]bool, len(s.bools)),
+	}
+	m.call(s.entry)
+	return m.env.Current()
+}
+
+func indexOf(names []string) map[string]int {
+	index := make(map[string]int, len(names))
+	for i, name := range names {
+		index[name] = i
+	}
+	return index
+}
+
+// among builds the runtime table of a. Entries report the number of their
+// action, or one past the last action if they have none.
+func (s *Stemmer) among(a *sbl.Among) *snowball.Among {
+	entries := make([]snowball.AmongEntry, len(a.Entries))
+	for i, e := range a.Entries {
+		entries[i] = snowball.AmongEntry{S: e.S, Result: len(a.Actions) + 1}
+		if e.Action >= 0 {
+			entries[i].Result = e.Action + 1
+		}
+		if e.Routine != "" {
+			r := s.routines[e.Routine]
+			entries[i].Cond = func(env *snowball.Env, ctx interface{}) bool {
+				return ctx.(*machine).call(r)
+			}
+		}
+	}
+	return snowball.NewAmong(entries)
+}
+
+// check reports the commands of c that the interpreter does not support.
+func check(c sbl.Command) error {
+	switch c := c.(type) {
+	case *sbl.Seq:
+		for _, sub := range c.List {
+			if err := check(sub); err != nil {
+				return err
+			}
+		}
+	case *sbl.Or:
+		return checkAll(c.Left, c.Right)
+	case *sbl.And:
+		return checkAll(c.Left, c.Right)
+	case *sbl.Unary:
+		if c.Op == "reverse" {
+			return fmt.Errorf("reverse is not supported")
+		}
+		return check(c.C)
+	case *sbl.Loop:
+		return check(c.C)
+	case *sbl.Atleast:
+		return check(c.C)
+	case *sbl.Setlimit:
+		return checkAll(c.Limit, c.C)
+	case *sbl.Among:
+		if c.Starter != nil {
+			if err := check(c.Starter); err != nil {
+				return err
+			}
+		}
+		return checkAll(c.Actions...)
+	}
+	return nil
+}
+
+func checkAll(list ...sbl.Command) error {
+	for _, c := range list {
+		if err := check(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
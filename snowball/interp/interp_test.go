@@ -0,0 +1,112 @@
+package interp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/machine23/ugu-stemmer/snowball/algorithms"
+)
+
+func TestNew(t *testing.T) {
+	s, err := New("plural.sbl", []byte(`
+routines ( Step )
+externals ( stem )
+define stem as ( backwards Step )
+backwardmode ( define Step as ( ['s'] delete ) )
+`))
+	require.NoError(t, err)
+	require.Equal(t, "cat", s.Stem("Cats"))
+
+	_, err = New("bad.sbl", []byte("externals ( stem )\ndefine stem as x"))
+	require.EqualError(t, err, "bad.sbl:2: x is not declared")
+
+	_, err = New("none.sbl", []byte("routines ( r )\ndefine r as true"))
+	require.EqualError(t, err, "interp: none.sbl: no external routine to call")
+
+	_, err = New("reverse.sbl", []byte("externals ( stem )\ndefine stem as reverse true"))
+	require.EqualError(t, err, "interp: reverse.sbl: stem: reverse is not supported")
+}
+
+func TestNewFromFile(t *testing.T) {
+	s, err := NewFromFile("../algorithms/porter.sbl")
+	require.NoError(t, err)
+	require.Equal(t, "gener", s.Stem("generalization"))
+
+	_, err = NewFromFile("../algorithms/missing.sbl")
+	require.Error(t, err)
+}
+
+// TestStemmer_Stem checks the interpreter against the stemmers generated
+// from the same programs.
+func TestStemmer_Stem(t *testing.T) {
+	words := []string{
+		"caresses", "ponies", "agreed", "hopping", "relational", "electrical",
+		"generously", "happiness", "conditional", "skies", "dying", "news",
+		"succeeding", "y'all", "yellow", "sky", "",
+		"вечерний", "бегавшая", "ёлками", "красивейшими", "написанного",
+		"образовательность", "умывшись", "самый",
+		"mangiandolo", "parlargli", "dargli", "città", "amichevolmente",
+	}
+	for _, name := range []string{"porter", "english", "russian", "italian"} {
+		s, err := NewFromFile("../algorithms/" + name + ".sbl")
+		require.NoError(t, err)
+		generated := algorithms.Stemmers[name]()
+		for _, w := range words {
+			require.Equal(t, generated.Stem(w), s.Stem(w), "%s: %s", name, w)
+		}
+	}
+}
+
+func TestStemmer_Stem_variables(t *testing.T) {
+	s, err := New("vars.sbl", []byte(`
+externals ( stem )
+integers ( n )
+strings ( s )
+booleans ( b )
+groupings ( v c )
+define v 'aeiou'
+define c 'abcdefghijklmnopqrstuvwxyz' - v
+
+define stem as (
+    $n = len
+    $n > 3
+    do ( [ hop 2 ] -> s )
+    not b
+    setlimit tomark 2 for ( atleast 2 gopast c atlimit )
+    $n *= 2
+    $n /= 0
+    $n == 0
+    tolimit
+    attach s
+    set b
+    b
+)
+`))
+	require.NoError(t, err)
+	require.Equal(t, "stemsst", s.Stem("stems"))
+	require.Equal(t, "ab", s.Stem("ab"))
+}
+
+func TestStemmer_Stem_setlimit(t *testing.T) {
+	f := func(src, word, stem string) {
+		t.Helper()
+		s, err := New("limit.sbl", []byte("externals ( stem )\n"+src))
+		require.NoError(t, err)
+
+		done := make(chan string, 1)
+		go func() { done <- s.Stem(word) }()
+		select {
+		case actual := <-done:
+			require.Equal(t, stem, actual)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("Stem(%q) does not return", word)
+		}
+	}
+
+	f("define stem as ( setlimit hop 2 for ( ['a'] delete ) repeat next )", "abcd", "bcd")
+	f("define stem as ( setlimit hop 2 for ( insert 'xx' ) tolimit insert '!' )", "abcd", "xxabcd!")
+	f("define stem as ( setlimit hop 1 for ( ['a'] <- 'aaa' ) ['d'] delete )", "abcd", "aaabcd")
+	f("define stem as backwards ( setlimit tomark 2 for ( ['d'] delete ) tolimit insert '-' )", "abcd", "-abc")
+}
@@ -0,0 +1,444 @@
+package interp
+
+import (
+	"fmt"
+
+	"github.com/machine23/ugu-stemmer/snowball"
+	"github.com/machine23/ugu-stemmer/snowball/sbl"
+)
+
+// machine is the state of one run of a Stemmer on a word.
+type machine struct {
+	s     *Stemmer
+	env   *snowball.Env
+	ints  []int
+	strs  []string
+	bools []bool
+
+	// backward is set while running backward-mode commands.
+	backward bool
+	// amongVar is the result of the last among or substring of the
+	// current routine.
+	amongVar int
+}
+
+// call runs routine r in its own mode.
+func (m *machine) call(r *sbl.Routine) bool {
+	backward, amongVar := m.backward, m.amongVar
+	m.backward = r.Backward
+	ok := m.run(r.Body)
+	m.backward, m.amongVar = backward, amongVar
+	return ok
+}
+
+// save returns the cursor in a form that survives changes at the other end
+// of the string: the distance from the limit in backward mode.
+func (m *machine) save() int {
+	if m.backward {
+		return m.env.Limit - m.env.Cursor
+	}
+	return m.env.Cursor
+}
+
+// restore moves the cursor back to a position returned by save.
+func (m *machine) restore(v int) {
+	if m.backward {
+		m.env.Cursor = m.env.Limit - v
+	} else {
+		m.env.Cursor = v
+	}
+}
+
+// next moves the cursor one character in the direction of the mode.
+func (m *machine) next() bool {
+	if m.backward {
+		return m.env.Prev()
+	}
+	return m.env.Next()
+}
+
+// limit returns the limit in the direction of the mode.
+func (m *machine) limit() int {
+	if m.backward {
+		return m.env.LimitBackward
+	}
+	return m.env.Limit
+}
+
+// run runs c and reports whether it succeeded.
+func (m *machine) run(c sbl.Command) bool {
+	env := m.env
+	switch c := c.(type) {
+	case *sbl.Seq:
+		for _, sub := range c.List {
+			if !m.run(sub) {
+				return false
+			}
+		}
+		return true
+	case *sbl.Or:
+		v := m.save()
+		if m.run(c.Left) {
+			return true
+		}
+		m.restore(v)
+		return m.run(c.Right)
+	case *sbl.And:
+		v := m.save()
+		if !m.run(c.Left) {
+			return false
+		}
+		m.restore(v)
+		return m.run(c.Right)
+	case *sbl.Unary:
+		return m.unary(c)
+	case *sbl.Loop:
+		for i := m.eval(c.Count); i > 0; i-- {
+			if !m.run(c.C) {
+				return false
+			}
+		}
+		return true
+	case *sbl.Atleast:
+		n := m.eval(c.Count)
+		for {
+			v := m.save()
+			if !m.run(c.C) {
+				m.restore(v)
+				return n <= 0
+			}
+			n--
+		}
+	case *sbl.Setlimit:
+		return m.setlimit(c)
+	case *sbl.Simple:
+		return m.simple(c.Op)
+	case *sbl.Literal:
+		if m.backward {
+			return env.EqSB(c.S)
+		}
+		return env.EqS(c.S)
+	case *sbl.Ref:
+		return m.ref(c)
+	case *sbl.SliceFrom:
+		return env.SliceFrom(m.str(c.S))
+	case *sbl.Insert:
+		c0 := env.Cursor
+		env.Insert(env.Cursor, env.Cursor, m.str(c.S))
+		// Insert leaves the cursor after the new text in forward mode and
+		// attach in backward mode; otherwise the cursor stays.
+		if c.Attach != m.backward {
+			env.Cursor = c0
+		}
+		return true
+	case *sbl.SliceTo:
+		m.strs[m.s.strs[c.Var]] = env.SliceTo()
+		return true
+	case *sbl.AssignTo:
+		m.strs[m.s.strs[c.Var]] = env.AssignTo()
+		return true
+	case *sbl.SetBool:
+		m.bools[m.s.bools[c.Var]] = c.Value
+		return true
+	case *sbl.Hop:
+		if m.backward {
+			return env.HopBack(m.eval(c.N))
+		}
+		return env.Hop(m.eval(c.N))
+	case *sbl.Tomark:
+		pos := m.eval(c.Pos)
+		if m.backward && env.Cursor < pos || !m.backward && env.Cursor > pos {
+			return false
+		}
+		env.Cursor = pos
+		return true
+	case *sbl.Atmark:
+		return env.Cursor == m.eval(c.Pos)
+	case *sbl.Setmark:
+		m.ints[m.s.ints[c.Var]] = env.Cursor
+		return true
+	case *sbl.IntAssign:
+		m.assign(c)
+		return true
+	case *sbl.IntTest:
+		return compare(m.eval(c.Left), c.Op, m.eval(c.Right))
+	case *sbl.Substring:
+		return m.findAmong(c.Among)
+	case *sbl.Among:
+		if !c.Substring && !m.findAmong(c) {
+			return false
+		}
+		if c.Starter != nil && !m.run(c.Starter) {
+			return false
+		}
+		if m.amongVar > len(c.Actions) {
+			return true
+		}
+		return m.run(c.Actions[m.amongVar-1])
+	}
+	panic(fmt.Sprintf("interp: unknown command %T", c))
+}
+
+func (m *machine) unary(c *sbl.Unary) bool {
+	switch c.Op {
+	case "not":
+		v := m.save()
+		if m.run(c.C) {
+			return false
+		}
+		m.restore(v)
+		return true
+	case "test":
+		v := m.save()
+		if !m.run(c.C) {
+			return false
+		}
+		m.restore(v)
+		return true
+	case "try":
+		v := m.save()
+		if !m.run(c.C) {
+			m.restore(v)
+		}
+		return true
+	case "do":
+		v := m.save()
+		m.run(c.C)
+		m.restore(v)
+		return true
+	case "fail":
+		m.run(c.C)
+		return false
+	case "goto", "gopast":
+		for {
+			v := m.save()
+			if m.run(c.C) {
+				if c.Op == "goto" {
+					m.restore(v)
+				}
+				return true
+			}
+			m.restore(v)
+			if !m.next() {
+				return false
+			}
+		}
+	case "repeat":
+		for {
+			v := m.save()
+			if !m.run(c.C) {
+				m.restore(v)
+				return true
+			}
+		}
+	case "backwards":
+		env := m.env
+		env.LimitBackward = env.Cursor
+		env.Cursor = env.Limit
+		m.backward = true
+		ok := m.run(c.C)
+		m.backward = false
+		if ok {
+			env.Cursor = env.LimitBackward
+		}
+		return ok
+	}
+	panic("interp: unsupported command " + c.Op)
+}
+
+// setlimit runs setlimit C1 for C2: C1 moves the cursor to the new limit,
+// then C2 runs from the old cursor with the limit in place. The forward
+// limit moves with every edit C2 makes, so the old one is restored relative
+// to it.
+func (m *machine) setlimit(c *sbl.Setlimit) bool {
+	env := m.env
+	v := m.save()
+	if !m.run(c.Limit) {
+		return false
+	}
+	if m.backward {
+		old := env.LimitBackward
+		env.LimitBackward = env.Cursor
+		m.restore(v)
+		ok := m.run(c.C)
+		env.LimitBackward = old
+		return ok
+	}
+	old := env.Limit - env.Cursor
+	env.Limit = env.Cursor
+	m.restore(v)
+	ok := m.run(c.C)
+	env.Limit += old
+	return ok
+}
+
+func (m *machine) simple(op string) bool {
+	env := m.env
+	switch op {
+	case "[":
+		if m.backward {
+			env.Ket = env.Cursor
+		} else {
+			env.Bra = env.Cursor
+		}
+	case "]":
+		if m.backward {
+			env.Bra = env.Cursor
+		} else {
+			env.Ket = env.Cursor
+		}
+	case "delete":
+		return env.SliceDel()
+	case "next":
+		return m.next()
+	case "atlimit":
+		return env.Cursor == m.limit()
+	case "tolimit":
+		env.Cursor = m.limit()
+	case "true", "?":
+	case "false":
+		return false
+	default:
+		panic("interp: unsupported command " + op)
+	}
+	return true
+}
+
+func (m *machine) ref(c *sbl.Ref) bool {
+	env := m.env
+	switch c.Kind {
+	case sbl.KindRoutine, sbl.KindExternal:
+		return m.call(m.s.routines[c.Name])
+	case sbl.KindGrouping:
+		g := m.s.groupings[c.Name]
+		switch {
+		case m.backward && c.Non:
+			return env.OutGroupingB(g)
+		case m.backward:
+			return env.InGroupingB(g)
+		case c.Non:
+			return env.OutGrouping(g)
+		default:
+			return env.InGrouping(g)
+		}
+	case sbl.KindBoolean:
+		return m.bools[m.s.bools[c.Name]]
+	case sbl.KindString:
+		s := m.strs[m.s.strs[c.Name]]
+		if m.backward {
+			return env.EqSB(s)
+		}
+		return env.EqS(s)
+	}
+	panic(fmt.Sprintf("interp: %s %s used as a command", c.Kind, c.Name))
+}
+
+func (m *machine) str(s sbl.Str) string {
+	if s.Var != "" {
+		return m.strs[m.s.strs[s.Var]]
+	}
+	return s.Lit
+}
+
+// findAmong matches a at the cursor and records the result for the
+// actions of a.
+func (m *machine) findAmong(a *sbl.Among) bool {
+	table := m.s.amongs[a.ID]
+	if m.backward {
+		m.amongVar = m.env.FindAmongB(table, m)
+	} else {
+		m.amongVar = m.env.FindAmong(table, m)
+	}
+	return m.amongVar != 0
+}
+
+func (m *machine) assign(c *sbl.IntAssign) {
+	p := &m.ints[m.s.ints[c.Var]]
+	x := m.eval(c.X)
+	switch c.Op {
+	case "=":
+		*p = x
+	case "+=":
+		*p += x
+	case "-=":
+		*p -= x
+	case "*=":
+		*p *= x
+	case "/=":
+		*p = divide(*p, x)
+	}
+}
+
+func compare(x int, op string, y int) bool {
+	switch op {
+	case "==":
+		return x == y
+	case "!=":
+		return x != y
+	case "<":
+		return x < y
+	case "<=":
+		return x <= y
+	case ">":
+		return x > y
+	case ">=":
+		return x >= y
+	}
+	panic("interp: unknown comparison " + op)
+}
+
+// divide divides x by y, giving 0 for a zero y so that a faulty program
+// cannot crash the caller.
+func divide(x, y int) int {
+	if y == 0 {
+		return 0
+	}
+	return x / y
+}
+
+// eval returns the value of an arithmetic expression.
+func (m *machine) eval(x sbl.Expr) int {
+	env := m.env
+	switch x := x.(type) {
+	case *sbl.Num:
+		return x.Value
+	case *sbl.Var:
+		return m.ints[m.s.ints[x.Name]]
+	case *sbl.Builtin:
+		switch x.Name {
+		case "cursor":
+			return env.Cursor
+		case "limit":
+			return m.limit()
+		case "size":
+			return env.Size()
+		case "len":
+			return env.Len()
+		case "maxint":
+			return snowball.MaxInt
+		case "minint":
+			return snowball.MinInt
+		}
+	case *sbl.SizeOf:
+		s := m.strs[m.s.strs[x.Var]]
+		if x.Chars {
+			return snowball.LenOf(s)
+		}
+		return len(s)
+	case *sbl.Binary:
+		a, b := m.eval(x.X), m.eval(x.Y)
+		switch x.Op {
+		case "+":
+			return a + b
+		case "-":
+			return a - b
+		case "*":
+			return a * b
+		case "/":
+			return divide(a, b)
+		}
+	case *sbl.Neg:
+		return -m.eval(x.X)
+	}
+	panic(fmt.Sprintf("interp: unknown expression %T", x))
+}
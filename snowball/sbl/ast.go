@@ -0,0 +1,321 @@
+package sbl
+
+// Kind is the kind of a declared name.
+type Kind int
+
+const (
+	KindString Kind = iota + 1
+	KindInteger
+	KindBoolean
+	KindRoutine
+	KindExternal
+	KindGrouping
+)
+
+func (k Kind) String() string {
+	switch k {
+	case KindString:
+		return "string"
+	case KindInteger:
+		return "integer"
+	case KindBoolean:
+		return "boolean"
+	case KindRoutine:
+		return "routine"
+	case KindExternal:
+		return "external"
+	case KindGrouping:
+		return "grouping"
+	default:
+		return "unknown"
+	}
+}
+
+// Program is a parsed Snowball source file.
+type Program struct {
+	// Names maps every declared name to its kind.
+	Names map[string]Kind
+	// Strings, Integers and Booleans list the declared variables in
+	// declaration order.
+	Strings  []string
+	Integers []string
+	Booleans []string
+	// Routines lists the defined routines and externals in definition
+	// order.
+	Routines []*Routine
+	// Groupings lists the defined groupings in definition order.
+	Groupings []*Grouping
+	// Amongs lists every among of the program; Among.ID indexes it.
+	Amongs []*Among
+}
+
+// Routine returns the routine or external called name, or nil.
+func (p *Program) Routine(name string) *Routine {
+	for _, r := range p.Routines {
+		if r.Name == name {
+			return r
+		}
+	}
+	return nil
+}
+
+// Entry returns the external that stems a word: the one called stem, or
+// else the first external. It returns nil if there is no external.
+func (p *Program) Entry() *Routine {
+	var first *Routine
+	for _, r := range p.Routines {
+		if !r.External {
+			continue
+		}
+		if r.Name == "stem" {
+			return r
+		}
+		if first == nil {
+			first = r
+		}
+	}
+	return first
+}
+
+// Routine is a routine or external defined with define r as C.
+type Routine struct {
+	Name     string
+	External bool
+	// Backward is set for routines defined inside backwardmode.
+	Backward bool
+	Body     Command
+	Line     int
+}
+
+// Grouping is a grouping defined with define g G.
+type Grouping struct {
+	Name string
+	// Chars holds every character of the grouping once, in order of
+	// first appearance.
+	Chars string
+	Line  int
+}
+
+// Command is a Snowball command. It is one of the pointer types below.
+type Command interface {
+	command()
+}
+
+// Seq is a bracketed sequence of commands, ( C1 C2 ... ).
+type Seq struct {
+	List []Command
+}
+
+// Or is C1 or C2; And is C1 and C2.
+type (
+	Or struct {
+		Left, Right Command
+	}
+	And struct {
+		Left, Right Command
+	}
+)
+
+// Unary is a prefix command applied to C. Op is one of not, test, try,
+// do, fail, goto, gopast, repeat, backwards and reverse.
+type Unary struct {
+	Op string
+	C  Command
+}
+
+// Loop is loop AE C; Atleast is atleast AE C.
+type (
+	Loop struct {
+		Count Expr
+		C     Command
+	}
+	Atleast struct {
+		Count Expr
+		C     Command
+	}
+)
+
+// Setlimit is setlimit C1 for C2.
+type Setlimit struct {
+	Limit Command
+	C     Command
+}
+
+// Simple is a command without operands. Op is one of [, ], delete, next,
+// atlimit, tolimit, true, false, substring and ?.
+type Simple struct {
+	Op string
+}
+
+// Literal matches a literal string.
+type Literal struct {
+	S string
+}
+
+// Ref is a bare name used as a command: a routine call, a grouping test, a
+// boolean test or a match against a string variable, depending on Kind.
+// Non is set for non g and non-g.
+type Ref struct {
+	Name string
+	Kind Kind
+	Non  bool
+}
+
+// Str is a string operand: a literal, or a string variable when Var is set.
+type Str struct {
+	Lit string
+	Var string
+}
+
+// SliceFrom is <- S.
+type SliceFrom struct {
+	S Str
+}
+
+// Insert is insert S or <+ S, or attach S when Attach is set.
+type Insert struct {
+	S      Str
+	Attach bool
+}
+
+// SliceTo is -> s; AssignTo is => s.
+type (
+	SliceTo struct {
+		Var string
+	}
+	AssignTo struct {
+		Var string
+	}
+)
+
+// SetBool is set b, or unset b when Value is false.
+type SetBool struct {
+	Var   string
+	Value bool
+}
+
+// Hop is hop AE, Tomark is tomark AE and Atmark is atmark AE.
+type (
+	Hop struct {
+		N Expr
+	}
+	Tomark struct {
+		Pos Expr
+	}
+	Atmark struct {
+		Pos Expr
+	}
+)
+
+// Setmark is setmark i.
+type Setmark struct {
+	Var string
+}
+
+// IntAssign is $i op AE with op one of =, +=, -=, *= and /=.
+type IntAssign struct {
+	Var string
+	Op  string
+	X   Expr
+}
+
+// IntTest compares two arithmetic expressions, written $i op AE or
+// $(AE op AE). Op is one of ==, !=, <, <=, > and >=.
+type IntTest struct {
+	Left  Expr
+	Op    string
+	Right Expr
+}
+
+// Among is among ( ... ). If Substring is set, an earlier substring in the
+// same routine has already done the matching and the among only dispatches
+// on the result. Starter is the optional command written first, as in
+// among ( (C) ... ); it runs after a match and before the action.
+type Among struct {
+	ID        int
+	Entries   []AmongEntry
+	Actions   []Command
+	Starter   Command
+	Substring bool
+}
+
+// AmongEntry is a string of an among. Action indexes Among.Actions, or is
+// -1 if the string has no action; Routine names an optional condition.
+type AmongEntry struct {
+	S       string
+	Routine string
+	Action  int
+}
+
+// Substring is the substring that hands its match to Among.
+type Substring struct {
+	Among *Among
+}
+
+func (*Seq) command()       {}
+func (*Or) command()        {}
+func (*And) command()       {}
+func (*Unary) command()     {}
+func (*Loop) command()      {}
+func (*Atleast) command()   {}
+func (*Setlimit) command()  {}
+func (*Simple) command()    {}
+func (*Literal) command()   {}
+func (*Ref) command()       {}
+func (*SliceFrom) command() {}
+func (*Insert) command()    {}
+func (*SliceTo) command()   {}
+func (*AssignTo) command()  {}
+func (*SetBool) command()   {}
+func (*Hop) command()       {}
+func (*Tomark) command()    {}
+func (*Atmark) command()    {}
+func (*Setmark) command()   {}
+func (*IntAssign) command() {}
+func (*IntTest) command()   {}
+func (*Among) command()     {}
+func (*Substring) command() {}
+
+// Expr is an arithmetic expression. It is one of the pointer types below.
+type Expr interface {
+	expr()
+}
+
+// Num is an integer literal.
+type Num struct {
+	Value int
+}
+
+// Var is an integer variable.
+type Var struct {
+	Name string
+}
+
+// Builtin is one of cursor, limit, size, len, maxint and minint.
+type Builtin struct {
+	Name string
+}
+
+// SizeOf is sizeof s, or lenof s when Chars is set.
+type SizeOf struct {
+	Var   string
+	Chars bool
+}
+
+// Binary is X op Y with op one of +, -, * and /.
+type Binary struct {
+	Op   string
+	X, Y Expr
+}
+
+// Neg is -X.
+type Neg struct {
+	X Expr
+}
+
+func (*Num) expr()     {}
+func (*Var) expr()     {}
+func (*Builtin) expr() {}
+func (*SizeOf) expr()  {}
+func (*Binary) expr()  {}
+func (*Neg) expr()     {}
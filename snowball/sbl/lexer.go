@@ -0,0 +1,216 @@
+package sbl
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokName
+	tokNumber
+	tokString
+	tokOp
+)
+
+type token struct {
+	kind tokenKind
+	// text is the name, the number, the operator, or the value of a string
+	// literal with its escapes resolved.
+	text string
+	line int
+}
+
+func (t token) String() string {
+	switch t.kind {
+	case tokEOF:
+		return "end of file"
+	case tokString:
+		return strconv.Quote(t.text)
+	default:
+		return t.text
+	}
+}
+
+// operators lists the operators, two-character ones first.
+var operators = []string{
+	"<-", "<+", "->", "=>", "==", "!=", "<=", ">=", "+=", "-=", "*=", "/=",
+	"(", ")", "[", "]", "$", "=", "<", ">", "+", "-", "*", "/", "?",
+}
+
+// lexer splits Snowball source into tokens. It also resolves the escapes in
+// string literals, which depend on the stringescapes and stringdef
+// declarations seen so far.
+type lexer struct {
+	src  string
+	pos  int
+	line int
+
+	escapes    bool
+	escOpen    rune
+	escClose   rune
+	stringdefs map[string]string
+}
+
+func newLexer(src string) *lexer {
+	return &lexer{src: src, line: 1, stringdefs: map[string]string{}}
+}
+
+func (l *lexer) errorf(format string, args ...interface{}) {
+	panic(&Error{Line: l.line, Msg: fmt.Sprintf(format, args...)})
+}
+
+// skipSpace skips white space and comments.
+func (l *lexer) skipSpace() {
+	for l.pos < len(l.src) {
+		switch {
+		case l.src[l.pos] == '\n':
+			l.line++
+			l.pos++
+		case l.src[l.pos] == ' ' || l.src[l.pos] == '\t' || l.src[l.pos] == '\r':
+			l.pos++
+		case strings.HasPrefix(l.src[l.pos:], "//"):
+			for l.pos < len(l.src) && l.src[l.pos] != '\n' {
+				l.pos++
+			}
+		case strings.HasPrefix(l.src[l.pos:], "/*"):
+			end := strings.Index(l.src[l.pos+2:], "*/")
+			if end < 0 {
+				l.errorf("unterminated comment")
+			}
+			l.line += strings.Count(l.src[l.pos:l.pos+2+end], "\n")
+			l.pos += end + 4
+		default:
+			return
+		}
+	}
+}
+
+// next returns the next token.
+func (l *lexer) next() token {
+	l.skipSpace()
+	if l.pos >= len(l.src) {
+		return token{kind: tokEOF, line: l.line}
+	}
+
+	start := l.pos
+	c := l.src[l.pos]
+	switch {
+	case isLetter(c):
+		for l.pos < len(l.src) && (isLetter(l.src[l.pos]) || isDigit(l.src[l.pos]) || l.src[l.pos] == '_') {
+			l.pos++
+		}
+		return token{kind: tokName, text: l.src[start:l.pos], line: l.line}
+	case isDigit(c):
+		for l.pos < len(l.src) && isDigit(l.src[l.pos]) {
+			l.pos++
+		}
+		return token{kind: tokNumber, text: l.src[start:l.pos], line: l.line}
+	case c == '\'':
+		line := l.line
+		return token{kind: tokString, text: l.stringLiteral(), line: line}
+	}
+
+	for _, op := range operators {
+		if strings.HasPrefix(l.src[l.pos:], op) {
+			l.pos += len(op)
+			return token{kind: tokOp, text: op, line: l.line}
+		}
+	}
+	r, _ := utf8.DecodeRuneInString(l.src[l.pos:])
+	l.errorf("unexpected character %q", r)
+	return token{}
+}
+
+// stringLiteral reads a quoted string at the current position and returns
+// its value.
+func (l *lexer) stringLiteral() string {
+	l.pos++ // opening quote
+	var b strings.Builder
+	for {
+		if l.pos >= len(l.src) {
+			l.errorf("unterminated string")
+		}
+		r, size := utf8.DecodeRuneInString(l.src[l.pos:])
+		l.pos += size
+		switch {
+		case r == '\'':
+			return b.String()
+		case r == '\n':
+			l.errorf("newline in string")
+		case l.escapes && r == l.escOpen:
+			end := strings.IndexRune(l.src[l.pos:], l.escClose)
+			if end < 0 {
+				l.errorf("unterminated escape in string")
+			}
+			b.WriteString(l.escape(l.src[l.pos : l.pos+end]))
+			l.pos += end + utf8.RuneLen(l.escClose)
+		default:
+			b.WriteRune(r)
+		}
+	}
+}
+
+// escape returns the value of the escape {code}: a stringdef, U+XXXX, a
+// quote or the opening escape character itself.
+func (l *lexer) escape(code string) string {
+	if value, ok := l.stringdefs[code]; ok {
+		return value
+	}
+	switch {
+	case code == "'":
+		return "'"
+	case code == string(l.escOpen):
+		return code
+	case strings.HasPrefix(code, "U+"):
+		n, err := strconv.ParseUint(code[2:], 16, 32)
+		if err != nil || !utf8.ValidRune(rune(n)) {
+			l.errorf("bad character code %q", code)
+		}
+		return string(rune(n))
+	}
+	l.errorf("undefined escape %q", code)
+	return ""
+}
+
+// rawChar returns the next non-space character, for stringescapes.
+func (l *lexer) rawChar() rune {
+	l.skipSpace()
+	if l.pos >= len(l.src) {
+		l.errorf("unexpected end of file")
+	}
+	r, size := utf8.DecodeRuneInString(l.src[l.pos:])
+	l.pos += size
+	return r
+}
+
+// rawWord returns the next run of non-space characters, for the name of a
+// stringdef, which may contain quotes and punctuation.
+func (l *lexer) rawWord() string {
+	l.skipSpace()
+	start := l.pos
+	for l.pos < len(l.src) {
+		r, size := utf8.DecodeRuneInString(l.src[l.pos:])
+		if unicode.IsSpace(r) {
+			break
+		}
+		l.pos += size
+	}
+	if start == l.pos {
+		l.errorf("unexpected end of file")
+	}
+	return l.src[start:l.pos]
+}
+
+func isLetter(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
@@ -0,0 +1,633 @@
+// Package sbl parses programs written in the Snowball string processing
+// language, the language the reference stemming algorithms are published
+// in, into an abstract syntax tree.
+//
+// The string commands $s C, which run C on a string variable, and get
+// directives are not supported and are reported as errors.
+package sbl
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Error is a syntax or semantic error in a Snowball program.
+type Error struct {
+	File string
+	Line int
+	Msg  string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s:%d: %s", e.File, e.Line, e.Msg)
+}
+
+// unaryOps are the prefix operators that take a single command.
+var unaryOps = map[string]bool{
+	"not": true, "test": true, "try": true, "do": true, "fail": true,
+	"goto": true, "gopast": true, "repeat": true, "backwards": true,
+	"reverse": true,
+}
+
+// simpleOps are the commands without operands that are spelt as names.
+var simpleOps = map[string]bool{
+	"delete": true, "next": true, "atlimit": true, "tolimit": true,
+	"true": true, "false": true,
+}
+
+type parser struct {
+	lex  *lexer
+	tok  token
+	prog *Program
+
+	// backward is set inside backwardmode.
+	backward bool
+	// substring is the substring of the current routine still waiting for
+	// its among.
+	substring *Substring
+	// declared maps every declared name to the line of its declaration.
+	declared map[string]int
+}
+
+// Parse parses the Snowball program src. The file name is only used in
+// error messages.
+func Parse(file string, src []byte) (prog *Program, err error) {
+	p := &parser{
+		lex:      newLexer(string(src)),
+		prog:     &Program{Names: map[string]Kind{}},
+		declared: map[string]int{},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			e, ok := r.(*Error)
+			if !ok {
+				panic(r)
+			}
+			e.File = file
+			prog, err = nil, e
+		}
+	}()
+
+	p.next()
+	for p.tok.kind != tokEOF {
+		p.topLevel()
+	}
+	p.checkDefined()
+	return p.prog, nil
+}
+
+func (p *parser) errorf(format string, args ...interface{}) {
+	panic(&Error{Line: p.tok.line, Msg: fmt.Sprintf(format, args...)})
+}
+
+func (p *parser) next() {
+	p.tok = p.lex.next()
+}
+
+// is reports whether the current token is the name or operator text.
+func (p *parser) is(text string) bool {
+	return (p.tok.kind == tokName || p.tok.kind == tokOp) && p.tok.text == text
+}
+
+func (p *parser) expect(text string) {
+	if !p.is(text) {
+		p.errorf("expected %s, found %s", text, p.tok)
+	}
+	p.next()
+}
+
+func (p *parser) name() string {
+	if p.tok.kind != tokName {
+		p.errorf("expected a name, found %s", p.tok)
+	}
+	name := p.tok.text
+	p.next()
+	return name
+}
+
+// nameOf reads a name that must have been declared with the given kind.
+func (p *parser) nameOf(kinds ...Kind) string {
+	line := p.tok.line
+	name := p.name()
+	kind := p.prog.Names[name]
+	for _, k := range kinds {
+		if kind == k {
+			return name
+		}
+	}
+	if kind == 0 {
+		panic(&Error{Line: line, Msg: fmt.Sprintf("%s is not declared", name)})
+	}
+	panic(&Error{Line: line, Msg: fmt.Sprintf("%s is a %s, expected a %s", name, kind, kinds[0])})
+}
+
+func (p *parser) topLevel() {
+	switch {
+	case p.is("strings"):
+		p.declare(KindString, &p.prog.Strings)
+	case p.is("integers"):
+		p.declare(KindInteger, &p.prog.Integers)
+	case p.is("booleans"):
+		p.declare(KindBoolean, &p.prog.Booleans)
+	case p.is("routines"):
+		p.declare(KindRoutine, nil)
+	case p.is("externals"):
+		p.declare(KindExternal, nil)
+	case p.is("groupings"):
+		p.declare(KindGrouping, nil)
+	case p.is("define"):
+		p.define()
+	case p.is("backwardmode"):
+		if p.backward {
+			p.errorf("nested backwardmode")
+		}
+		p.next()
+		p.expect("(")
+		p.backward = true
+		for !p.is(")") {
+			if p.tok.kind == tokEOF {
+				p.errorf("unterminated backwardmode")
+			}
+			p.topLevel()
+		}
+		p.backward = false
+		p.next()
+	case p.is("stringescapes"):
+		p.lex.escOpen = p.lex.rawChar()
+		p.lex.escClose = p.lex.rawChar()
+		p.lex.escapes = true
+		p.next()
+	case p.is("stringdef"):
+		name := p.lex.rawWord()
+		p.next()
+		p.lex.stringdefs[name] = p.stringdefValue()
+	default:
+		p.errorf("unexpected %s", p.tok)
+	}
+}
+
+// declare reads a declaration list such as integers ( p1 p2 ).
+func (p *parser) declare(kind Kind, list *[]string) {
+	p.next()
+	p.expect("(")
+	for !p.is(")") {
+		line := p.tok.line
+		name := p.name()
+		if _, ok := p.prog.Names[name]; ok {
+			panic(&Error{Line: line, Msg: fmt.Sprintf("%s declared twice", name)})
+		}
+		p.prog.Names[name] = kind
+		p.declared[name] = line
+		if list != nil {
+			*list = append(*list, name)
+		}
+	}
+	p.next()
+}
+
+// stringdefValue reads the value of a stringdef: a string, or hex or
+// decimal followed by a string of character codes.
+func (p *parser) stringdefValue() string {
+	base := 0
+	switch {
+	case p.is("hex"):
+		base = 16
+		p.next()
+	case p.is("decimal"):
+		base = 10
+		p.next()
+	}
+	if p.tok.kind != tokString {
+		p.errorf("expected a string, found %s", p.tok)
+	}
+	value := p.tok.text
+	if base != 0 {
+		var b strings.Builder
+		for _, code := range strings.Fields(value) {
+			n, err := strconv.ParseUint(code, base, 32)
+			if err != nil {
+				p.errorf("bad character code %q", code)
+			}
+			b.WriteRune(rune(n))
+		}
+		value = b.String()
+	}
+	p.next()
+	return value
+}
+
+func (p *parser) define() {
+	p.next()
+	line := p.tok.line
+	name := p.name()
+	switch p.prog.Names[name] {
+	case KindRoutine, KindExternal:
+		if p.prog.Routine(name) != nil {
+			panic(&Error{Line: line, Msg: fmt.Sprintf("%s defined twice", name)})
+		}
+		p.expect("as")
+		r := &Routine{
+			Name:     name,
+			External: p.prog.Names[name] == KindExternal,
+			Backward: p.backward,
+			Line:     line,
+		}
+		p.substring = nil
+		r.Body = p.command()
+		if p.substring != nil {
+			panic(&Error{Line: line, Msg: fmt.Sprintf("substring without among in %s", name)})
+		}
+		p.prog.Routines = append(p.prog.Routines, r)
+	case KindGrouping:
+		for _, g := range p.prog.Groupings {
+			if g.Name == name {
+				panic(&Error{Line: line, Msg: fmt.Sprintf("%s defined twice", name)})
+			}
+		}
+		p.prog.Groupings = append(p.prog.Groupings, &Grouping{Name: name, Chars: p.grouping(), Line: line})
+	case 0:
+		panic(&Error{Line: line, Msg: fmt.Sprintf("%s is not declared", name)})
+	default:
+		panic(&Error{Line: line, Msg: fmt.Sprintf("cannot define %s %s", p.prog.Names[name], name)})
+	}
+}
+
+// grouping reads the characters of a grouping definition, a list of
+// strings and earlier groupings joined by + and -.
+func (p *parser) grouping() string {
+	var chars []rune
+	add := func(s string) {
+		for _, r := range s {
+			if !strings.ContainsRune(string(chars), r) {
+				chars = append(chars, r)
+			}
+		}
+	}
+	remove := func(s string) {
+		kept := chars[:0]
+		for _, r := range chars {
+			if !strings.ContainsRune(s, r) {
+				kept = append(kept, r)
+			}
+		}
+		chars = kept
+	}
+
+	op := "+"
+	for {
+		var s string
+		if p.tok.kind == tokString {
+			s = p.tok.text
+			p.next()
+		} else {
+			line := p.tok.line
+			name := p.nameOf(KindGrouping)
+			g := p.findGrouping(name)
+			if g == nil {
+				panic(&Error{Line: line, Msg: fmt.Sprintf("grouping %s used before its definition", name)})
+			}
+			s = g.Chars
+		}
+		if op == "+" {
+			add(s)
+		} else {
+			remove(s)
+		}
+
+		if !p.is("+") && !p.is("-") {
+			return string(chars)
+		}
+		op = p.tok.text
+		p.next()
+	}
+}
+
+func (p *parser) findGrouping(name string) *Grouping {
+	for _, g := range p.prog.Groupings {
+		if g.Name == name {
+			return g
+		}
+	}
+	return nil
+}
+
+// command reads a command with its or and and operators, which have equal
+// precedence and group to the left.
+func (p *parser) command() Command {
+	c := p.primary()
+	for {
+		switch {
+		case p.is("or"):
+			p.next()
+			c = &Or{Left: c, Right: p.primary()}
+		case p.is("and"):
+			p.next()
+			c = &And{Left: c, Right: p.primary()}
+		default:
+			return c
+		}
+	}
+}
+
+// primary reads a command without or and and at its top level.
+func (p *parser) primary() Command {
+	switch p.tok.kind {
+	case tokString:
+		s := p.tok.text
+		p.next()
+		return &Literal{S: s}
+	case tokOp:
+		return p.operator()
+	case tokName:
+	default:
+		p.errorf("expected a command, found %s", p.tok)
+	}
+
+	word := p.tok.text
+	switch {
+	case unaryOps[word]:
+		p.next()
+		return &Unary{Op: word, C: p.primary()}
+	case simpleOps[word]:
+		p.next()
+		return &Simple{Op: word}
+	}
+
+	switch word {
+	case "loop", "atleast":
+		p.next()
+		n := p.expr()
+		c := p.primary()
+		if word == "loop" {
+			return &Loop{Count: n, C: c}
+		}
+		return &Atleast{Count: n, C: c}
+	case "hop":
+		p.next()
+		return &Hop{N: p.expr()}
+	case "tomark":
+		p.next()
+		return &Tomark{Pos: p.expr()}
+	case "atmark":
+		p.next()
+		return &Atmark{Pos: p.expr()}
+	case "setmark":
+		p.next()
+		return &Setmark{Var: p.nameOf(KindInteger)}
+	case "setlimit":
+		p.next()
+		limit := p.primary()
+		p.expect("for")
+		return &Setlimit{Limit: limit, C: p.primary()}
+	case "set", "unset":
+		p.next()
+		return &SetBool{Var: p.nameOf(KindBoolean), Value: word == "set"}
+	case "insert", "attach":
+		p.next()
+		return &Insert{S: p.str(), Attach: word == "attach"}
+	case "substring":
+		p.next()
+		if p.substring != nil {
+			p.errorf("substring without among")
+		}
+		p.substring = &Substring{}
+		return p.substring
+	case "among":
+		return p.among()
+	case "non":
+		p.next()
+		if p.is("-") {
+			p.next()
+		}
+		return &Ref{Name: p.nameOf(KindGrouping), Kind: KindGrouping, Non: true}
+	}
+
+	line := p.tok.line
+	name := p.name()
+	kind := p.prog.Names[name]
+	switch kind {
+	case KindRoutine, KindExternal, KindGrouping, KindBoolean, KindString:
+		return &Ref{Name: name, Kind: kind}
+	case 0:
+		panic(&Error{Line: line, Msg: fmt.Sprintf("%s is not declared", name)})
+	default:
+		panic(&Error{Line: line, Msg: fmt.Sprintf("%s %s cannot be used as a command", kind, name)})
+	}
+}
+
+// operator reads a command that starts with an operator.
+func (p *parser) operator() Command {
+	op := p.tok.text
+	switch op {
+	case "(":
+		p.next()
+		seq := &Seq{}
+		for !p.is(")") {
+			if p.tok.kind == tokEOF {
+				p.errorf("unterminated (")
+			}
+			seq.List = append(seq.List, p.command())
+		}
+		p.next()
+		return seq
+	case "[", "]", "?":
+		p.next()
+		return &Simple{Op: op}
+	case "<-":
+		p.next()
+		return &SliceFrom{S: p.str()}
+	case "<+":
+		p.next()
+		return &Insert{S: p.str()}
+	case "->":
+		p.next()
+		return &SliceTo{Var: p.nameOf(KindString)}
+	case "=>":
+		p.next()
+		return &AssignTo{Var: p.nameOf(KindString)}
+	case "$":
+		p.next()
+		return p.integerCommand()
+	}
+	p.errorf("expected a command, found %s", p.tok)
+	return nil
+}
+
+// integerCommand reads what follows $: either (AE op AE), or an integer
+// variable followed by an assignment or a comparison.
+func (p *parser) integerCommand() Command {
+	if p.is("(") {
+		p.next()
+		left := p.expr()
+		op := p.comparison()
+		right := p.expr()
+		p.expect(")")
+		return &IntTest{Left: left, Op: op, Right: right}
+	}
+
+	name := p.nameOf(KindInteger)
+	switch p.tok.text {
+	case "=", "+=", "-=", "*=", "/=":
+		if p.tok.kind != tokOp {
+			break
+		}
+		op := p.tok.text
+		p.next()
+		return &IntAssign{Var: name, Op: op, X: p.expr()}
+	}
+	op := p.comparison()
+	return &IntTest{Left: &Var{Name: name}, Op: op, Right: p.expr()}
+}
+
+func (p *parser) comparison() string {
+	switch {
+	case p.is("=="), p.is("!="), p.is("<"), p.is("<="), p.is(">"), p.is(">="):
+		op := p.tok.text
+		p.next()
+		return op
+	}
+	p.errorf("expected a comparison, found %s", p.tok)
+	return ""
+}
+
+// str reads a string operand: a literal or a string variable.
+func (p *parser) str() Str {
+	if p.tok.kind == tokString {
+		s := p.tok.text
+		p.next()
+		return Str{Lit: s}
+	}
+	return Str{Var: p.nameOf(KindString)}
+}
+
+// among reads among ( ... ) and links it to a waiting substring.
+func (p *parser) among() Command {
+	p.next()
+	p.expect("(")
+	a := &Among{ID: len(p.prog.Amongs)}
+	p.prog.Amongs = append(p.prog.Amongs, a)
+	// Link the substring before reading the actions, which may hold a
+	// substring and among of their own.
+	if p.substring != nil {
+		p.substring.Among = a
+		a.Substring = true
+		p.substring = nil
+	}
+	pending := 0 // entries still without an action
+	for !p.is(")") {
+		switch {
+		case p.tok.kind == tokString:
+			a.Entries = append(a.Entries, AmongEntry{S: p.tok.text, Action: -1})
+			pending++
+			p.next()
+			if p.tok.kind == tokName {
+				a.Entries[len(a.Entries)-1].Routine = p.nameOf(KindRoutine, KindExternal)
+			}
+		case p.is("(") && len(a.Entries) == 0:
+			if a.Starter != nil {
+				p.errorf("among command without strings")
+			}
+			a.Starter = p.operator()
+		case p.is("("):
+			action := p.operator()
+			for i := len(a.Entries) - pending; i < len(a.Entries); i++ {
+				a.Entries[i].Action = len(a.Actions)
+			}
+			a.Actions = append(a.Actions, action)
+			pending = 0
+		default:
+			p.errorf("expected a string or an action in among, found %s", p.tok)
+		}
+	}
+	p.next()
+
+	seen := map[string]bool{}
+	for _, e := range a.Entries {
+		if seen[e.S] {
+			p.errorf("duplicate string %q in among", e.S)
+		}
+		seen[e.S] = true
+	}
+	return a
+}
+
+// expr reads an arithmetic expression.
+func (p *parser) expr() Expr {
+	x := p.term()
+	for p.is("+") || p.is("-") {
+		op := p.tok.text
+		p.next()
+		x = &Binary{Op: op, X: x, Y: p.term()}
+	}
+	return x
+}
+
+func (p *parser) term() Expr {
+	x := p.factor()
+	for p.is("*") || p.is("/") {
+		op := p.tok.text
+		p.next()
+		x = &Binary{Op: op, X: x, Y: p.factor()}
+	}
+	return x
+}
+
+func (p *parser) factor() Expr {
+	switch p.tok.kind {
+	case tokNumber:
+		n, err := strconv.Atoi(p.tok.text)
+		if err != nil {
+			p.errorf("bad number %s", p.tok.text)
+		}
+		p.next()
+		return &Num{Value: n}
+	case tokOp:
+		switch p.tok.text {
+		case "-":
+			p.next()
+			return &Neg{X: p.factor()}
+		case "(":
+			p.next()
+			x := p.expr()
+			p.expect(")")
+			return x
+		}
+	case tokName:
+		switch word := p.tok.text; word {
+		case "cursor", "limit", "size", "len", "maxint", "minint":
+			p.next()
+			return &Builtin{Name: word}
+		case "sizeof", "lenof":
+			p.next()
+			return &SizeOf{Var: p.nameOf(KindString), Chars: word == "lenof"}
+		}
+		return &Var{Name: p.nameOf(KindInteger)}
+	}
+	p.errorf("expected an arithmetic expression, found %s", p.tok)
+	return nil
+}
+
+// checkDefined reports routines and groupings that were declared but never
+// defined.
+func (p *parser) checkDefined() {
+	names := make([]string, 0, len(p.prog.Names))
+	for name := range p.prog.Names {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		switch kind := p.prog.Names[name]; kind {
+		case KindRoutine, KindExternal:
+			if p.prog.Routine(name) == nil {
+				panic(&Error{Line: p.declared[name], Msg: fmt.Sprintf("%s %s is not defined", kind, name)})
+			}
+		case KindGrouping:
+			if p.findGrouping(name) == nil {
+				panic(&Error{Line: p.declared[name], Msg: fmt.Sprintf("grouping %s is not defined", name)})
+			}
+		}
+	}
+}
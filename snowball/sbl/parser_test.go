@@ -0,0 +1,118 @@
+package sbl
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testProgram = `
+stringescapes {}
+stringdef a" hex 'E4'
+
+routines ( R1 Step )
+externals ( stem )
+integers ( p1 )
+strings ( s )
+booleans ( found )
+groupings ( v vx )
+
+define v 'aeiou{a"}'
+define vx v + 'y' - 'u'
+
+define stem as (
+    $p1 = limit
+    do ( gopast v setmark p1 )
+    set found
+    backwards Step
+)
+
+backwardmode (
+    define R1 as $p1 <= cursor
+
+    define Step as (
+        [substring] R1 among (
+            'ies' (<-'i')
+            'ss' 's{a"}'
+            's'   (delete)
+        )
+        -> s
+    )
+)
+`
+
+func TestParse(t *testing.T) {
+	prog, err := Parse("test.sbl", []byte(testProgram))
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"s"}, prog.Strings)
+	require.Equal(t, []string{"p1"}, prog.Integers)
+	require.Equal(t, []string{"found"}, prog.Booleans)
+	require.Equal(t, KindGrouping, prog.Names["vx"])
+
+	require.Len(t, prog.Groupings, 2)
+	require.Equal(t, "aeiouä", prog.Groupings[0].Chars)
+	require.Equal(t, "aeioäy", prog.Groupings[1].Chars)
+
+	stem := prog.Routine("stem")
+	require.NotNil(t, stem)
+	require.True(t, stem.External)
+	require.False(t, stem.Backward)
+	require.True(t, prog.Routine("Step").Backward)
+	require.Nil(t, prog.Routine("missing"))
+
+	require.Len(t, prog.Amongs, 1)
+	a := prog.Amongs[0]
+	require.True(t, a.Substring)
+	require.Equal(t, []AmongEntry{
+		{S: "ies", Action: 0},
+		{S: "ss", Action: 1},
+		{S: "sä", Action: 1},
+		{S: "s", Action: 1},
+	}, a.Entries)
+	require.Len(t, a.Actions, 2)
+}
+
+func TestParse_amongStarter(t *testing.T) {
+	prog, err := Parse("test.sbl", []byte(`
+routines ( R )
+externals ( stem )
+define R as true
+define stem as among ( (R)
+    'ar' 'er' (delete)
+    'o'
+)
+`))
+	require.NoError(t, err)
+
+	require.Len(t, prog.Amongs, 1)
+	a := prog.Amongs[0]
+	require.Equal(t, &Seq{List: []Command{&Ref{Name: "R", Kind: KindRoutine}}}, a.Starter)
+	require.Equal(t, []AmongEntry{
+		{S: "ar", Action: 0},
+		{S: "er", Action: 0},
+		{S: "o", Action: -1},
+	}, a.Entries)
+	require.Len(t, a.Actions, 1)
+}
+
+func TestParse_errors(t *testing.T) {
+	f := func(src string, line int, msg string) {
+		t.Helper()
+		_, err := Parse("bad.sbl", []byte(src))
+		var e *Error
+		require.True(t, errors.As(err, &e), "%v", err)
+		require.Equal(t, "bad.sbl", e.File)
+		require.Equal(t, line, e.Line)
+		require.Contains(t, e.Msg, msg)
+	}
+
+	f("externals ( stem )\ndefine stem as x", 2, "x is not declared")
+	f("externals ( stem )\nexternals ( stem )", 2, "declared twice")
+	f("routines ( r )\nexternals ( stem )\ndefine stem as ( true )", 1, "r is not defined")
+	f("externals ( stem )\ndefine stem as ( 'abc", 2, "unterminated string")
+	f("externals ( stem )\ndefine stem as ( [substring] )", 2, "substring without among")
+	f("externals ( stem )\ndefine stem as among ( 'a' 'a' )", 2, "duplicate string")
+	f("externals ( stem )\ndefine stem as among ( (true) (false) 'a' )", 2, "among command without strings")
+}
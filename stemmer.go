@@ -1,48 +1,164 @@
 package ugustemmer
 
-import "github.com/machine23/ugu-stemmer/stemmer"
+import (
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/machine23/ugu-stemmer/stemmer"
+)
 
 type Stemmer interface {
 	Stem(word string) string
 }
 
+// ErrUnsupportedLanguage is returned by New for a language that has no
+// registered stemmer.
+type ErrUnsupportedLanguage struct {
+	Lang string
+}
+
+func (e ErrUnsupportedLanguage) Error() string {
+	return fmt.Sprintf("ugustemmer: unsupported language %q", e.Lang)
+}
+
+var (
+	registryMu sync.RWMutex
+	registry   = map[string]func() Stemmer{
+		"en":              func() Stemmer { return stemmer.NewEnglishStemmer() },
+		"english":         func() Stemmer { return stemmer.NewEnglishStemmer() },
+		"en-US":           func() Stemmer { return stemmer.NewEnglishStemmer() },
+		"es":              func() Stemmer { return stemmer.NewSpanishStemmer() },
+		"fr":              func() Stemmer { return stemmer.NewFrenchStemmer() },
+		"it":              func() Stemmer { return stemmer.NewItalianStemmer() },
+		"pt":              func() Stemmer { return stemmer.NewPortugueseStemmer() },
+		"ru":              func() Stemmer { return stemmer.NewRussianStemmer() },
+		"de":              func() Stemmer { return stemmer.NewGermanStemmer() },
+		"german2":         func() Stemmer { return stemmer.NewGerman2Stemmer() },
+		"nl":              func() Stemmer { return stemmer.NewDutchStemmer() },
+		"kraaij_pohlmann": func() Stemmer { return stemmer.NewKraaijPohlmannStemmer() },
+		"sv":              func() Stemmer { return stemmer.NewSwedishStemmer() },
+		"no":              func() Stemmer { return stemmer.NewNorwegianStemmer() },
+		"da":              func() Stemmer { return stemmer.NewDanishStemmer() },
+		"fi":              func() Stemmer { return stemmer.NewFinnishStemmer() },
+		"uk":              func() Stemmer { return stemmer.NewUkrainianStemmer() },
+		"be":              func() Stemmer { return stemmer.NewBelarusianStemmer() },
+		"bg":              func() Stemmer { return stemmer.NewBulgarianStemmer() },
+		"hu":              func() Stemmer { return stemmer.NewHungarianStemmer() },
+		"tr":              func() Stemmer { return stemmer.NewTurkishStemmer() },
+		"ar":              func() Stemmer { return stemmer.NewArabicStemmer() },
+		"el":              func() Stemmer { return stemmer.NewGreekStemmer() },
+		"ro":              func() Stemmer { return stemmer.NewRomanianStemmer() },
+		"ca":              func() Stemmer { return stemmer.NewCatalanStemmer() },
+		"id":              func() Stemmer { return stemmer.NewIndonesianStemmer() },
+		"lt":              func() Stemmer { return stemmer.NewLithuanianStemmer() },
+		"et":              func() Stemmer { return stemmer.NewEstonianStemmer() },
+		"hi":              func() Stemmer { return stemmer.NewHindiStemmer() },
+		"ne":              func() Stemmer { return stemmer.NewNepaliStemmer() },
+		"porter":          func() Stemmer { return stemmer.NewPorterStemmer() },
+		"lovins":          func() Stemmer { return stemmer.NewLovinsStemmer() },
+		"lancaster":       func() Stemmer { return stemmer.NewLancasterStemmer() },
+		"en_light":        func() Stemmer { return stemmer.NewEnglishStemmerWithLevel(stemmer.LevelLight) },
+		"ru_light":        func() Stemmer { return stemmer.NewRussianStemmerWithLevel(stemmer.LevelLight) },
+		"ru_savoy":        func() Stemmer { return stemmer.NewRussianLightStemmer() },
+	}
+)
+
+// Register makes a stemmer available under the given language code. A code
+// that is already registered, including a built-in one, is replaced.
+// Register panics if factory is nil.
+func Register(lang string, factory func() Stemmer) {
+	if factory == nil {
+		panic("ugustemmer: Register factory is nil")
+	}
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	registry[lang] = factory
+}
+
+// Languages returns the sorted list of supported language codes.
+func Languages() []string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	langs := make([]string, 0, len(registry))
+	for lang := range registry {
+		langs = append(langs, lang)
+	}
+	slices.Sort(langs)
+	return langs
+}
+
 type SnowballStemmer struct {
 	stemmer Stemmer
 	lang    string
 }
 
-// NewSnowballStemmer creates a new SnowballStemmer for the given language.
-// The language must be a valid ISO 639-1 code.
-// If the language is not supported, the function will return nil.
-// Supported languages are:
-//   - "en" (English) - not implemented
-//   - "es" (Spanish) - not implemented
-//   - "fr" (French) - not implemented
-//   - "it" (Italian) - not implemented
-//   - "pt" (Portuguese) - not implemented
+// New creates a new SnowballStemmer for the given language. The language
+// must be one of the codes returned by Languages. Built-in codes are:
+//   - "en", "english", "en-US" (English)
+//   - "es" (Spanish)
+//   - "fr" (French)
+//   - "it" (Italian)
+//   - "pt" (Portuguese)
 //   - "ru" (Russian)
-//   - "de" (German) - not implemented
-//   - "nl" (Dutch) - not implemented
-//   - "sv" (Swedish) - not implemented
-//   - "no" (Norwegian) - not implemented
-//   - "da" (Danish) - not implemented
-//   - "fi" (Finnish) - not implemented
-func NewSnowballStemmer(lang string) *SnowballStemmer {
-	stemmers := map[string]Stemmer{
-		"ru": stemmer.NewRussianStemmer(),
-	}
-	stemmer, ok := stemmers[lang]
+//   - "de" (German)
+//   - "german2" (German, also reading ae, oe and ue as ä, ö and ü)
+//   - "nl" (Dutch)
+//   - "kraaij_pohlmann" (Dutch, Kraaij-Pohlmann algorithm)
+//   - "sv" (Swedish)
+//   - "no" (Norwegian)
+//   - "da" (Danish)
+//   - "fi" (Finnish)
+//   - "uk" (Ukrainian)
+//   - "be" (Belarusian)
+//   - "bg" (Bulgarian)
+//   - "hu" (Hungarian)
+//   - "tr" (Turkish)
+//   - "ar" (Arabic)
+//   - "el" (Greek)
+//   - "ro" (Romanian)
+//   - "ca" (Catalan)
+//   - "id" (Indonesian)
+//   - "lt" (Lithuanian)
+//   - "et" (Estonian)
+//   - "hi" (Hindi)
+//   - "ne" (Nepali)
+//   - "porter" (English, original Porter algorithm)
+//   - "lovins" (English, Lovins algorithm)
+//   - "lancaster" (English, Lancaster/Paice-Husk algorithm)
+//   - "en_light" (English, plural and possessive endings only)
+//   - "ru_light" (Russian, noun and adjective case endings only)
+//   - "ru_savoy" (Russian, Savoy light stemmer)
+//
+// If the language is not supported, New returns an ErrUnsupportedLanguage.
+func New(lang string) (*SnowballStemmer, error) {
+	registryMu.RLock()
+	factory, ok := registry[lang]
+	registryMu.RUnlock()
 	if !ok {
-		return nil
+		return nil, ErrUnsupportedLanguage{Lang: lang}
 	}
 	return &SnowballStemmer{
-		stemmer: stemmer,
+		stemmer: factory(),
 		lang:    lang,
+	}, nil
+}
+
+// NewSnowballStemmer creates a new SnowballStemmer for the given language.
+// It is like New, but returns nil if the language is not supported.
+func NewSnowballStemmer(lang string) *SnowballStemmer {
+	s, err := New(lang)
+	if err != nil {
+		return nil
 	}
+	return s
 }
 
 // Stem returns the stem of the given word.
 // If the language is not supported, the function will return the word unchanged.
 func (s *SnowballStemmer) Stem(word string) string {
+	if s == nil || s.stemmer == nil {
+		return word
+	}
 	return s.stemmer.Stem(word)
 }
@@ -0,0 +1,415 @@
+package stemmer
+
+import (
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// arAffix is an Arabic prefix or suffix, the number of letters a word must
+// have at least for it to be removed, and what replaces it.
+type arAffix struct {
+	form        string
+	minLen      int
+	replacement string
+}
+
+var (
+	arStopWords = map[string]struct{}{
+		"في":    {},
+		"من":    {},
+		"على":   {},
+		"الى":   {},
+		"إلى":   {},
+		"عن":    {},
+		"مع":    {},
+		"هذا":   {},
+		"هذه":   {},
+		"ذلك":   {},
+		"تلك":   {},
+		"التي":  {},
+		"الذي":  {},
+		"الذين": {},
+		"ان":    {},
+		"أن":    {},
+		"إن":    {},
+		"او":    {},
+		"أو":    {},
+		"ثم":    {},
+		"كان":   {},
+		"كانت":  {},
+		"قد":    {},
+		"لا":    {},
+		"لم":    {},
+		"لن":    {},
+		"ما":    {},
+		"و":     {},
+		"هو":    {},
+		"هي":    {},
+		"هم":    {},
+		"نحن":   {},
+		"انا":   {},
+		"أنا":   {},
+		"كل":    {},
+		"بين":   {},
+		"حتى":   {},
+		"عند":   {},
+		"بعد":   {},
+		"قبل":   {},
+	}
+
+	arNormalizer = strings.NewReplacer(
+		// Tashkeel and tatweel.
+		"\u064B", "", "\u064C", "", "\u064D", "", "\u064E", "", "\u064F", "", "\u0650", "", "\u0651", "", "\u0652", "", "\u0640", "",
+		// Arabic-Indic digits.
+		"٠", "0", "١", "1", "٢", "2", "٣", "3", "٤", "4", "٥", "5", "٦", "6", "٧", "7", "٨", "8", "٩", "9",
+		// Presentation forms.
+		"\uFE80", "ء",
+		"\uFE83", "أ", "\uFE84", "أ",
+		"\uFE87", "إ", "\uFE88", "إ",
+		"\uFE89", "ئ", "\uFE8A", "ئ", "\uFE8B", "ئ", "\uFE8C", "ئ",
+		"\uFE81", "آ", "\uFE82", "آ",
+		"\uFE85", "ؤ", "\uFE86", "ؤ",
+		"\uFE8D", "ا", "\uFE8E", "ا",
+		"\uFE8F", "ب", "\uFE90", "ب", "\uFE91", "ب", "\uFE92", "ب",
+		"\uFE93", "ة", "\uFE94", "ة",
+		"\uFE95", "ت", "\uFE96", "ت", "\uFE97", "ت", "\uFE98", "ت",
+		"\uFE99", "ث", "\uFE9A", "ث", "\uFE9B", "ث", "\uFE9C", "ث",
+		"\uFE9D", "ج", "\uFE9E", "ج", "\uFE9F", "ج", "\uFEA0", "ج",
+		"\uFEA1", "ح", "\uFEA2", "ح", "\uFEA3", "ح", "\uFEA4", "ح",
+		"\uFEA5", "خ", "\uFEA6", "خ", "\uFEA7", "خ", "\uFEA8", "خ",
+		"\uFEA9", "د", "\uFEAA", "د",
+		"\uFEAB", "ذ", "\uFEAC", "ذ",
+		"\uFEAD", "ر", "\uFEAE", "ر",
+		"\uFEAF", "ز", "\uFEB0", "ز",
+		"\uFEB1", "س", "\uFEB2", "س", "\uFEB3", "س", "\uFEB4", "س",
+		"\uFEB5", "ش", "\uFEB6", "ش", "\uFEB7", "ش", "\uFEB8", "ش",
+		"\uFEB9", "ص", "\uFEBA", "ص", "\uFEBB", "ص", "\uFEBC", "ص",
+		"\uFEBD", "ض", "\uFEBE", "ض", "\uFEBF", "ض", "\uFEC0", "ض",
+		"\uFEC1", "ط", "\uFEC2", "ط", "\uFEC3", "ط", "\uFEC4", "ط",
+		"\uFEC5", "ظ", "\uFEC6", "ظ", "\uFEC7", "ظ", "\uFEC8", "ظ",
+		"\uFEC9", "ع", "\uFECA", "ع", "\uFECB", "ع", "\uFECC", "ع",
+		"\uFECD", "غ", "\uFECE", "غ", "\uFECF", "غ", "\uFED0", "غ",
+		"\uFED1", "ف", "\uFED2", "ف", "\uFED3", "ف", "\uFED4", "ف",
+		"\uFED5", "ق", "\uFED6", "ق", "\uFED7", "ق", "\uFED8", "ق",
+		"\uFED9", "ك", "\uFEDA", "ك", "\uFEDB", "ك", "\uFEDC", "ك",
+		"\uFEDD", "ل", "\uFEDE", "ل", "\uFEDF", "ل", "\uFEE0", "ل",
+		"\uFEE1", "م", "\uFEE2", "م", "\uFEE3", "م", "\uFEE4", "م",
+		"\uFEE5", "ن", "\uFEE6", "ن", "\uFEE7", "ن", "\uFEE8", "ن",
+		"\uFEE9", "ه", "\uFEEA", "ه", "\uFEEB", "ه", "\uFEEC", "ه",
+		"\uFEED", "و", "\uFEEE", "و",
+		"\uFEEF", "ى", "\uFEF0", "ى",
+		"\uFEF1", "ي", "\uFEF2", "ي", "\uFEF3", "ي", "\uFEF4", "ي",
+		"\uFEFB", "لا", "\uFEFC", "لا",
+		"\uFEF7", "لأ", "\uFEF8", "لأ",
+		"\uFEF9", "لإ", "\uFEFA", "لإ",
+		"\uFEF5", "لآ", "\uFEF6", "لآ",
+	)
+
+	// arHamzaReplacer undoes the hamza forms of alef, waw and yeh once the
+	// affixes are gone.
+	arHamzaReplacer = strings.NewReplacer("آ", "ا", "أ", "ا", "إ", "ا", "ؤ", "و", "ئ", "ي")
+
+	arHamzaPrefixes = []arAffix{
+		{"أآ", 4, "آ"},
+		{"أأ", 4, "أ"},
+		{"أؤ", 4, "أ"},
+		{"أإ", 4, "إ"},
+		{"أا", 4, "ا"},
+	}
+	arConjunctionPrefixes = []arAffix{
+		{"ف", 4, ""},
+		{"و", 4, ""},
+	}
+	arArticlePrefixes = []arAffix{
+		{"بال", 6, ""},
+		{"كال", 6, ""},
+		{"ال", 5, ""},
+		{"لل", 5, ""},
+	}
+	arPrepositionPrefixes = []arAffix{
+		{"بب", 4, "ب"},
+		{"كك", 4, "ك"},
+		{"ب", 4, ""},
+	}
+	arFuturePrefixes = []arAffix{
+		{"سأ", 5, "أ"},
+		{"ست", 5, "ت"},
+		{"سن", 5, "ن"},
+		{"سي", 5, "ي"},
+	}
+	arIstafalaPrefixes = []arAffix{
+		{"تست", 5, "است"},
+		{"نست", 5, "است"},
+		{"يست", 5, "است"},
+	}
+
+	arNounPronounSuffixes = []arAffix{
+		{"كما", 6, ""},
+		{"هما", 6, ""},
+		{"كم", 5, ""},
+		{"هم", 5, ""},
+		{"هن", 5, ""},
+		{"نا", 5, ""},
+		{"ها", 5, ""},
+		{"ك", 4, ""},
+		{"ه", 4, ""},
+		{"ي", 4, ""},
+	}
+	arNounNSuffixes       = []arAffix{{"ن", 6, ""}}
+	arNounVowelSuffixes   = []arAffix{{"و", 5, ""}, {"ي", 5, ""}, {"ا", 5, ""}}
+	arNounPluralSuffixes  = []arAffix{{"ات", 5, ""}}
+	arNounTSuffixes       = []arAffix{{"ت", 4, ""}}
+	arNounTehMarbuta      = []arAffix{{"ة", 4, ""}}
+	arNounNisbaSuffixes   = []arAffix{{"ي", 3, ""}}
+	arVerbPronounSuffixes = []arAffix{
+		{"كمو", 6, ""},
+		{"كما", 6, ""},
+		{"هما", 6, ""},
+		{"كم", 5, ""},
+		{"هم", 5, ""},
+		{"كن", 5, ""},
+		{"هن", 5, ""},
+		{"ني", 5, ""},
+		{"نا", 5, ""},
+		{"ها", 5, ""},
+		{"ك", 4, ""},
+		{"ه", 4, ""},
+	}
+	arVerbPersonSuffixes = []arAffix{
+		{"تما", 6, ""},
+		{"ون", 6, ""},
+		{"ين", 6, ""},
+		{"ان", 6, ""},
+		{"تن", 5, ""},
+		{"نا", 5, ""},
+		{"تا", 5, ""},
+		{"ن", 4, ""},
+		{"ي", 4, ""},
+		{"ا", 4, ""},
+		{"ت", 4, ""},
+	}
+	arVerbPluralSuffixes = []arAffix{{"تم", 5, ""}, {"وا", 5, ""}}
+	arVerbWawSuffixes    = []arAffix{{"تمو", 6, ""}, {"و", 4, ""}}
+	arAlefMaqsura        = []arAffix{{"ى", 0, "ي"}}
+)
+
+// ArabicStemmer implements the Snowball Arabic stemmer. Arabic words are
+// handled as slices of runes, and affix lengths are counted in letters.
+type ArabicStemmer struct{}
+
+// NewArabicStemmer creates a new ArabicStemmer.
+func NewArabicStemmer() *ArabicStemmer {
+	return &ArabicStemmer{}
+}
+
+// Stem returns the stem of the given word.
+func (s ArabicStemmer) Stem(word string) string {
+	word = strings.ToLower(word)
+	// The definite article is looked for before diacritics are removed.
+	defined := s.isDefined(word)
+
+	word = arNormalizer.Replace(word)
+	if s.isStopWord(word) {
+		return word
+	}
+
+	runes := s.suffixes([]rune(word), defined)
+	runes = s.prefixes(runes, defined)
+	return s.normalizeHamza(string(runes))
+}
+
+// isDefined reports whether word starts with a definite article and is long
+// enough to keep a stem once it is removed. Such a word is taken for a noun.
+func (s ArabicStemmer) isDefined(word string) bool {
+	n := utf8.RuneCountInString(word)
+	switch {
+	case strings.HasPrefix(word, "بال"), strings.HasPrefix(word, "كال"):
+		return n > 4
+	case strings.HasPrefix(word, "ال"), strings.HasPrefix(word, "لل"):
+		return n > 3
+	}
+	return false
+}
+
+// suffixes removes verb suffixes, or noun suffixes if the word is defined or
+// has no verb suffix.
+func (s ArabicStemmer) suffixes(runes []rune, defined bool) []rune {
+	if !defined {
+		var ok bool
+		if runes, ok = s.verbSuffixes(runes); ok {
+			return runes
+		}
+	}
+
+	runes, pos := s.nounSuffixes(runes, defined)
+	if r, _, ok := s.trimSuffix(runes, pos, arNounNisbaSuffixes); ok {
+		return r
+	}
+	runes, _, _ = s.trimSuffix(runes, len(runes), arAlefMaqsura)
+	return runes
+}
+
+func (s ArabicStemmer) verbSuffixes(runes []rune) ([]rune, bool) {
+	pos := len(runes)
+	removed := false
+	for {
+		r, p, ok := s.trimSuffix(runes, pos, arVerbPronounSuffixes)
+		if !ok {
+			break
+		}
+		runes, pos, removed = r, p, true
+	}
+
+	if removed {
+		if r, _, ok := s.trimSuffix(runes, pos, arVerbPersonSuffixes); ok {
+			return r, true
+		}
+		if r, _, ok := s.trimSuffix(runes, pos, arVerbWawSuffixes); ok {
+			return r, true
+		}
+		if pos > 0 {
+			return runes, true
+		}
+	}
+
+	for _, suffixes := range [][]arAffix{arVerbPluralSuffixes, arVerbPersonSuffixes} {
+		if r, _, ok := s.trimSuffix(runes, len(runes), suffixes); ok {
+			return r, true
+		}
+	}
+	return runes, false
+}
+
+// nounSuffixes removes pronoun, dual, plural and feminine suffixes. It
+// returns the rune offset the nisba suffix is looked for before, which is
+// one letter short of the end if a pronoun was removed and nothing followed
+// it.
+func (s ArabicStemmer) nounSuffixes(runes []rune, defined bool) ([]rune, int) {
+	if r, p, ok := s.trimSuffix(runes, len(runes), arNounTehMarbuta); ok {
+		return r, p
+	}
+
+	secondSteps := [][]arAffix{arNounVowelSuffixes, arNounPluralSuffixes, arNounTSuffixes}
+	if !defined {
+		if r, p, ok := s.trimSuffix(runes, len(runes), arNounPronounSuffixes); ok {
+			runes = r
+			for _, suffixes := range secondSteps {
+				if r, q, ok := s.trimSuffix(runes, p, suffixes); ok {
+					return r, q
+				}
+			}
+			if p > 0 {
+				return runes, p - 1
+			}
+		}
+	}
+
+	if r, p, ok := s.trimSuffix(runes, len(runes), arNounNSuffixes); ok {
+		// The n stays removed even if no other suffix precedes it.
+		runes = r
+		for _, suffixes := range secondSteps {
+			if r, q, ok := s.trimSuffix(runes, p, suffixes); ok {
+				return r, q
+			}
+		}
+	}
+
+	if !defined {
+		if r, p, ok := s.trimSuffix(runes, len(runes), arNounVowelSuffixes); ok {
+			return r, p
+		}
+	}
+	if r, p, ok := s.trimSuffix(runes, len(runes), arNounPluralSuffixes); ok {
+		return r, p
+	}
+	return runes, len(runes)
+}
+
+// prefixes removes conjunctions, the definite article and prepositions from
+// nouns, and the future and istaf'ala prefixes from verbs.
+func (s ArabicStemmer) prefixes(runes []rune, defined bool) []rune {
+	pos := 0
+	if r, p, ok := s.trimPrefix(runes, pos, arHamzaPrefixes); ok {
+		runes, pos = r, p
+	}
+	if !s.hasPrefixAt(runes, pos, "فا") && !s.hasPrefixAt(runes, pos, "وا") {
+		if r, p, ok := s.trimPrefix(runes, pos, arConjunctionPrefixes); ok {
+			runes, pos = r, p
+		}
+	}
+
+	if r, _, ok := s.trimPrefix(runes, pos, arArticlePrefixes); ok {
+		return r
+	}
+	if !s.hasPrefixAt(runes, pos, "با") {
+		if r, _, ok := s.trimPrefix(runes, pos, arPrepositionPrefixes); ok {
+			return r
+		}
+	}
+
+	if !defined {
+		if r, p, ok := s.trimPrefix(runes, pos, arFuturePrefixes); ok {
+			runes, pos = r, p
+		}
+		runes, _, _ = s.trimPrefix(runes, pos, arIstafalaPrefixes)
+	}
+	return runes
+}
+
+// normalizeHamza turns a final hamza carrier into a bare hamza and every
+// other one into the plain letter.
+func (s ArabicStemmer) normalizeHamza(word string) string {
+	if last := lastRune(word); strings.ContainsRune("آأؤإئ", last) {
+		word = strings.TrimSuffix(word, string(last))
+		return arHamzaReplacer.Replace(word) + "ء"
+	}
+	return arHamzaReplacer.Replace(word)
+}
+
+// trimSuffix replaces the longest of affixes that ends at the rune offset
+// pos. Like the Snowball among, it fails rather than trying a shorter affix
+// if the word is too short for the longest one. It returns the new runes and
+// the offset where the replacement starts.
+func (s ArabicStemmer) trimSuffix(runes []rune, pos int, affixes []arAffix) ([]rune, int, bool) {
+	for _, affix := range affixes {
+		form := []rune(affix.form)
+		if len(form) > pos || string(runes[pos-len(form):pos]) != affix.form {
+			continue
+		}
+		if len(runes) < affix.minLen {
+			return runes, pos, false
+		}
+		start := pos - len(form)
+		return slices.Concat(runes[:start], []rune(affix.replacement), runes[pos:]), start, true
+	}
+	return runes, pos, false
+}
+
+// trimPrefix replaces the longest of affixes that starts at the rune offset
+// pos, and returns the new runes and the offset after the replacement.
+func (s ArabicStemmer) trimPrefix(runes []rune, pos int, affixes []arAffix) ([]rune, int, bool) {
+	for _, affix := range affixes {
+		if !s.hasPrefixAt(runes, pos, affix.form) {
+			continue
+		}
+		if len(runes) < affix.minLen {
+			return runes, pos, false
+		}
+		replacement := []rune(affix.replacement)
+		end := pos + utf8.RuneCountInString(affix.form)
+		return slices.Concat(runes[:pos], replacement, runes[end:]), pos + len(replacement), true
+	}
+	return runes, pos, false
+}
+
+func (s ArabicStemmer) hasPrefixAt(runes []rune, pos int, prefix string) bool {
+	n := utf8.RuneCountInString(prefix)
+	return pos+n <= len(runes) && string(runes[pos:pos+n]) == prefix
+}
+
+// isStopWord returns true if the given word is a stop word.
+func (s ArabicStemmer) isStopWord(word string) bool {
+	_, found := arStopWords[word]
+	return found
+}
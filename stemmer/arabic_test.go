@@ -0,0 +1,253 @@
+package stemmer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewArabicStemmer(t *testing.T) {
+	s := NewArabicStemmer()
+	require.NotNil(t, s)
+}
+
+func TestArabicStemmer_isStopWord(t *testing.T) {
+	s := NewArabicStemmer()
+	require.True(t, s.isStopWord("في"))
+	require.False(t, s.isStopWord("كتاب"))
+}
+
+func TestArabicStemmer_Stem(t *testing.T) {
+	s := NewArabicStemmer()
+	t.Run("stop word", func(t *testing.T) {
+		require.Equal(t, "في", s.Stem("في"))
+		require.Equal(t, "في", s.Stem("فِي"))
+	})
+
+	t.Run("normalization", func(t *testing.T) {
+		require.Equal(t, "كتاب", s.Stem("كتابُ"))
+		require.Equal(t, "كتاب", s.Stem("الكِتَابُ"))
+		require.Equal(t, "مدرس", s.Stem("مُدَرِّسٌ"))
+		require.Equal(t, "كتاب", s.Stem("كتـــاب"))
+		require.Equal(t, "123", s.Stem("١٢٣"))
+		require.Equal(t, "كتاب", s.Stem("ﻛﺘﺎﺏ"))
+	})
+
+	f := func(word, stem string) {
+		t.Helper()
+		require.Equal(t, stem, s.Stem(word))
+	}
+
+	f("كتاب", "كتاب")
+	f("الكتاب", "كتاب")
+	f("كتابه", "كتاب")
+	f("كتابها", "كتاب")
+	f("كتابهم", "كتاب")
+	f("كتابنا", "كتاب")
+	f("بالكتاب", "كتاب")
+	f("كالكتاب", "كتاب")
+	f("للكتاب", "كتاب")
+	f("والكتاب", "والكتاب")
+	f("فالكتاب", "فالكتاب")
+	f("كتب", "كتب")
+	f("الكتب", "كتب")
+	f("كاتب", "كاتب")
+	f("الكاتب", "كاتب")
+	f("كاتبة", "كاتب")
+	f("الكاتبة", "كاتب")
+	f("كتابات", "كتابا")
+	f("مكتبة", "مكتب")
+	f("المكتبة", "مكتب")
+	f("مكتبات", "مكتبا")
+	f("المكتبات", "مكتب")
+	f("يكتب", "يكتب")
+	f("يكتبون", "يكتب")
+	f("تكتب", "تكتب")
+	f("تكتبين", "تكتب")
+	f("كتبوا", "كتب")
+	f("كتبت", "كتب")
+	f("كتبتم", "كتب")
+	f("كتبنا", "كتب")
+	f("سيكتب", "يكتب")
+	f("سيكتبون", "يكتب")
+	f("ستكتب", "تكتب")
+	f("مدرسة", "مدرس")
+	f("المدرسة", "مدرس")
+	f("مدارس", "مدارس")
+	f("المدارس", "مدارس")
+	f("مدرسون", "مدرس")
+	f("المدرسون", "مدرس")
+	f("مدرسين", "مدرس")
+	f("المدرسين", "مدرس")
+	f("معلم", "معلم")
+	f("المعلم", "معلم")
+	f("معلمة", "معلم")
+	f("المعلمة", "معلم")
+	f("معلمون", "معلم")
+	f("معلمين", "معلم")
+	f("معلمات", "معلما")
+	f("المعلمات", "معلم")
+	f("طالب", "طالب")
+	f("الطالب", "طالب")
+	f("طالبة", "طالب")
+	f("الطلاب", "طلاب")
+	f("طلاب", "طلاب")
+	f("طالبات", "طالبا")
+	f("الطالبات", "طالب")
+	f("بيت", "بيت")
+	f("البيت", "بيت")
+	f("بيوت", "بيو")
+	f("البيوت", "بيوت")
+	f("بيتي", "بيت")
+	f("بيتك", "بيت")
+	f("بيتكم", "بيت")
+	f("بيتهما", "بيت")
+	f("مسلم", "مسلم")
+	f("المسلم", "مسلم")
+	f("المسلمون", "مسلم")
+	f("المسلمين", "مسلم")
+	f("مسلمات", "مسلما")
+	f("المسلمات", "مسلم")
+	f("العربية", "عرب")
+	f("عربي", "عرب")
+	f("العربي", "عرب")
+	f("عرب", "عرب")
+	f("العرب", "عرب")
+	f("استخدام", "استخدام")
+	f("الاستخدام", "استخدام")
+	f("يستخدم", "استخدم")
+	f("يستخدمون", "استخدم")
+	f("تستخدم", "استخدم")
+	f("نستخدم", "استخدم")
+	f("استخدموا", "استخدم")
+	f("مستخدم", "مستخدم")
+	f("المستخدم", "مستخدم")
+	f("المستخدمين", "مستخدم")
+	f("مستشفى", "مستشفي")
+	f("المستشفى", "مستشفي")
+	f("مستشفيات", "مستشفيا")
+	f("فتاة", "فتا")
+	f("الفتاة", "فتا")
+	f("فتيات", "تيا")
+	f("ذهب", "ذهب")
+	f("ذهبوا", "ذهب")
+	f("ذهبت", "ذهب")
+	f("يذهب", "يذهب")
+	f("يذهبون", "يذهب")
+	f("سيذهب", "يذهب")
+	f("قال", "قال")
+	f("قالوا", "قال")
+	f("قالت", "قال")
+	f("يقول", "يقول")
+	f("يقولون", "يقول")
+	f("أكل", "اكل")
+	f("يأكل", "ياكل")
+	f("أكلوا", "اكل")
+	f("مأكولات", "ماكولا")
+	f("سأل", "سال")
+	f("يسأل", "يسال")
+	f("سؤال", "سوال")
+	f("أسئلة", "اسيل")
+	f("الأسئلة", "اسيل")
+	f("مسؤول", "مسوول")
+	f("المسؤول", "مسوول")
+	f("رئيس", "رييس")
+	f("الرئيس", "رييس")
+	f("رؤساء", "روساء")
+	f("قرأ", "قرء")
+	f("يقرأ", "يقرء")
+	f("قراءة", "قراء")
+	f("القراءة", "قراء")
+	f("إسلام", "اسلام")
+	f("الإسلام", "اسلام")
+	f("إسلامي", "اسلام")
+	f("الإسلامية", "اسلام")
+	f("آمن", "امن")
+	f("الآن", "الان")
+	f("أمير", "امير")
+	f("الأمير", "امير")
+	f("أمراء", "امراء")
+	f("الأمراء", "امراء")
+	f("سماء", "سماء")
+	f("السماء", "سماء")
+	f("ماء", "ماء")
+	f("الماء", "ماء")
+	f("شيء", "شيء")
+	f("أشياء", "اشياء")
+	f("الأشياء", "اشياء")
+	f("جميل", "جميل")
+	f("الجميل", "جميل")
+	f("جميلة", "جميل")
+	f("الجميلة", "جميل")
+	f("جميلات", "جميلا")
+	f("كبير", "كبير")
+	f("الكبير", "كبير")
+	f("كبيرة", "كبير")
+	f("كبار", "كبار")
+	f("الكبار", "كبار")
+	f("صغير", "صغير")
+	f("الصغير", "صغير")
+	f("صغيرة", "صغير")
+	f("الصغيرة", "صغير")
+	f("عمل", "عمل")
+	f("العمل", "عمل")
+	f("أعمال", "اعمال")
+	f("الأعمال", "اعمال")
+	f("يعمل", "يعمل")
+	f("يعملون", "يعمل")
+	f("عاملون", "عامل")
+	f("العاملين", "عامل")
+	f("عاملات", "عاملا")
+	f("مدينة", "مدين")
+	f("المدينة", "مدين")
+	f("مدن", "مدن")
+	f("المدن", "مدن")
+	f("حكومة", "حكوم")
+	f("الحكومة", "حكوم")
+	f("حكومات", "حكوما")
+	f("الحكومات", "حكوم")
+	f("وزير", "زير")
+	f("الوزير", "وزير")
+	f("وزارة", "زار")
+	f("الوزارة", "وزار")
+	f("وزراء", "زراء")
+	f("الوزراء", "وزراء")
+	f("سيارة", "سيار")
+	f("السيارة", "سيار")
+	f("سيارات", "يارا")
+	f("السيارات", "سيار")
+	f("بسيارته", "سيار")
+	f("جامعة", "جامع")
+	f("الجامعة", "جامع")
+	f("جامعات", "جامعا")
+	f("الجامعات", "جامع")
+	f("بالجامعة", "جامع")
+	f("وبالجامعة", "جامع")
+	f("فلسطين", "لسط")
+	f("فلسطيني", "لسط")
+	f("الفلسطيني", "فلسطين")
+	f("الفلسطينيون", "فلسطين")
+	f("الفلسطينيين", "فلسطين")
+	f("مصر", "مصر")
+	f("مصري", "مصر")
+	f("المصري", "مصر")
+	f("المصريون", "مصر")
+	f("كتابُ", "كتاب")
+}
+
+func TestArabicStemmer_isDefined(t *testing.T) {
+	s := NewArabicStemmer()
+	require.True(t, s.isDefined("الكتاب"))
+	require.True(t, s.isDefined("بالكتاب"))
+	require.True(t, s.isDefined("للكتاب"))
+	require.False(t, s.isDefined("كتاب"))
+	require.False(t, s.isDefined("الى"))
+	require.False(t, s.isDefined("بالي"))
+}
+
+func TestArabicStemmer_normalizeHamza(t *testing.T) {
+	s := NewArabicStemmer()
+	require.Equal(t, "اسلام", s.normalizeHamza("إسلام"))
+	require.Equal(t, "مسوول", s.normalizeHamza("مسؤول"))
+	require.Equal(t, "قرء", s.normalizeHamza("قرأ"))
+}
@@ -0,0 +1,391 @@
+package stemmer
+
+import (
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+var (
+	beStopWords = map[string]struct{}{
+		"і":       {},
+		"й":       {},
+		"ды":      {},
+		"а":       {},
+		"але":     {},
+		"ці":      {},
+		"або":     {},
+		"у":       {},
+		"ў":       {},
+		"на":      {},
+		"з":       {},
+		"са":      {},
+		"да":      {},
+		"ад":      {},
+		"для":     {},
+		"па":      {},
+		"пры":     {},
+		"пра":     {},
+		"што":     {},
+		"як":      {},
+		"гэта":    {},
+		"гэты":    {},
+		"гэтая":   {},
+		"гэтыя":   {},
+		"гэтага":  {},
+		"той":     {},
+		"тая":     {},
+		"тое":     {},
+		"тыя":     {},
+		"таго":    {},
+		"ён":      {},
+		"яна":     {},
+		"яно":     {},
+		"яны":     {},
+		"я":       {},
+		"ты":      {},
+		"мы":      {},
+		"вы":      {},
+		"мяне":    {},
+		"цябе":    {},
+		"яго":     {},
+		"яе":      {},
+		"іх":      {},
+		"нас":     {},
+		"вас":     {},
+		"мне":     {},
+		"табе":    {},
+		"яму":     {},
+		"ёй":      {},
+		"ім":      {},
+		"нам":     {},
+		"вам":     {},
+		"імі":     {},
+		"не":      {},
+		"ні":      {},
+		"так":     {},
+		"жа":      {},
+		"б":       {},
+		"бы":      {},
+		"бо":      {},
+		"каб":     {},
+		"калі":    {},
+		"дзе":     {},
+		"тут":     {},
+		"там":     {},
+		"ужо":     {},
+		"яшчэ":    {},
+		"толькі":  {},
+		"таксама": {},
+		"быў":     {},
+		"была":    {},
+		"было":    {},
+		"былі":    {},
+		"ёсць":    {},
+		"быць":    {},
+		"будзе":   {},
+		"будуць":  {},
+		"без":     {},
+		"над":     {},
+		"пад":     {},
+		"паміж":   {},
+		"праз":    {},
+		"за":      {},
+		"перад":   {},
+		"пасля":   {},
+		"нават":   {},
+		"вельмі":  {},
+		"свой":    {},
+		"свая":    {},
+		"сваё":    {},
+		"свае":    {},
+		"мой":     {},
+		"мая":     {},
+		"маё":     {},
+		"мае":     {},
+		"твой":    {},
+		"твая":    {},
+		"наш":     {},
+		"наша":    {},
+		"ваш":     {},
+		"ваша":    {},
+		"хто":     {},
+		"чаму":    {},
+		"які":     {},
+		"якая":    {},
+		"якое":    {},
+		"якія":    {},
+		"увесь":   {},
+		"уся":     {},
+		"усё":     {},
+		"усе":     {},
+		"сам":     {},
+		"сама":    {},
+		"сябе":    {},
+		"сабе":    {},
+	}
+
+	bePerfectiveSuffixes = []string{
+		"ыўшыся",
+		"іўшыся",
+		"ачыся",
+		"учыся",
+		"ючыся",
+		"ячыся",
+		"ўшыся",
+		"ыўшы",
+		"іўшы",
+		"ачы",
+		"учы",
+		"ючы",
+		"ячы",
+		"ўшы",
+	}
+
+	// bePerfectiveSuffixesAfterA are the perfective gerund suffixes that
+	// must follow а or я. The slice is sorted for binary search.
+	bePerfectiveSuffixesAfterA = []string{"ўшы", "ўшыся"}
+
+	beReflexiveSuffixes = []string{"цца", "ся"}
+
+	beAdjectiveSuffixes = []string{
+		"ага",
+		"аму",
+		"ымі",
+		"яга",
+		"яму",
+		"імі",
+		"ае",
+		"ай",
+		"ая",
+		"ее",
+		"ое",
+		"ой",
+		"ою",
+		"ую",
+		"ым",
+		"ых",
+		"ыя",
+		"юю",
+		"яе",
+		"яй",
+		"яя",
+		"ім",
+		"іх",
+		"ія",
+	}
+
+	beParticipleSuffixes = []string{"уч", "юч"}
+
+	beVerbSuffixes = []string{
+		"уеце",
+		"еце",
+		"йма",
+		"йце",
+		"уем",
+		"уеш",
+		"уць",
+		"ыла",
+		"ыло",
+		"ылі",
+		"ыце",
+		"ыць",
+		"эце",
+		"юць",
+		"іла",
+		"іло",
+		"ілі",
+		"іце",
+		"іць",
+		"ем",
+		"еш",
+		"ла",
+		"ло",
+		"лі",
+		"ую",
+		"ць",
+		"ыш",
+		"ыў",
+		"эш",
+		"іш",
+		"іў",
+		"й",
+		"ў",
+	}
+
+	// beVerbSuffixesAfterA are the verb suffixes that must follow а or я,
+	// which stays in the stem: чытаць, чытаў and чытала all give чыта. The
+	// slice is sorted for binary search.
+	beVerbSuffixesAfterA = []string{
+		"ем", "еце", "еш", "й", "йма", "йце", "ла", "ло", "лі", "ць", "ў",
+	}
+
+	beNounSuffixes = []string{
+		"амі",
+		"ыяй",
+		"ьмі",
+		"ямі",
+		"іяй",
+		"ам",
+		"ах",
+		"аў",
+		"ей",
+		"ем",
+		"еў",
+		"ой",
+		"ом",
+		"ою",
+		"оў",
+		"ыю",
+		"ыя",
+		"ыі",
+		"ям",
+		"ях",
+		"яў",
+		"ёй",
+		"ём",
+		"ёю",
+		"ёў",
+		"ію",
+		"ія",
+		"іі",
+		"а",
+		"е",
+		"й",
+		"о",
+		"у",
+		"ы",
+		"ь",
+		"ю",
+		"я",
+		"ё",
+		"і",
+	}
+
+	beDerivationalSuffixes = []string{"асць", "асц"}
+
+	// beApostropheReplacer drops the apostrophe, which Belarusian writes
+	// before я, ю, е, ё and і after a consonant, in any of its forms.
+	beApostropheReplacer = strings.NewReplacer("'", "", "’", "", "ʼ", "")
+)
+
+type BelarusianStemmer struct{}
+
+// NewBelarusianStemmer creates a new BelarusianStemmer.
+func NewBelarusianStemmer() *BelarusianStemmer {
+	return &BelarusianStemmer{}
+}
+
+// Stem returns the stem of the given word.
+func (s BelarusianStemmer) Stem(word string) string {
+	word = strings.ToLower(word)
+	if s.isStopWord(word) {
+		return word
+	}
+
+	word = beApostropheReplacer.Replace(word)
+	rv, r2 := s.regions(word)
+
+	word = s.step1(word, rv)
+
+	// Step 2: remove a final і or ы.
+	if last := lastRune(region(word, rv)); last == 'і' || last == 'ы' {
+		word = word[:len(word)-utf8.RuneLen(last)]
+	}
+
+	// Step 3: remove a derivational suffix in R2.
+	if suffix := longestSuffix(region(word, r2), beDerivationalSuffixes); suffix != "" {
+		word = word[:len(word)-len(suffix)]
+	}
+
+	return s.step4(word, rv)
+}
+
+// step1 removes a gerund ending or, failing that, a reflexive ending
+// followed by an adjectival, verb or noun ending, all inside RV.
+func (s BelarusianStemmer) step1(word string, rv int) string {
+	if stem, ok := s.trimAfterA(word, rv, bePerfectiveSuffixes, bePerfectiveSuffixesAfterA); ok {
+		return stem
+	}
+
+	if suffix := longestSuffix(region(word, rv), beReflexiveSuffixes); suffix != "" {
+		word = word[:len(word)-len(suffix)]
+	}
+
+	if suffix := longestSuffix(region(word, rv), beAdjectiveSuffixes); suffix != "" {
+		word = word[:len(word)-len(suffix)]
+		if next := longestSuffix(region(word, rv), beParticipleSuffixes); next != "" {
+			word = word[:len(word)-len(next)]
+		}
+		return word
+	}
+
+	if stem, ok := s.trimAfterA(word, rv, beVerbSuffixes, beVerbSuffixesAfterA); ok {
+		return stem
+	}
+
+	if suffix := longestSuffix(region(word, rv), beNounSuffixes); suffix != "" {
+		word = word[:len(word)-len(suffix)]
+	}
+	return word
+}
+
+// trimAfterA removes the longest of suffixes found in RV. Suffixes listed in
+// afterA are only removed when they follow а or я inside RV.
+func (s BelarusianStemmer) trimAfterA(word string, rv int, suffixes, afterA []string) (string, bool) {
+	suffix := longestSuffix(region(word, rv), suffixes)
+	if suffix == "" {
+		return word, false
+	}
+	stem := word[:len(word)-len(suffix)]
+
+	if _, found := slices.BinarySearch(afterA, suffix); found {
+		before := region(stem, rv)
+		if !strings.HasSuffix(before, "а") && !strings.HasSuffix(before, "я") {
+			return word, false
+		}
+	}
+	return stem, true
+}
+
+// step4 removes a final soft sign, or the comparative ейш and then one н of
+// a final нн.
+func (s BelarusianStemmer) step4(word string, rv int) string {
+	if strings.HasSuffix(region(word, rv), "ейш") {
+		word = word[:len(word)-len("ейш")]
+	} else if strings.HasSuffix(region(word, rv), "ь") {
+		return word[:len(word)-len("ь")]
+	}
+
+	if strings.HasSuffix(region(word, rv), "нн") {
+		word = word[:len(word)-len("н")]
+	}
+	return word
+}
+
+// isStopWord returns true if the given word is a stop word.
+func (s BelarusianStemmer) isStopWord(word string) bool {
+	_, found := beStopWords[word]
+	return found
+}
+
+func (s BelarusianStemmer) isVowel(r rune) bool {
+	switch r {
+	case 'а', 'е', 'ё', 'і', 'о', 'у', 'ы', 'э', 'ю', 'я':
+		return true
+	default:
+		return false
+	}
+}
+
+// regions returns the byte offsets of RV and R2. RV is the region after the
+// first vowel.
+func (s BelarusianStemmer) regions(word string) (int, int) {
+	rv := len(word)
+	if i := strings.IndexFunc(word, s.isVowel); i >= 0 {
+		_, size := utf8.DecodeRuneInString(word[i:])
+		rv = i + size
+	}
+	_, r2 := standardRegions(word, s.isVowel)
+	return rv, r2
+}
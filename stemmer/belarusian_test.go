@@ -0,0 +1,223 @@
+package stemmer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBelarusianStemmer(t *testing.T) {
+	s := NewBelarusianStemmer()
+	require.NotNil(t, s)
+}
+
+func TestBelarusianStemmer_isStopWord(t *testing.T) {
+	s := NewBelarusianStemmer()
+	require.True(t, s.isStopWord("і"))
+	require.False(t, s.isStopWord("кніга"))
+}
+
+func TestBelarusianStemmer_Stem(t *testing.T) {
+	s := NewBelarusianStemmer()
+	t.Run("stop word", func(t *testing.T) {
+		require.Equal(t, "але", s.Stem("але"))
+		require.Equal(t, "але", s.Stem("Але"))
+	})
+
+	f := func(word, stem string) {
+		t.Helper()
+		require.Equal(t, stem, s.Stem(word))
+	}
+
+	f("кніга", "кніг")
+	f("кнігі", "кніг")
+	f("кнізе", "кніз")
+	f("кнігу", "кніг")
+	f("кнігай", "кніг")
+	f("кніг", "кніг")
+	f("кнігамі", "кніг")
+	f("кнігах", "кніг")
+	f("горад", "горад")
+	f("горада", "горад")
+	f("гораду", "горад")
+	f("горадам", "горад")
+	f("гарады", "гарад")
+	f("гарадоў", "гарад")
+	f("гарадамі", "гарад")
+	f("гарадах", "гарад")
+	f("вада", "вад")
+	f("вады", "вад")
+	f("ваду", "вад")
+	f("вадой", "вад")
+	f("чалавек", "чалавек")
+	f("чалавека", "чалавек")
+	f("чалавеку", "чалавек")
+	f("чалавекам", "чалавек")
+	f("людзі", "людз")
+	f("людзей", "людз")
+	f("людзям", "людз")
+	f("людзьмі", "людз")
+	f("студэнт", "студэнт")
+	f("студэнта", "студэнт")
+	f("студэнту", "студэнт")
+	f("студэнтам", "студэнт")
+	f("студэнты", "студэнт")
+	f("студэнтаў", "студэнта")
+	f("студэнтамі", "студэнт")
+	f("настаўнік", "настаўнік")
+	f("настаўніка", "настаўнік")
+	f("настаўнікі", "настаўнік")
+	f("настаўнікаў", "настаўніка")
+	f("станцыя", "станц")
+	f("станцыі", "станц")
+	f("станцыю", "станц")
+	f("станцыяй", "станц")
+	f("песня", "песн")
+	f("песні", "песн")
+	f("песню", "песн")
+	f("песняй", "песн")
+	f("зямля", "зямл")
+	f("зямлі", "зямл")
+	f("зямлю", "зямл")
+	f("зямлёй", "зямл")
+	f("краіна", "краін")
+	f("краіны", "краін")
+	f("краіну", "краін")
+	f("краінай", "краін")
+	f("краін", "краін")
+	f("радасць", "радасц")
+	f("радасці", "радасц")
+	f("радасцю", "радасц")
+	f("магчымасць", "магчым")
+	f("магчымасці", "магчым")
+	f("магчымасцей", "магчым")
+	f("новы", "нов")
+	f("новая", "нов")
+	f("новае", "нов")
+	f("новыя", "нов")
+	f("новага", "нов")
+	f("новаму", "нов")
+	f("новым", "нов")
+	f("новай", "нов")
+	f("новую", "нов")
+	f("новых", "нов")
+	f("новымі", "нов")
+	f("навейшы", "нав")
+	f("сіні", "сін")
+	f("сіняя", "сін")
+	f("сіняга", "сін")
+	f("сінім", "сін")
+	f("вялікі", "вялік")
+	f("вялікая", "вялік")
+	f("вялікага", "вялік")
+	f("вялікіх", "вялік")
+	f("беларускі", "беларуск")
+	f("беларуская", "беларуск")
+	f("беларускай", "беларуск")
+	f("беларускага", "беларуск")
+	f("беларускіх", "беларуск")
+	f("прачытаны", "прачытан")
+	f("прачытаная", "прачытан")
+	f("прачытанага", "прачытан")
+	f("чытаць", "чыта")
+	f("чытаю", "чыта")
+	f("чытаеш", "чыта")
+	f("чытае", "чыт")
+	f("чытаем", "чыта")
+	f("чытаеце", "чыта")
+	f("чытаюць", "чыта")
+	f("чытаў", "чыта")
+	f("чытала", "чыта")
+	f("чыталі", "чыта")
+	f("чытай", "чыт")
+	f("чытайце", "чыта")
+	f("чытаючы", "чыта")
+	f("прачытаўшы", "прачыта")
+	f("гуляць", "гуля")
+	f("гуляў", "гуля")
+	f("гуляла", "гуля")
+	f("працаваў", "працава")
+	f("працавала", "працава")
+	f("рабіць", "раб")
+	f("раблю", "рабл")
+	f("робіш", "роб")
+	f("робіць", "роб")
+	f("робім", "роб")
+	f("робіце", "роб")
+	f("робяць", "робя")
+	f("рабіў", "раб")
+	f("рабіла", "раб")
+	f("рабілі", "раб")
+	f("рабі", "раб")
+	f("зрабіўшы", "зраб")
+	f("пісаць", "піса")
+	f("пішу", "піш")
+	f("пішаш", "пішаш")
+	f("піша", "піш")
+	f("пішуць", "піш")
+	f("пісаў", "піса")
+	f("пісала", "піса")
+	f("вучыцца", "вуч")
+	f("вучуся", "вуч")
+	f("вучышся", "вуч")
+	f("вучыліся", "вуч")
+	f("вучылася", "вуч")
+	f("навучанне", "навучан")
+	f("навучання", "навучан")
+	f("навучаннем", "навучан")
+	f("веды", "вед")
+	f("ведаў", "веда")
+	f("пытанне", "пытан")
+	f("пытанні", "пытан")
+	f("пытанняў", "пытання")
+	f("сустрэча", "сустрэч")
+	f("сустрэчы", "сустрэч")
+	f("сустрэчамі", "сустрэч")
+	f("сям'я", "сям")
+	f("сям'і", "сям")
+	f("сям’ёй", "сям")
+	f("Беларусь", "беларус")
+	f("Беларусі", "беларус")
+	f("урад", "урад")
+	f("урада", "урад")
+	f("урадам", "урад")
+	f("прэзідэнт", "прэзідэнт")
+	f("прэзідэнта", "прэзідэнт")
+	f("навіны", "навін")
+	f("навін", "навін")
+	f("навінамі", "навін")
+	f("вайна", "вайн")
+	f("вайны", "вайн")
+	f("вайну", "вайн")
+	f("вайной", "вайн")
+	f("жыццё", "жыцц")
+	f("жыцця", "жыцц")
+	f("жыццём", "жыцц")
+	f("шчасце", "шчасц")
+	f("каханне", "кахан")
+	f("кахання", "кахан")
+	f("добры", "добр")
+	f("добрая", "добр")
+	f("добрага", "добр")
+	f("добра", "добр")
+	f("хутка", "хутк")
+	f("хуткі", "хутк")
+	f("хуткасць", "хуткасц")
+	f("хуткасці", "хуткасц")
+}
+
+func TestBelarusianStemmer_regions(t *testing.T) {
+	s := NewBelarusianStemmer()
+
+	f := func(word, rv, r2 string) {
+		t.Helper()
+		rvStart, r2Start := s.regions(word)
+		require.Equal(t, rv, word[rvStart:])
+		require.Equal(t, r2, word[r2Start:])
+	}
+
+	f("магчымасць", "гчымасць", "асць")
+	f("чытаць", "таць", "ь")
+	f("кніга", "га", "")
+	f("сквер", "р", "")
+}
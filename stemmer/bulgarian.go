@@ -0,0 +1,258 @@
+package stemmer
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+var (
+	bgStopWords = map[string]struct{}{
+		"и":      {},
+		"в":      {},
+		"във":    {},
+		"на":     {},
+		"с":      {},
+		"със":    {},
+		"за":     {},
+		"от":     {},
+		"до":     {},
+		"по":     {},
+		"при":    {},
+		"през":   {},
+		"към":    {},
+		"под":    {},
+		"над":    {},
+		"без":    {},
+		"между":  {},
+		"след":   {},
+		"пред":   {},
+		"преди":  {},
+		"че":     {},
+		"да":     {},
+		"не":     {},
+		"ни":     {},
+		"а":      {},
+		"но":     {},
+		"или":    {},
+		"ако":    {},
+		"като":   {},
+		"когато": {},
+		"където": {},
+		"как":    {},
+		"какво":  {},
+		"кой":    {},
+		"коя":    {},
+		"кое":    {},
+		"кои":    {},
+		"който":  {},
+		"която":  {},
+		"което":  {},
+		"които":  {},
+		"аз":     {},
+		"ти":     {},
+		"той":    {},
+		"тя":     {},
+		"то":     {},
+		"ние":    {},
+		"вие":    {},
+		"те":     {},
+		"мен":    {},
+		"теб":    {},
+		"него":   {},
+		"нея":    {},
+		"нас":    {},
+		"вас":    {},
+		"тях":    {},
+		"ме":     {},
+		"го":     {},
+		"я":      {},
+		"ги":     {},
+		"ви":     {},
+		"ми":     {},
+		"му":     {},
+		"ѝ":      {},
+		"им":     {},
+		"си":     {},
+		"се":     {},
+		"съм":    {},
+		"е":      {},
+		"сме":    {},
+		"сте":    {},
+		"са":     {},
+		"бях":    {},
+		"беше":   {},
+		"бяха":   {},
+		"бил":    {},
+		"била":   {},
+		"било":   {},
+		"били":   {},
+		"ще":     {},
+		"този":   {},
+		"тази":   {},
+		"това":   {},
+		"тези":   {},
+		"онзи":   {},
+		"онази":  {},
+		"онова":  {},
+		"онези":  {},
+		"всички": {},
+		"всеки":  {},
+		"всичко": {},
+		"също":   {},
+		"още":    {},
+		"вече":   {},
+		"само":   {},
+		"много":  {},
+		"тук":    {},
+		"там":    {},
+		"защо":   {},
+		"дали":   {},
+		"нито":   {},
+		"нещо":   {},
+		"някой":  {},
+		"мой":    {},
+		"моя":    {},
+		"мое":    {},
+		"мои":    {},
+		"твой":   {},
+		"твоя":   {},
+		"твое":   {},
+		"твои":   {},
+		"негов":  {},
+		"неин":   {},
+		"наш":    {},
+		"ваш":    {},
+		"техен":  {},
+		"свой":   {},
+	}
+
+	// bgRules maps an inflectional ending to the text that replaces it, in
+	// the manner of BulStem's stemming rules.
+	bgRules = map[string]string{
+		// Noun and adjective endings, with the definite article.
+		"а":     "",
+		"я":     "",
+		"о":     "",
+		"е":     "",
+		"и":     "",
+		"й":     "",
+		"ът":    "",
+		"ят":    "",
+		"тта":   "т",
+		"ата":   "",
+		"ята":   "",
+		"ото":   "",
+		"ето":   "",
+		"ите":   "",
+		"ии":    "",
+		"иите":  "",
+		"ия":    "",
+		"ият":   "",
+		"ие":    "",
+		"ието":  "",
+		"ията":  "",
+		"ове":   "",
+		"овете": "",
+		"ища":   "",
+		"ци":    "к",
+		"ците":  "к",
+		"зи":    "г",
+		"зите":  "г",
+		"си":    "х",
+		"сите":  "х",
+
+		// Verb endings.
+		"еш":   "",
+		"ем":   "",
+		"ете":  "",
+		"ат":   "",
+		"иш":   "",
+		"им":   "",
+		"ох":   "",
+		"охме": "",
+		"охте": "",
+		"оха":  "",
+		"их":   "",
+		"ихме": "",
+		"ихте": "",
+		"иха":  "",
+		"ахме": "",
+		"ахте": "",
+		"аха":  "",
+		"яхме": "",
+		"яхте": "",
+		"яха":  "",
+		"ал":   "",
+		"ала":  "",
+		"ало":  "",
+		"али":  "",
+		"ял":   "",
+		"яла":  "",
+		"яло":  "",
+		"яли":  "",
+		"ил":   "",
+		"ила":  "",
+		"ило":  "",
+		"или":  "",
+		"ейки": "",
+		"айки": "",
+		"яйки": "",
+	}
+)
+
+type BulgarianStemmer struct{}
+
+// NewBulgarianStemmer creates a new BulgarianStemmer.
+func NewBulgarianStemmer() *BulgarianStemmer {
+	return &BulgarianStemmer{}
+}
+
+// Stem returns the stem of the given word. The longest ending that has a
+// rule and leaves at least the first vowel and one more letter is replaced.
+func (s BulgarianStemmer) Stem(word string) string {
+	word = strings.ToLower(word)
+	if s.isStopWord(word) {
+		return word
+	}
+
+	for i := s.stemEnd(word); i < len(word); {
+		if replacement, ok := bgRules[word[i:]]; ok {
+			return word[:i] + replacement
+		}
+		_, size := utf8.DecodeRuneInString(word[i:])
+		i += size
+	}
+	return word
+}
+
+// stemEnd returns the byte offset of the letter after the one that follows
+// the first vowel, or len(word) if there is none.
+func (s BulgarianStemmer) stemEnd(word string) int {
+	i := strings.IndexFunc(word, s.isVowel)
+	if i < 0 {
+		return len(word)
+	}
+	for range 2 {
+		if i >= len(word) {
+			break
+		}
+		_, size := utf8.DecodeRuneInString(word[i:])
+		i += size
+	}
+	return i
+}
+
+// isStopWord returns true if the given word is a stop word.
+func (s BulgarianStemmer) isStopWord(word string) bool {
+	_, found := bgStopWords[word]
+	return found
+}
+
+func (s BulgarianStemmer) isVowel(r rune) bool {
+	switch r {
+	case 'а', 'ъ', 'о', 'у', 'е', 'и', 'я', 'ю':
+		return true
+	default:
+		return false
+	}
+}
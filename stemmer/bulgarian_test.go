@@ -0,0 +1,170 @@
+package stemmer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBulgarianStemmer(t *testing.T) {
+	s := NewBulgarianStemmer()
+	require.NotNil(t, s)
+}
+
+func TestBulgarianStemmer_isStopWord(t *testing.T) {
+	s := NewBulgarianStemmer()
+	require.True(t, s.isStopWord("и"))
+	require.False(t, s.isStopWord("книга"))
+}
+
+func TestBulgarianStemmer_Stem(t *testing.T) {
+	s := NewBulgarianStemmer()
+	t.Run("stop word", func(t *testing.T) {
+		require.Equal(t, "като", s.Stem("като"))
+		require.Equal(t, "като", s.Stem("Като"))
+	})
+
+	f := func(word, stem string) {
+		t.Helper()
+		require.Equal(t, stem, s.Stem(word))
+	}
+
+	f("град", "град")
+	f("града", "град")
+	f("градът", "град")
+	f("градове", "град")
+	f("градовете", "град")
+	f("учител", "учител")
+	f("учителя", "учител")
+	f("учителят", "учител")
+	f("учители", "учител")
+	f("учителите", "учител")
+	f("герой", "геро")
+	f("героя", "геро")
+	f("героят", "геро")
+	f("герои", "геро")
+	f("героите", "геро")
+	f("ученик", "ученик")
+	f("ученика", "ученик")
+	f("ученикът", "ученик")
+	f("ученици", "ученик")
+	f("учениците", "ученик")
+	f("жена", "жен")
+	f("жената", "жен")
+	f("жени", "жен")
+	f("жените", "жен")
+	f("радост", "радост")
+	f("радостта", "радост")
+	f("радости", "радост")
+	f("радостите", "радост")
+	f("село", "сел")
+	f("селото", "сел")
+	f("села", "сел")
+	f("селата", "сел")
+	f("море", "мор")
+	f("морето", "мор")
+	f("знание", "знан")
+	f("знанието", "знан")
+	f("знания", "знан")
+	f("знанията", "знан")
+	f("история", "истор")
+	f("историята", "истор")
+	f("истории", "истор")
+	f("нов", "нов")
+	f("нова", "нов")
+	f("новата", "нов")
+	f("ново", "нов")
+	f("новото", "нов")
+	f("нови", "нов")
+	f("новите", "нов")
+	f("новия", "нов")
+	f("новият", "нов")
+	f("български", "българск")
+	f("българска", "българск")
+	f("българската", "българск")
+	f("българско", "българск")
+	f("българското", "българск")
+	f("българските", "българск")
+	f("българския", "българск")
+	f("българският", "българск")
+	f("карта", "карт")
+	f("картата", "карт")
+	f("карти", "карт")
+	f("картите", "карт")
+	f("книга", "книг")
+	f("книгата", "книг")
+	f("книги", "книг")
+	f("книгите", "книг")
+	f("човек", "човек")
+	f("човека", "човек")
+	f("човекът", "човек")
+	f("хора", "хор")
+	f("хората", "хор")
+	f("държава", "държав")
+	f("държавата", "държав")
+	f("държави", "държав")
+	f("правителство", "правителств")
+	f("правителството", "правителств")
+	f("президент", "президент")
+	f("президента", "президент")
+	f("президентът", "президент")
+	f("чета", "чет")
+	f("четеш", "чет")
+	f("чете", "чет")
+	f("четем", "чет")
+	f("четете", "чет")
+	f("четат", "чет")
+	f("четох", "чет")
+	f("четохме", "чет")
+	f("четоха", "чет")
+	f("говоря", "говор")
+	f("говориш", "говор")
+	f("говори", "говор")
+	f("говорим", "говор")
+	f("говорите", "говор")
+	f("говорят", "говор")
+	f("говорих", "говор")
+	f("говорихме", "говор")
+	f("говориха", "говор")
+	f("говорил", "говор")
+	f("говорила", "говор")
+	f("говорили", "говор")
+	f("говорейки", "говор")
+	f("работя", "работ")
+	f("работиш", "работ")
+	f("работи", "работ")
+	f("работим", "работ")
+	f("работят", "работ")
+	f("работил", "работ")
+	f("работила", "работ")
+	f("работа", "работ")
+	f("работата", "работ")
+	f("работник", "работник")
+	f("работници", "работник")
+	f("работниците", "работник")
+	f("пиша", "пиш")
+	f("пишеш", "пиш")
+	f("пише", "пиш")
+	f("пишат", "пиш")
+	f("писах", "писах")
+	f("писал", "пис")
+	f("писала", "пис")
+	f("вятър", "вятър")
+	f("вятърът", "вятър")
+	f("монах", "монах")
+	f("монаси", "монах")
+}
+
+func TestBulgarianStemmer_stemEnd(t *testing.T) {
+	s := NewBulgarianStemmer()
+
+	f := func(word, ending string) {
+		t.Helper()
+		require.Equal(t, ending, word[s.stemEnd(word):])
+	}
+
+	f("градовете", "овете")
+	f("учителите", "ителите")
+	f("стр", "")
+	f("пия", "")
+}
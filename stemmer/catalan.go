@@ -0,0 +1,602 @@
+package stemmer
+
+import "strings"
+
+var (
+	caStopWords = map[string]struct{}{
+		"a":           {},
+		"abans":       {},
+		"ací":         {},
+		"ah":          {},
+		"així":        {},
+		"això":        {},
+		"al":          {},
+		"aleshores":   {},
+		"algun":       {},
+		"alguna":      {},
+		"algunes":     {},
+		"alguns":      {},
+		"alhora":      {},
+		"allà":        {},
+		"allí":        {},
+		"allò":        {},
+		"als":         {},
+		"altra":       {},
+		"altre":       {},
+		"altres":      {},
+		"amb":         {},
+		"ambdues":     {},
+		"ambdós":      {},
+		"anar":        {},
+		"ans":         {},
+		"apa":         {},
+		"aquell":      {},
+		"aquella":     {},
+		"aquelles":    {},
+		"aquells":     {},
+		"aquest":      {},
+		"aquesta":     {},
+		"aquestes":    {},
+		"aquests":     {},
+		"aquí":        {},
+		"baix":        {},
+		"bastant":     {},
+		"bé":          {},
+		"cada":        {},
+		"cadascuna":   {},
+		"cadascunes":  {},
+		"cadascuns":   {},
+		"cadascú":     {},
+		"com":         {},
+		"contra":      {},
+		"d":           {},
+		"de":          {},
+		"del":         {},
+		"des":         {},
+		"després":     {},
+		"dins":        {},
+		"dintre":      {},
+		"donat":       {},
+		"doncs":       {},
+		"durant":      {},
+		"e":           {},
+		"eh":          {},
+		"el":          {},
+		"elles":       {},
+		"ells":        {},
+		"els":         {},
+		"em":          {},
+		"en":          {},
+		"encara":      {},
+		"ens":         {},
+		"entre":       {},
+		"era":         {},
+		"erem":        {},
+		"eren":        {},
+		"eres":        {},
+		"es":          {},
+		"estan":       {},
+		"estat":       {},
+		"estava":      {},
+		"estaven":     {},
+		"estem":       {},
+		"esteu":       {},
+		"estic":       {},
+		"està":        {},
+		"ets":         {},
+		"fa":          {},
+		"faig":        {},
+		"fan":         {},
+		"fas":         {},
+		"fem":         {},
+		"fer":         {},
+		"feu":         {},
+		"fi":          {},
+		"fins":        {},
+		"fora":        {},
+		"gairebé":     {},
+		"ha":          {},
+		"han":         {},
+		"has":         {},
+		"haver":       {},
+		"hi":          {},
+		"ho":          {},
+		"i":           {},
+		"igual":       {},
+		"iguals":      {},
+		"inclòs":      {},
+		"ja":          {},
+		"jo":          {},
+		"l":           {},
+		"la":          {},
+		"les":         {},
+		"li":          {},
+		"llarg":       {},
+		"llavors":     {},
+		"lo":          {},
+		"los":         {},
+		"m":           {},
+		"mateix":      {},
+		"mateixa":     {},
+		"mateixes":    {},
+		"mateixos":    {},
+		"me":          {},
+		"mentre":      {},
+		"meu":         {},
+		"meus":        {},
+		"meva":        {},
+		"meves":       {},
+		"molt":        {},
+		"molta":       {},
+		"moltes":      {},
+		"molts":       {},
+		"mon":         {},
+		"mons":        {},
+		"més":         {},
+		"n":           {},
+		"na":          {},
+		"ne":          {},
+		"ni":          {},
+		"no":          {},
+		"nogensmenys": {},
+		"només":       {},
+		"nosaltres":   {},
+		"nostra":      {},
+		"nostre":      {},
+		"nostres":     {},
+		"o":           {},
+		"oh":          {},
+		"oi":          {},
+		"on":          {},
+		"pas":         {},
+		"pel":         {},
+		"pels":        {},
+		"per":         {},
+		"perquè":      {},
+		"però":        {},
+		"poc":         {},
+		"poca":        {},
+		"pocs":        {},
+		"podem":       {},
+		"poden":       {},
+		"poder":       {},
+		"podeu":       {},
+		"poques":      {},
+		"potser":      {},
+		"primer":      {},
+		"propi":       {},
+		"puc":         {},
+		"qual":        {},
+		"quals":       {},
+		"quan":        {},
+		"quant":       {},
+		"que":         {},
+		"quelcom":     {},
+		"qui":         {},
+		"quin":        {},
+		"quina":       {},
+		"quines":      {},
+		"quins":       {},
+		"què":         {},
+		"s":           {},
+		"sa":          {},
+		"sabem":       {},
+		"saben":       {},
+		"saber":       {},
+		"sabeu":       {},
+		"sap":         {},
+		"saps":        {},
+		"se":          {},
+		"sense":       {},
+		"sent":        {},
+		"ser":         {},
+		"seran":       {},
+		"serem":       {},
+		"seré":        {},
+		"seu":         {},
+		"seus":        {},
+		"seva":        {},
+		"seves":       {},
+		"si":          {},
+		"sobre":       {},
+		"sobretot":    {},
+		"sols":        {},
+		"som":         {},
+		"sota":        {},
+		"sou":         {},
+		"sovint":      {},
+		"suficient":   {},
+		"sí":          {},
+		"t":           {},
+		"ta":          {},
+		"tal":         {},
+		"també":       {},
+		"tampoc":      {},
+		"tan":         {},
+		"tant":        {},
+		"tanta":       {},
+		"tantes":      {},
+		"te":          {},
+		"tenim":       {},
+		"tenir":       {},
+		"teniu":       {},
+		"teu":         {},
+		"teus":        {},
+		"teva":        {},
+		"teves":       {},
+		"tot":         {},
+		"totes":       {},
+		"tots":        {},
+		"trobar":      {},
+		"u":           {},
+		"un":          {},
+		"una":         {},
+		"unes":        {},
+		"uns":         {},
+		"us":          {},
+		"va":          {},
+		"vaig":        {},
+		"vam":         {},
+		"van":         {},
+		"vas":         {},
+		"veu":         {},
+		"vosaltres":   {},
+		"vostra":      {},
+		"vostre":      {},
+		"vostres":     {},
+		"últim":       {},
+	}
+
+	// caPronouns are the pronouns that attach to a verb after a hyphen or an
+	// apostrophe, as in donar-me-la or porta'ls.
+	caPronouns = map[string]struct{}{
+		"en":  {},
+		"ens": {},
+		"hi":  {},
+		"ho":  {},
+		"l":   {},
+		"la":  {},
+		"les": {},
+		"li":  {},
+		"lo":  {},
+		"los": {},
+		"ls":  {},
+		"m":   {},
+		"me":  {},
+		"n":   {},
+		"ne":  {},
+		"nos": {},
+		"ns":  {},
+		"s":   {},
+		"se":  {},
+		"t":   {},
+		"te":  {},
+		"us":  {},
+		"vos": {},
+	}
+
+	caStandardSuffixes = []string{
+		"íssimament",
+		"quíssimes",
+		"lògiques",
+		"quíssima",
+		"quíssims",
+		"quíssim",
+		"íssimes",
+		"lògica",
+		"lògics",
+		"àncies",
+		"ències",
+		"íssima",
+		"íssims",
+		"acions",
+		"adores",
+		"icions",
+		"logies",
+		"lògic",
+		"ucions",
+		"ància",
+		"àries",
+		"ència",
+		"íssim",
+		"ables",
+		"ació",
+		"adora",
+		"adors",
+		"ament",
+		"ances",
+		"ança",
+		"atges",
+		"ibles",
+		"ició",
+		"ismes",
+		"istes",
+		"itats",
+		"logia",
+		"ments",
+		"ució",
+		"ària",
+		"able",
+		"ador",
+		"aris",
+		"atge",
+		"ible",
+		"isme",
+		"ista",
+		"itat",
+		"ives",
+		"ment",
+		"oses",
+		"osos",
+		"ari",
+		"ius",
+		"iva",
+		"osa",
+		"ós",
+		"iu",
+	}
+
+	caVerbSuffixes = []string{
+		"aríem",
+		"aríeu",
+		"eríem",
+		"eríeu",
+		"iríem",
+		"iríeu",
+		"àssim",
+		"àssiu",
+		"éssim",
+		"éssiu",
+		"íssim",
+		"íssiu",
+		"arien",
+		"aries",
+		"aràs",
+		"assin",
+		"assis",
+		"eixem",
+		"eixen",
+		"eixes",
+		"eixeu",
+		"eixin",
+		"eixis",
+		"erien",
+		"eries",
+		"eràs",
+		"essin",
+		"essis",
+		"irien",
+		"iries",
+		"iràs",
+		"issin",
+		"issis",
+		"ríem",
+		"ríeu",
+		"àrem",
+		"àreu",
+		"àvem",
+		"àveu",
+		"èrem",
+		"èreu",
+		"írem",
+		"íreu",
+		"ades",
+		"aran",
+		"arem",
+		"aren",
+		"ares",
+		"areu",
+		"aria",
+		"arà",
+		"aré",
+		"aven",
+		"aves",
+		"eixi",
+		"eixo",
+		"eran",
+		"erem",
+		"eren",
+		"eres",
+		"ereu",
+		"eria",
+		"erà",
+		"eré",
+		"ides",
+		"iran",
+		"irem",
+		"iren",
+		"ireu",
+		"iria",
+		"irà",
+		"iré",
+		"rien",
+		"ries",
+		"ràs",
+		"udes",
+		"íem",
+		"íeu",
+		"ada",
+		"ant",
+		"ats",
+		"ava",
+		"eix",
+		"ent",
+		"ida",
+		"ien",
+		"ies",
+		"int",
+		"its",
+		"ran",
+		"rem",
+		"reu",
+		"ria",
+		"rà",
+		"ré",
+		"uda",
+		"uts",
+		"às",
+		"ís",
+		"ar",
+		"at",
+		"em",
+		"en",
+		"er",
+		"es",
+		"eu",
+		"ia",
+		"in",
+		"ir",
+		"is",
+		"it",
+		"ut",
+	}
+
+	caResidualSuffixes = []string{
+		"ions", "as", "es", "is", "os", "us", "ió", "à", "é", "í", "ó", "a", "e",
+		"i", "o", "s",
+	}
+
+	// caNormalizer unifies apostrophes and the spellings of the geminated
+	// l (l·l), which is also typed with a full stop, a bullet or the
+	// precomposed ŀ.
+	caNormalizer = strings.NewReplacer("’", "'", "l·l", "ll", "l.l", "ll", "l•l", "ll", "ŀl", "ll")
+
+	caAccentReplacer = strings.NewReplacer(
+		"á", "a", "à", "a", "é", "e", "è", "e", "í", "i", "ï", "i",
+		"ó", "o", "ò", "o", "ú", "u", "ü", "u",
+	)
+)
+
+type CatalanStemmer struct{}
+
+// NewCatalanStemmer creates a new CatalanStemmer.
+func NewCatalanStemmer() *CatalanStemmer {
+	return &CatalanStemmer{}
+}
+
+// Stem returns the stem of the given word.
+func (s CatalanStemmer) Stem(word string) string {
+	word = caNormalizer.Replace(strings.ToLower(word))
+	if s.isStopWord(word) {
+		return word
+	}
+
+	word = s.attachedPronoun(word)
+	r1, r2 := standardRegions(word, s.isVowel)
+
+	var removed bool
+	if word, removed = s.standardSuffix(word, r1, r2); !removed {
+		word = s.verbSuffix(word, r1)
+	}
+
+	word = s.residualSuffix(word, r1)
+
+	return caAccentReplacer.Replace(word)
+}
+
+// attachedPronoun removes an elided article or pronoun in front of the
+// word, as in l'home, and the pronouns attached after a verb, as in
+// donar-se-li or dona'm.
+func (s CatalanStemmer) attachedPronoun(word string) string {
+	if len(word) > 2 && word[1] == '\'' && strings.IndexByte("dlmnst", word[0]) >= 0 {
+		word = word[2:]
+	}
+
+	for {
+		i := strings.LastIndexAny(word, "-'")
+		if i <= 0 {
+			return word
+		}
+		if _, found := caPronouns[word[i+1:]]; !found {
+			return word
+		}
+		word = word[:i]
+	}
+}
+
+func (s CatalanStemmer) standardSuffix(word string, r1, r2 int) (string, bool) {
+	suffix := longestSuffix(word, caStandardSuffixes)
+	if suffix == "" {
+		return word, false
+	}
+
+	switch suffix {
+	case "íssimament", "íssima", "íssimes", "íssims", "íssim", "ament":
+		if !inRegion(word, suffix, r1) {
+			return word, false
+		}
+		return word[:len(word)-len(suffix)], true
+	case "quíssima", "quíssimes", "quíssims", "quíssim":
+		if !inRegion(word, suffix, r1) {
+			return word, false
+		}
+		return word[:len(word)-len(suffix)] + "c", true
+	}
+
+	if !inRegion(word, suffix, r2) {
+		return word, false
+	}
+	word = word[:len(word)-len(suffix)]
+
+	switch suffix {
+	case "ador", "adora", "adors", "adores", "ació", "acions":
+		word = s.trimInR2(word, "ic", r2)
+	case "logia", "logies", "lògic", "lògica", "lògics", "lògiques":
+		word += "log"
+	case "ució", "ucions":
+		word += "u"
+	case "itat", "itats":
+		if next := longestSuffix(word, []string{"abil", "ic", "iv"}); next != "" {
+			word = s.trimInR2(word, next, r2)
+		}
+	case "iu", "iva", "ius", "ives":
+		word = s.trimInR2(word, "at", r2)
+	}
+	return word, true
+}
+
+func (s CatalanStemmer) verbSuffix(word string, r1 int) string {
+	suffix := longestSuffix(region(word, r1), caVerbSuffixes)
+	return word[:len(word)-len(suffix)]
+}
+
+// residualSuffix removes a final vowel or plural ending in R1, and turns a
+// final iqu left by -iques back into ic. A lone s is only removed after a
+// consonant, so that famós and famosa share a stem.
+func (s CatalanStemmer) residualSuffix(word string, r1 int) string {
+	suffix := longestSuffix(word, caResidualSuffixes)
+	if suffix == "s" && s.isVowel(lastRune(strings.TrimSuffix(word, suffix))) {
+		suffix = ""
+	}
+	if suffix != "" && inRegion(word, suffix, r1) {
+		word = word[:len(word)-len(suffix)]
+	}
+
+	if strings.HasSuffix(word, "iqu") && inRegion(word, "iqu", r1) {
+		word = strings.TrimSuffix(word, "qu") + "c"
+	}
+	return word
+}
+
+// trimInR2 removes suffix from word if word ends with it inside R2.
+func (s CatalanStemmer) trimInR2(word, suffix string, r2 int) string {
+	if strings.HasSuffix(word, suffix) && inRegion(word, suffix, r2) {
+		return word[:len(word)-len(suffix)]
+	}
+	return word
+}
+
+// isStopWord returns true if the given word is a stop word.
+func (s CatalanStemmer) isStopWord(word string) bool {
+	_, found := caStopWords[word]
+	return found
+}
+
+func (s CatalanStemmer) isVowel(r rune) bool {
+	switch r {
+	case 'a', 'e', 'i', 'o', 'u', 'á', 'à', 'é', 'è', 'í', 'ï', 'ó', 'ò', 'ú', 'ü':
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,142 @@
+package stemmer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCatalanStemmer(t *testing.T) {
+	s := NewCatalanStemmer()
+	require.NotNil(t, s)
+}
+
+func TestCatalanStemmer_isStopWord(t *testing.T) {
+	s := NewCatalanStemmer()
+	require.True(t, s.isStopWord("perquè"))
+	require.False(t, s.isStopWord("casa"))
+}
+
+func TestCatalanStemmer_Stem(t *testing.T) {
+	s := NewCatalanStemmer()
+	t.Run("stop word", func(t *testing.T) {
+		require.Equal(t, "i", s.Stem("i"))
+		require.Equal(t, "però", s.Stem("Però"))
+	})
+
+	t.Run("middle dot", func(t *testing.T) {
+		for _, word := range []string{"col·lecció", "col.lecció", "coŀlecció", "COĿLECCIÓ", "col•lecció", "collecció"} {
+			require.Equal(t, "collecc", s.Stem(word), word)
+		}
+	})
+
+	f := func(word, stem string) {
+		t.Helper()
+		require.Equal(t, stem, s.Stem(word))
+	}
+
+	f("casa", "cas")
+	f("cases", "cas")
+	f("gats", "gat")
+	f("gat", "gat")
+	f("llibre", "llibr")
+	f("llibres", "llibr")
+	f("col·lecció", "collecc")
+	f("col·leccions", "collecc")
+	f("intel·ligent", "intellig")
+	f("intel·ligència", "intellig")
+	f("il·lusió", "illus")
+	f("paral·lel", "parallel")
+	f("donar-me-la", "don")
+	f("donar-li", "don")
+	f("dona'm", "don")
+	f("porta'ls", "port")
+	f("fes-ho", "fes")
+	f("anar-se'n", "an")
+	f("l'home", "hom")
+	f("d'aigua", "aigu")
+	f("l’escola", "escol")
+	f("nacional", "nacional")
+	f("nacionalitat", "nacional")
+	f("nacionalitats", "nacional")
+	f("ciutat", "ciut")
+	f("ciutats", "ciut")
+	f("universitat", "univer")
+	f("universitats", "univer")
+	f("política", "politic")
+	f("polítiques", "politic")
+	f("polític", "politic")
+	f("polítics", "politic")
+	f("parlar", "parl")
+	f("parlava", "parl")
+	f("parlàvem", "parl")
+	f("parlaré", "parl")
+	f("parlaria", "parl")
+	f("parlessin", "parl")
+	f("parlant", "parl")
+	f("parlat", "parl")
+	f("parlada", "parl")
+	f("parlades", "parl")
+	f("cantem", "cant")
+	f("canteu", "cant")
+	f("canten", "cant")
+	f("serveix", "serv")
+	f("serveixen", "serv")
+	f("servir", "serv")
+	f("llengua", "llengu")
+	f("llengües", "llengu")
+	f("riquíssim", "riqu")
+	f("bellíssima", "bell")
+	f("clarament", "clar")
+	f("ràpidament", "rapid")
+	f("coneixement", "coneix")
+	f("coneixements", "coneix")
+	f("creació", "creac")
+	f("creacions", "creac")
+	f("biologia", "biolog")
+	f("biològic", "biologic")
+	f("importància", "import")
+	f("independència", "independ")
+	f("independent", "independ")
+	f("treballador", "treball")
+	f("treballadora", "treball")
+	f("treballadors", "treball")
+	f("activitat", "activ")
+	f("activitats", "activ")
+	f("creativa", "creativ")
+	f("creatius", "creati")
+	f("famós", "famos")
+	f("famosa", "famos")
+	f("famosos", "famos")
+	f("fotografia", "fotograf")
+	f("fotògraf", "fotograf")
+	f("perdre", "perdr")
+	f("perdem", "perd")
+	f("història", "histo")
+	f("històries", "histo")
+	f("europeu", "europ")
+	f("europea", "europe")
+	f("europees", "europ")
+	f("Barcelona", "barcelon")
+	f("Catalunya", "cataluny")
+	f("catalans", "catalan")
+	f("catalanes", "catalan")
+}
+
+func TestCatalanStemmer_attachedPronoun(t *testing.T) {
+	s := NewCatalanStemmer()
+
+	f := func(word, expected string) {
+		t.Helper()
+		require.Equal(t, expected, s.attachedPronoun(word))
+	}
+
+	f("donar-me-la", "donar")
+	f("anar-se'n", "anar")
+	f("dona'm", "dona")
+	f("l'home", "home")
+	f("d'aigua", "aigua")
+	f("nord-americà", "nord-americà")
+	f("-la", "-la")
+	f("l'", "l'")
+}
@@ -0,0 +1,213 @@
+package stemmer
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+var (
+	daStopWords = map[string]struct{}{
+		"og":     {},
+		"i":      {},
+		"jeg":    {},
+		"det":    {},
+		"at":     {},
+		"en":     {},
+		"den":    {},
+		"til":    {},
+		"er":     {},
+		"som":    {},
+		"på":     {},
+		"de":     {},
+		"med":    {},
+		"han":    {},
+		"af":     {},
+		"for":    {},
+		"ikke":   {},
+		"der":    {},
+		"var":    {},
+		"mig":    {},
+		"sig":    {},
+		"men":    {},
+		"et":     {},
+		"har":    {},
+		"om":     {},
+		"vi":     {},
+		"min":    {},
+		"havde":  {},
+		"ham":    {},
+		"hun":    {},
+		"nu":     {},
+		"over":   {},
+		"da":     {},
+		"fra":    {},
+		"du":     {},
+		"ud":     {},
+		"sin":    {},
+		"dem":    {},
+		"os":     {},
+		"op":     {},
+		"man":    {},
+		"hans":   {},
+		"hvor":   {},
+		"eller":  {},
+		"hvad":   {},
+		"skal":   {},
+		"selv":   {},
+		"her":    {},
+		"alle":   {},
+		"vil":    {},
+		"blev":   {},
+		"kunne":  {},
+		"ind":    {},
+		"når":    {},
+		"være":   {},
+		"dog":    {},
+		"noget":  {},
+		"ville":  {},
+		"jo":     {},
+		"deres":  {},
+		"efter":  {},
+		"ned":    {},
+		"skulle": {},
+		"denne":  {},
+		"end":    {},
+		"dette":  {},
+		"mit":    {},
+		"også":   {},
+		"under":  {},
+		"have":   {},
+		"dig":    {},
+		"anden":  {},
+		"hende":  {},
+		"mine":   {},
+		"alt":    {},
+		"meget":  {},
+		"sit":    {},
+		"sine":   {},
+		"vor":    {},
+		"mod":    {},
+		"disse":  {},
+		"hvis":   {},
+		"din":    {},
+		"nogle":  {},
+		"hos":    {},
+		"blive":  {},
+		"mange":  {},
+		"ad":     {},
+		"bliver": {},
+		"hendes": {},
+		"været":  {},
+		"thi":    {},
+		"jer":    {},
+		"sådan":  {},
+	}
+
+	daMainSuffixes = []string{
+		"erendes", "erende", "hedens", "endes", "erede", "erens", "erets", "ernes",
+		"ethed", "heden", "heder", "ende", "enes", "ered", "eren", "erer", "eres",
+		"eret", "erne", "heds", "ene", "ens", "ere", "ers", "ets", "hed", "en", "er",
+		"es", "et", "e", "s",
+	}
+
+	daConsonantPairs = []string{"gd", "dt", "gt", "kt"}
+	daOtherSuffixes  = []string{"elig", "løst", "els", "lig", "ig"}
+	daValidSEndings  = "abcdfghjklmnoprtvyzå"
+)
+
+type DanishStemmer struct{}
+
+// NewDanishStemmer creates a new DanishStemmer.
+func NewDanishStemmer() *DanishStemmer {
+	return &DanishStemmer{}
+}
+
+// Stem returns the stem of the given word.
+func (s DanishStemmer) Stem(word string) string {
+	word = strings.ToLower(word)
+	if s.isStopWord(word) {
+		return word
+	}
+
+	r1 := s.regions(word)
+
+	word = s.mainSuffix(word, r1)
+	word = s.consonantPair(word, r1)
+	word = s.otherSuffix(word, r1)
+	word = s.undouble(word, r1)
+
+	return word
+}
+
+func (s DanishStemmer) mainSuffix(word string, r1 int) string {
+	suffix := longestSuffix(region(word, r1), daMainSuffixes)
+	if suffix == "" {
+		return word
+	}
+	stem := word[:len(word)-len(suffix)]
+
+	if suffix == "s" && !strings.ContainsRune(daValidSEndings, lastRune(stem)) {
+		return word
+	}
+	return stem
+}
+
+// consonantPair removes the last letter of a final gd, dt, gt or kt in R1.
+func (s DanishStemmer) consonantPair(word string, r1 int) string {
+	if longestSuffix(region(word, r1), daConsonantPairs) == "" {
+		return word
+	}
+	return word[:len(word)-1]
+}
+
+func (s DanishStemmer) otherSuffix(word string, r1 int) string {
+	if strings.HasSuffix(word, "igst") {
+		word = word[:len(word)-2]
+	}
+
+	suffix := longestSuffix(region(word, r1), daOtherSuffixes)
+	switch suffix {
+	case "":
+		return word
+	case "løst":
+		return word[:len(word)-1]
+	}
+	return s.consonantPair(word[:len(word)-len(suffix)], r1)
+}
+
+// undouble removes the last letter of a final double consonant whose last
+// letter is in R1.
+func (s DanishStemmer) undouble(word string, r1 int) string {
+	last := lastRune(region(word, r1))
+	if last == utf8.RuneError || s.isVowel(last) {
+		return word
+	}
+
+	stem := word[:len(word)-len(string(last))]
+	if lastRune(stem) != last {
+		return word
+	}
+	return stem
+}
+
+// isStopWord returns true if the given word is a stop word.
+func (s DanishStemmer) isStopWord(word string) bool {
+	_, found := daStopWords[word]
+	return found
+}
+
+func (s DanishStemmer) isVowel(r rune) bool {
+	switch r {
+	case 'a', 'e', 'i', 'o', 'u', 'y', 'æ', 'å', 'ø':
+		return true
+	default:
+		return false
+	}
+}
+
+// regions returns the byte offset of R1, adjusted so that at least 3
+// letters precede it.
+func (s DanishStemmer) regions(word string) int {
+	r1, _ := standardRegions(word, s.isVowel)
+	return atLeastLetters(word, r1, 3)
+}
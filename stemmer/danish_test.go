@@ -0,0 +1,234 @@
+package stemmer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDanishStemmer(t *testing.T) {
+	s := NewDanishStemmer()
+	require.NotNil(t, s)
+}
+
+func TestDanishStemmer_isStopWord(t *testing.T) {
+	s := NewDanishStemmer()
+	require.True(t, s.isStopWord("ikke"))
+	require.False(t, s.isStopWord("huset"))
+}
+
+func TestDanishStemmer_Stem(t *testing.T) {
+	s := NewDanishStemmer()
+	t.Run("stop word", func(t *testing.T) {
+		require.Equal(t, "ikke", s.Stem("ikke"))
+		require.Equal(t, "ikke", s.Stem("Ikke"))
+	})
+
+	f := func(word, stem string) {
+		t.Helper()
+		require.Equal(t, stem, s.Stem(word))
+	}
+
+	f("indtager", "indtag")
+	f("indtagelse", "indtag")
+	f("indtog", "indtog")
+	f("hus", "hus")
+	f("huset", "hus")
+	f("husene", "hus")
+	f("husets", "hus")
+	f("bil", "bil")
+	f("bilen", "bil")
+	f("bilerne", "bil")
+	f("biler", "bil")
+	f("bilernes", "bil")
+	f("dreng", "dreng")
+	f("drengen", "dreng")
+	f("drenge", "dreng")
+	f("drengene", "dreng")
+	f("pige", "pig")
+	f("pigen", "pig")
+	f("piger", "pig")
+	f("pigerne", "pig")
+	f("barn", "barn")
+	f("barnet", "barn")
+	f("børnene", "børn")
+	f("børn", "børn")
+	f("land", "land")
+	f("landet", "land")
+	f("landene", "land")
+	f("landets", "land")
+	f("by", "by")
+	f("byen", "byen")
+	f("byerne", "byern")
+	f("byer", "byer")
+	f("bog", "bog")
+	f("bogen", "bog")
+	f("bøger", "bøg")
+	f("bøgerne", "bøg")
+	f("skole", "skol")
+	f("skolen", "skol")
+	f("skoler", "skol")
+	f("skolerne", "skol")
+	f("lærer", "lær")
+	f("læreren", "lær")
+	f("lærere", "lær")
+	f("lærerne", "lær")
+	f("student", "student")
+	f("studenten", "student")
+	f("studenter", "student")
+	f("studenterne", "student")
+	f("universitet", "universit")
+	f("universitetet", "universitet")
+	f("universiteter", "universitet")
+	f("arbejde", "arbejd")
+	f("arbejder", "arbejd")
+	f("arbejdet", "arbejd")
+	f("arbejdende", "arbejd")
+	f("arbejdere", "arbejd")
+	f("arbejderne", "arbejd")
+	f("skrive", "skriv")
+	f("skriver", "skriv")
+	f("skrev", "skrev")
+	f("skrevet", "skrev")
+	f("skrivende", "skriv")
+	f("læse", "læs")
+	f("læser", "læs")
+	f("læste", "læst")
+	f("læst", "læst")
+	f("læsende", "læs")
+	f("læsere", "læs")
+	f("tale", "tal")
+	f("taler", "tal")
+	f("talte", "talt")
+	f("talende", "tal")
+	f("komme", "kom")
+	f("kommer", "kom")
+	f("kom", "kom")
+	f("kommet", "kom")
+	f("kommende", "kom")
+	f("rejse", "rejs")
+	f("rejser", "rejs")
+	f("rejste", "rejst")
+	f("rejsende", "rejs")
+	f("rejsen", "rejs")
+	f("kærlighed", "kær")
+	f("kærligheden", "kær")
+	f("kærlig", "kær")
+	f("venlig", "ven")
+	f("venlige", "ven")
+	f("venlighed", "ven")
+	f("venligheden", "ven")
+	f("mulig", "mul")
+	f("mulige", "mul")
+	f("muligheden", "mul")
+	f("mulighederne", "mulighed")
+	f("muligheder", "mul")
+	f("lovlig", "lov")
+	f("lovlige", "lov")
+	f("lykkelig", "lyk")
+	f("lykkelige", "lyk")
+	f("ærlig", "ærl")
+	f("ærlighed", "ærl")
+	f("farlig", "far")
+	f("farlige", "far")
+	f("vigtig", "vigt")
+	f("vigtige", "vigt")
+	f("vigtigst", "vigt")
+	f("vigtigste", "vigt")
+	f("hyggelig", "hyg")
+	f("hyggelige", "hyg")
+	f("særligt", "sær")
+	f("særlig", "sær")
+	f("særlige", "sær")
+	f("regering", "regering")
+	f("regeringen", "regering")
+	f("regeringens", "regering")
+	f("samfund", "samfund")
+	f("samfundet", "samfund")
+	f("samfundets", "samfund")
+	f("politik", "politik")
+	f("politisk", "politisk")
+	f("politiske", "politisk")
+	f("økonomi", "økonomi")
+	f("økonomisk", "økonomisk")
+	f("økonomiske", "økonomisk")
+	f("historie", "histori")
+	f("historien", "histori")
+	f("historiske", "historisk")
+	f("kvinde", "kvind")
+	f("kvinden", "kvind")
+	f("kvinder", "kvind")
+	f("kvinderne", "kvind")
+	f("mand", "mand")
+	f("manden", "mand")
+	f("mænd", "mænd")
+	f("mændene", "mænd")
+	f("verden", "verd")
+	f("verdens", "verd")
+	f("vej", "vej")
+	f("vejen", "vej")
+	f("veje", "vej")
+	f("vejene", "vej")
+	f("hund", "hund")
+	f("hunden", "hund")
+	f("hunde", "hund")
+	f("hundene", "hund")
+	f("kat", "kat")
+	f("katten", "kat")
+	f("katte", "kat")
+	f("kattene", "kat")
+	f("stor", "stor")
+	f("store", "stor")
+	f("større", "stør")
+	f("størst", "størst")
+	f("største", "størst")
+	f("lille", "lil")
+	f("små", "små")
+	f("mindre", "mindr")
+	f("mindst", "mindst")
+	f("ny", "ny")
+	f("nyt", "nyt")
+	f("nye", "nye")
+	f("nyere", "nyer")
+	f("nyeste", "nyest")
+	f("gammel", "gammel")
+	f("gammelt", "gammelt")
+	f("gamle", "gaml")
+	f("ældre", "ældr")
+	f("ældst", "ældst")
+	f("hjælpeløst", "hjælpeløs")
+	f("hjælpeløs", "hjælpeløs")
+	f("håbløst", "håbløs")
+	f("kendt", "kend")
+	f("bygget", "byg")
+	f("bygt", "bygt")
+	f("hængt", "hæng")
+	f("begyndt", "begynd")
+	f("billigst", "bil")
+	f("lettest", "lettest")
+	f("grønnere", "grøn")
+	f("grønnest", "grønnest")
+	f("uddannelse", "uddan")
+	f("uddannelser", "uddan")
+	f("uddannelsen", "uddan")
+	f("mennesker", "mennesk")
+	f("menneskerne", "mennesk")
+	f("menneskets", "mennesk")
+	f("kaffe", "kaf")
+	f("kaffen", "kaf")
+}
+
+func TestDanishStemmer_regions(t *testing.T) {
+	s := NewDanishStemmer()
+
+	f := func(word, r1 string) {
+		t.Helper()
+		require.Equal(t, r1, word[s.regions(word):])
+	}
+
+	f("indtager", "tager")
+	f("bilerne", "erne")
+	f("kærlighed", "lighed")
+	f("mand", "d")
+	f("by", "")
+}
@@ -0,0 +1,312 @@
+package stemmer
+
+import "strings"
+
+var (
+	nlStopWords = map[string]struct{}{
+		"de":      {},
+		"en":      {},
+		"van":     {},
+		"ik":      {},
+		"te":      {},
+		"dat":     {},
+		"die":     {},
+		"in":      {},
+		"een":     {},
+		"hij":     {},
+		"het":     {},
+		"niet":    {},
+		"zijn":    {},
+		"is":      {},
+		"was":     {},
+		"op":      {},
+		"aan":     {},
+		"met":     {},
+		"als":     {},
+		"voor":    {},
+		"had":     {},
+		"er":      {},
+		"maar":    {},
+		"om":      {},
+		"hem":     {},
+		"dan":     {},
+		"zou":     {},
+		"of":      {},
+		"wat":     {},
+		"mijn":    {},
+		"men":     {},
+		"dit":     {},
+		"zo":      {},
+		"door":    {},
+		"over":    {},
+		"ze":      {},
+		"zich":    {},
+		"bij":     {},
+		"ook":     {},
+		"tot":     {},
+		"je":      {},
+		"mij":     {},
+		"uit":     {},
+		"der":     {},
+		"daar":    {},
+		"haar":    {},
+		"naar":    {},
+		"heb":     {},
+		"hoe":     {},
+		"heeft":   {},
+		"hebben":  {},
+		"deze":    {},
+		"u":       {},
+		"want":    {},
+		"nog":     {},
+		"zal":     {},
+		"me":      {},
+		"zij":     {},
+		"nu":      {},
+		"ge":      {},
+		"geen":    {},
+		"omdat":   {},
+		"iets":    {},
+		"worden":  {},
+		"toch":    {},
+		"al":      {},
+		"waren":   {},
+		"veel":    {},
+		"meer":    {},
+		"doen":    {},
+		"toen":    {},
+		"moet":    {},
+		"ben":     {},
+		"zonder":  {},
+		"kan":     {},
+		"hun":     {},
+		"dus":     {},
+		"alles":   {},
+		"onder":   {},
+		"ja":      {},
+		"eens":    {},
+		"hier":    {},
+		"wie":     {},
+		"werd":    {},
+		"altijd":  {},
+		"doch":    {},
+		"wordt":   {},
+		"wezen":   {},
+		"kunnen":  {},
+		"ons":     {},
+		"zelf":    {},
+		"tegen":   {},
+		"na":      {},
+		"reeds":   {},
+		"wil":     {},
+		"kon":     {},
+		"niets":   {},
+		"uw":      {},
+		"iemand":  {},
+		"geweest": {},
+		"andere":  {},
+	}
+
+	nlStep1Suffixes  = []string{"heden", "ene", "en", "se", "s"}
+	nlStep3bSuffixes = []string{"baar", "lijk", "bar", "end", "ing", "ig"}
+
+	nlDiaeresisReplacer = strings.NewReplacer(
+		"ä", "a", "á", "a",
+		"ë", "e", "é", "e",
+		"ï", "i", "í", "i",
+		"ö", "o", "ó", "o",
+		"ü", "u", "ú", "u",
+	)
+	nlMarkerReplacer = strings.NewReplacer("I", "i", "Y", "y")
+)
+
+type DutchStemmer struct{}
+
+// NewDutchStemmer creates a new DutchStemmer.
+func NewDutchStemmer() *DutchStemmer {
+	return &DutchStemmer{}
+}
+
+// Stem returns the stem of the given word.
+func (s DutchStemmer) Stem(word string) string {
+	word = strings.ToLower(word)
+	if s.isStopWord(word) {
+		return word
+	}
+
+	word = nlDiaeresisReplacer.Replace(word)
+	word = s.markVowels(word)
+	r1, r2 := s.regions(word)
+
+	word = s.step1(word, r1)
+
+	var eFound bool
+	word, eFound = s.eEnding(word, r1)
+
+	word = s.step3a(word, r1, r2)
+	word = s.step3b(word, r1, r2, eFound)
+	word = s.step4(word)
+
+	return nlMarkerReplacer.Replace(word)
+}
+
+func (s DutchStemmer) step1(word string, r1 int) string {
+	suffix := longestSuffix(word, nlStep1Suffixes)
+	if suffix == "" || !inRegion(word, suffix, r1) {
+		return word
+	}
+	stem := word[:len(word)-len(suffix)]
+
+	switch suffix {
+	case "heden":
+		return stem + "heid"
+	case "en", "ene":
+		word, _ = s.enEnding(word, suffix, r1)
+		return word
+	}
+
+	// s and se need a preceding letter that is neither a vowel nor j.
+	if stem == "" || s.isVowel(lastRune(stem)) || lastRune(stem) == 'j' {
+		return word
+	}
+	return stem
+}
+
+// enEnding removes suffix, an en or ene ending, if it is in R1 and follows a
+// non-vowel other than the end of gem, then undoubles the result.
+func (s DutchStemmer) enEnding(word, suffix string, r1 int) (string, bool) {
+	if !inRegion(word, suffix, r1) {
+		return word, false
+	}
+	stem := word[:len(word)-len(suffix)]
+	if stem == "" || s.isVowel(lastRune(stem)) || strings.HasSuffix(stem, "gem") {
+		return word, false
+	}
+	return s.undouble(stem), true
+}
+
+// eEnding removes a final e in R1 that follows a non-vowel, then undoubles
+// the result. It reports whether the e was removed.
+func (s DutchStemmer) eEnding(word string, r1 int) (string, bool) {
+	if !strings.HasSuffix(word, "e") || !inRegion(word, "e", r1) {
+		return word, false
+	}
+	stem := word[:len(word)-1]
+	if stem == "" || s.isVowel(lastRune(stem)) {
+		return word, false
+	}
+	return s.undouble(stem), true
+}
+
+func (s DutchStemmer) step3a(word string, r1, r2 int) string {
+	if !strings.HasSuffix(word, "heid") || !inRegion(word, "heid", r2) || strings.HasSuffix(word, "cheid") {
+		return word
+	}
+	word = word[:len(word)-len("heid")]
+
+	if strings.HasSuffix(word, "en") {
+		word, _ = s.enEnding(word, "en", r1)
+	}
+	return word
+}
+
+func (s DutchStemmer) step3b(word string, r1, r2 int, eFound bool) string {
+	suffix := longestSuffix(word, nlStep3bSuffixes)
+	if suffix == "" || !inRegion(word, suffix, r2) {
+		return word
+	}
+	stem := word[:len(word)-len(suffix)]
+
+	switch suffix {
+	case "end", "ing":
+		if strings.HasSuffix(stem, "ig") && !strings.HasSuffix(stem, "eig") && inRegion(stem, "ig", r2) {
+			return stem[:len(stem)-2]
+		}
+		return s.undouble(stem)
+	case "ig":
+		if strings.HasSuffix(stem, "e") {
+			return word
+		}
+	case "lijk":
+		stem, _ = s.eEnding(stem, r1)
+	case "bar":
+		if !eFound {
+			return word
+		}
+	}
+	return stem
+}
+
+// step4 undoubles a long vowel written as aa, ee, oo or uu when it is
+// enclosed by non-vowels at the end of the word.
+func (s DutchStemmer) step4(word string) string {
+	last := lastRune(word)
+	if word == "" || s.isVowel(last) || last == 'I' {
+		return word
+	}
+	stem := word[:len(word)-len(string(last))]
+
+	switch {
+	case strings.HasSuffix(stem, "aa"), strings.HasSuffix(stem, "ee"),
+		strings.HasSuffix(stem, "oo"), strings.HasSuffix(stem, "uu"):
+	default:
+		return word
+	}
+
+	before := stem[:len(stem)-2]
+	if before == "" || s.isVowel(lastRune(before)) {
+		return word
+	}
+	return stem[:len(stem)-1] + string(last)
+}
+
+// undouble removes the last letter of a final dd, kk or tt.
+func (s DutchStemmer) undouble(word string) string {
+	if strings.HasSuffix(word, "dd") || strings.HasSuffix(word, "kk") || strings.HasSuffix(word, "tt") {
+		return word[:len(word)-1]
+	}
+	return word
+}
+
+// markVowels upper-cases an initial y, a y after a vowel and an i between
+// vowels, so that they are treated as consonants.
+func (s DutchStemmer) markVowels(word string) string {
+	runes := []rune(word)
+	if len(runes) > 0 && runes[0] == 'y' {
+		runes[0] = 'Y'
+	}
+	for i := 1; i < len(runes); i++ {
+		if !s.isVowel(runes[i-1]) {
+			continue
+		}
+		switch {
+		case runes[i] == 'i' && i+1 < len(runes) && s.isVowel(runes[i+1]):
+			runes[i] = 'I'
+		case runes[i] == 'y':
+			runes[i] = 'Y'
+		}
+	}
+	return string(runes)
+}
+
+// isStopWord returns true if the given word is a stop word.
+func (s DutchStemmer) isStopWord(word string) bool {
+	_, found := nlStopWords[word]
+	return found
+}
+
+func (s DutchStemmer) isVowel(r rune) bool {
+	switch r {
+	case 'a', 'e', 'i', 'o', 'u', 'y', 'è':
+		return true
+	default:
+		return false
+	}
+}
+
+// regions returns the byte offsets of R1 and R2. R1 is adjusted so that at
+// least 3 letters precede it.
+func (s DutchStemmer) regions(word string) (int, int) {
+	r1, r2 := standardRegions(word, s.isVowel)
+	return atLeastLetters(word, r1, 3), r2
+}
@@ -0,0 +1,353 @@
+package stemmer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDutchStemmer(t *testing.T) {
+	s := NewDutchStemmer()
+	require.NotNil(t, s)
+}
+
+func TestDutchStemmer_isStopWord(t *testing.T) {
+	s := NewDutchStemmer()
+	require.True(t, s.isStopWord("hebben"))
+	require.False(t, s.isStopWord("huis"))
+}
+
+func TestDutchStemmer_Stem(t *testing.T) {
+	s := NewDutchStemmer()
+	t.Run("stop word", func(t *testing.T) {
+		require.Equal(t, "hebben", s.Stem("hebben"))
+		require.Equal(t, "hebben", s.Stem("Hebben"))
+	})
+
+	f := func(word, stem string) {
+		t.Helper()
+		require.Equal(t, stem, s.Stem(word))
+	}
+
+	f("lopen", "lop")
+	f("loop", "lop")
+	f("gelopen", "gelop")
+	f("boeken", "boek")
+	f("boek", "boek")
+	f("huisjes", "huisjes")
+	f("huisje", "huisj")
+	f("huis", "huis")
+	f("huizen", "huiz")
+	f("bedden", "bed")
+	f("gegeven", "gegev")
+	f("geven", "gev")
+	f("gewerkt", "gewerkt")
+	f("werken", "werk")
+	f("oplossen", "oploss")
+	f("opgelost", "opgelost")
+	f("boompje", "boompj")
+	f("kettinkje", "kettinkj")
+	f("balletje", "balletj")
+	f("nationaal", "national")
+	f("nationale", "national")
+	f("traditioneel", "traditionel")
+	f("traditionele", "traditionel")
+	f("informatief", "informatief")
+	f("draagbaar", "draagbar")
+	f("draagbare", "draagbar")
+	f("logisch", "logisch")
+	f("logische", "logisch")
+	f("vriendelijk", "vriendelijk")
+	f("vriendelijke", "vriendelijk")
+	f("vriendelijkheid", "vriendelijk")
+	f("mogelijk", "mogelijk")
+	f("mogelijke", "mogelijk")
+	f("mogelijkheden", "mogelijk")
+	f("mogelijkheid", "mogelijk")
+	f("algemene", "algemen")
+	f("algemeen", "algemen")
+	f("leven", "lev")
+	f("levens", "leven")
+	f("rijden", "rijd")
+	f("gereden", "gered")
+	f("kinderen", "kinder")
+	f("kind", "kind")
+	f("koningin", "koningin")
+	f("koninginnen", "koninginn")
+	f("auto's", "auto'")
+	f("actueel", "actueel")
+	f("materieel", "materieel")
+	f("schoonheid", "schoonheid")
+	f("bakkerij", "bakkerij")
+	f("biologie", "biologie")
+	f("fotografie", "fotografie")
+	f("zangeres", "zangeres")
+	f("heerlijke", "heerlijk")
+	f("heerlijk", "heerlijk")
+	f("vrijheid", "vrijheid")
+	f("kopje", "kopj")
+	f("studenten", "student")
+	f("student", "student")
+	f("telefoons", "telefon")
+	f("appels", "appel")
+	f("lichamelijk", "licham")
+	f("gevaarlijk", "gevar")
+	f("ernstig", "ernstig")
+	f("ernstige", "ernstig")
+	f("prachtig", "prachtig")
+	f("prachtige", "prachtig")
+	f("lezen", "lez")
+	f("gelezen", "gelez")
+	f("wonen", "won")
+	f("gewoond", "gewoond")
+	f("maken", "mak")
+	f("gemaakt", "gemaakt")
+	f("maakt", "maakt")
+	f("producten", "product")
+	f("product", "product")
+	f("ideeën", "ideeen")
+	f("knieën", "knieen")
+	f("politieke", "politiek")
+	f("politiek", "politiek")
+	f("yoghurt", "yoghurt")
+	f("fietsen", "fiets")
+	f("fiets", "fiet")
+	f("lachend", "lachend")
+	f("lachende", "lachend")
+	f("zingend", "zingend")
+	f("koning", "koning")
+	f("koningen", "koning")
+	f("beweging", "beweg")
+	f("bewegingen", "beweg")
+	f("regering", "reger")
+	f("regeringen", "reger")
+	f("vergadering", "vergader")
+	f("vergaderingen", "vergader")
+	f("ontwikkeling", "ontwikkel")
+	f("ontwikkelingen", "ontwikkel")
+	f("kleding", "kleding")
+	f("woning", "woning")
+	f("woningen", "woning")
+	f("achtig", "achtig")
+	f("eeuwig", "eeuwig")
+	f("eeuwige", "eeuwig")
+	f("heilig", "heilig")
+	f("heilige", "heilig")
+	f("nodig", "nodig")
+	f("nodige", "nodig")
+	f("ijverig", "ijver")
+	f("ijverige", "ijver")
+	f("zuinig", "zuinig")
+	f("machtig", "machtig")
+	f("machtigheid", "machtig")
+	f("duidelijkheid", "duidelijk")
+	f("eenheid", "eenheid")
+	f("gezondheid", "gezond")
+	f("gelegenheid", "geleg")
+	f("gelegenheden", "geleg")
+	f("waarheid", "waarheid")
+	f("waarheden", "waarheid")
+	f("bijzonderheid", "bijzonder")
+	f("bijzonderheden", "bijzonder")
+	f("zekerheid", "zeker")
+	f("eigenheid", "eig")
+	f("broederlijk", "broeder")
+	f("vaderlijk", "vader")
+	f("moederlijke", "moeder")
+	f("verschrikkelijk", "verschrik")
+	f("ongelooflijk", "ongelof")
+	f("eetbaar", "eetbar")
+	f("eetbare", "eetbar")
+	f("denkbaar", "denkbar")
+	f("haalbaar", "haalbar")
+	f("dankbaar", "dankbar")
+	f("dankbaarheid", "dankbar")
+	f("zichtbaar", "zichtbar")
+	f("zichtbare", "zichtbar")
+	f("vruchtbaar", "vruchtbar")
+	f("wonderbaar", "wonder")
+	f("kostbaar", "kostbar")
+	f("kostbare", "kostbar")
+	f("zangbaar", "zangbar")
+	f("aaien", "aai")
+	f("aaide", "aaid")
+	f("kraaien", "kraai")
+	f("kraaiende", "kraaiend")
+	f("mooie", "mooi")
+	f("mooi", "mooi")
+	f("mooiste", "mooist")
+	f("nieuwe", "nieuw")
+	f("nieuw", "nieuw")
+	f("blauwe", "blauw")
+	f("fluiten", "fluit")
+	f("fluitend", "fluitend")
+	f("brullen", "brull")
+	f("brullende", "brullend")
+	f("bakken", "bak")
+	f("bakker", "bakker")
+	f("bakkers", "bakker")
+	f("bakkerijen", "bakkerij")
+	f("hebbende", "hebbend")
+	f("stoppen", "stopp")
+	f("stoppend", "stoppend")
+	f("zetten", "zet")
+	f("zettende", "zettend")
+	f("kussen", "kuss")
+	f("kussens", "kussen")
+	f("kastje", "kastj")
+	f("kastjes", "kastjes")
+	f("meisje", "meisj")
+	f("meisjes", "meisjes")
+	f("jongetje", "jongetj")
+	f("jongetjes", "jongetjes")
+	f("straatje", "straatj")
+	f("straatjes", "straatjes")
+	f("zeeën", "zeeen")
+	f("zee", "zee")
+	f("zeeen", "zeeen")
+	f("ruïne", "ruin")
+	f("ruïnes", "ruines")
+	f("café", "caf")
+	f("cafés", "cafes")
+	f("reünie", "reunie")
+	f("reünies", "reunies")
+	f("enquête", "enquêt")
+	f("enquêtes", "enquêtes")
+	f("coördinatie", "coordinatie")
+	f("coördineren", "coordiner")
+	f("financiën", "financien")
+	f("financiële", "financiel")
+	f("efficiënt", "efficient")
+	f("efficiënte", "efficient")
+	f("drieën", "drieen")
+	f("tweeën", "tweeen")
+	f("geïnteresseerd", "geinteresseerd")
+	f("geïnteresseerde", "geinteresseerd")
+	f("naïef", "naief")
+	f("naïeve", "naiev")
+	f("yoga", "yoga")
+	f("yoghurtje", "yoghurtj")
+	f("ayurveda", "ayurveda")
+	f("loyaal", "loyal")
+	f("loyale", "loyal")
+	f("loyaliteit", "loyaliteit")
+	f("royaal", "royal")
+	f("royale", "royal")
+	f("baaien", "baai")
+	f("fooien", "fooi")
+	f("maaien", "maai")
+	f("zaaien", "zaai")
+	f("zaaiende", "zaaiend")
+	f("draaien", "draai")
+	f("draaiende", "draaiend")
+	f("gedraaid", "gedraaid")
+	f("beide", "beid")
+	f("beiden", "beid")
+	f("reizen", "reiz")
+	f("reiziger", "reiziger")
+	f("reizigers", "reiziger")
+	f("reisje", "reisj")
+	f("groei", "groei")
+	f("groeien", "groei")
+	f("groeiend", "groeiend")
+	f("groeiende", "groeiend")
+	f("bloei", "bloei")
+	f("bloeien", "bloei")
+	f("bloeiende", "bloeiend")
+	f("moeilijk", "moeilijk")
+	f("moeilijke", "moeilijk")
+	f("moeilijkheden", "moeilijk")
+	f("vrouwen", "vrouw")
+	f("vrouw", "vrouw")
+	f("mannen", "mann")
+	f("man", "man")
+	f("kleine", "klein")
+	f("klein", "klein")
+	f("kleinste", "kleinst")
+	f("groter", "groter")
+	f("grootste", "grootst")
+	f("grote", "grot")
+	f("groot", "grot")
+	f("lange", "lang")
+	f("lang", "lang")
+	f("langer", "langer")
+	f("schrijven", "schrijv")
+	f("schrijver", "schrijver")
+	f("schrijvers", "schrijver")
+	f("schreef", "schref")
+	f("geschreven", "geschrev")
+	f("lezers", "lezer")
+	f("lezer", "lezer")
+	f("verlaten", "verlat")
+	f("verliezen", "verliez")
+	f("verloren", "verlor")
+	f("gemeenschap", "gemeenschap")
+	f("gemeenschappen", "gemeenschapp")
+	f("vriendschap", "vriendschap")
+	f("vriendschappen", "vriendschapp")
+	f("wetenschap", "wetenschap")
+	f("wetenschappelijk", "wetenschapp")
+	f("wetenschappelijke", "wetenschapp")
+	f("wetenschapper", "wetenschapper")
+	f("wetenschappers", "wetenschapper")
+	f("landschap", "landschap")
+	f("landschappen", "landschapp")
+	f("huizenmarkt", "huizenmarkt")
+	f("ziekenhuis", "ziekenhuis")
+	f("ziekenhuizen", "ziekenhuiz")
+	f("gezien", "gezien")
+	f("gebeurd", "gebeurd")
+	f("gebeurtenis", "gebeurtenis")
+	f("gebeurtenissen", "gebeurteniss")
+	f("kenmerken", "kenmerk")
+	f("kenmerkend", "kenmerk")
+	f("kenmerkende", "kenmerk")
+	f("vaak", "vak")
+	f("steden", "sted")
+	f("stad", "stad")
+	f("gemeente", "gemeent")
+	f("gemeenten", "gemeent")
+	f("gemeentes", "gemeentes")
+	f("provincie", "provincie")
+	f("provincies", "provincies")
+	f("universiteit", "universiteit")
+	f("universiteiten", "universiteit")
+	f("activiteit", "activiteit")
+	f("activiteiten", "activiteit")
+	f("kwaliteit", "kwaliteit")
+	f("kwaliteiten", "kwaliteit")
+	f("realiteit", "realiteit")
+	f("nationaliteit", "nationaliteit")
+}
+
+func TestDutchStemmer_markVowels(t *testing.T) {
+	s := NewDutchStemmer()
+
+	f := func(word, marked string) {
+		t.Helper()
+		require.Equal(t, marked, s.markVowels(word))
+	}
+
+	f("yoga", "Yoga")
+	f("loyaal", "loYaal")
+	f("mooie", "mooIe")
+	f("kraaien", "kraaIen")
+	f("bakkerij", "bakkerij")
+	f("", "")
+}
+
+func TestDutchStemmer_regions(t *testing.T) {
+	s := NewDutchStemmer()
+
+	f := func(word, r1, r2 string) {
+		t.Helper()
+		r1Start, r2Start := s.regions(word)
+		require.Equal(t, r1, word[r1Start:])
+		require.Equal(t, r2, word[r2Start:])
+	}
+
+	f("lichamelijk", "hamelijk", "elijk")
+	f("opgelost", "elost", "ost")
+	f("een", "", "")
+	f("at", "", "")
+}
@@ -246,12 +246,21 @@ var (
 	}
 )
 
-type EnglishStemmer struct{}
+type EnglishStemmer struct {
+	level Level
+}
 
 func NewEnglishStemmer() *EnglishStemmer {
 	return &EnglishStemmer{}
 }
 
+// NewEnglishStemmerWithLevel creates an EnglishStemmer with the given level.
+// LevelLight only removes plural and possessive endings, and keeps the
+// spelling of the singular.
+func NewEnglishStemmerWithLevel(level Level) *EnglishStemmer {
+	return &EnglishStemmer{level: level}
+}
+
 func (s *EnglishStemmer) Stem(word string) string {
 	word = strings.ToLower(word)
 	if s.isStopWord(word) || len(word) < 3 {
@@ -290,6 +299,9 @@ func (s *EnglishStemmer) Stem(word string) string {
 	}
 
 	word, r1, r2 = s.step0(word, r1, r2)
+	if s.level == LevelLight {
+		return strings.ReplaceAll(s.plural(word), "Y", "y")
+	}
 	word, r1, r2 = s.step1a(word, r1, r2)
 	word, r1, r2 = s.step1b(word, r1, r2)
 	word, r1, r2 = s.step1c(word, r1, r2)
@@ -395,6 +407,33 @@ func (s EnglishStemmer) step1a(word, r1, r2 string) (string, string, string) {
 	return word, r1, r2
 }
 
+// plural removes the plural ending for the light level. Unlike step 1a it
+// keeps the singular spelling: -ies becomes -y, and -es goes after x, ch, sh,
+// ss and zz, so that boxes and churches meet box and church.
+func (s EnglishStemmer) plural(word string) string {
+	switch {
+	case strings.HasSuffix(word, "ies"):
+		if len(word) > 4 {
+			return word[:len(word)-3] + "y"
+		}
+		return word[:len(word)-1]
+	case strings.HasSuffix(word, "us"), strings.HasSuffix(word, "ss"):
+		return word
+	case strings.HasSuffix(word, "es"):
+		stem := word[:len(word)-2]
+		for _, ending := range []string{"x", "ch", "sh", "ss", "zz"} {
+			if strings.HasSuffix(stem, ending) {
+				return stem
+			}
+		}
+	}
+
+	if strings.HasSuffix(word, "s") && len(word) > 2 && strings.ContainsFunc(word[:len(word)-2], s.isVowel) {
+		return word[:len(word)-1]
+	}
+	return word
+}
+
 func (s EnglishStemmer) step1b(word, r1, r2 string) (string, string, string) {
 	step1bVowelFound := false
 
@@ -345,6 +345,62 @@ func TestEnglishStemmer_Stem(t *testing.T) {
 	f("wagon", "wagon")
 }
 
+func TestEnglishStemmer_Stem_light(t *testing.T) {
+	s := NewEnglishStemmerWithLevel(LevelLight)
+	t.Run("stop word", func(t *testing.T) {
+		require.Equal(t, "the", s.Stem("the"))
+	})
+
+	f := func(word, stem string) {
+		t.Helper()
+		require.Equal(t, stem, s.Stem(word))
+	}
+
+	f("general", "general")
+	f("generals", "general")
+	f("generate", "generate")
+	f("university", "university")
+	f("universities", "university")
+	f("universe", "universe")
+	f("universes", "universe")
+	f("ponies", "pony")
+	f("pony", "pony")
+	f("ties", "tie")
+	f("tie", "tie")
+	f("toys", "toy")
+	f("boy's", "boy")
+	f("boys'", "boy")
+	f("dresses", "dress")
+	f("Cats", "cat")
+	f("gas", "gas")
+	f("bus", "bus")
+	f("news", "news")
+	f("skies", "sky")
+	f("sky", "sky")
+	f("running", "running")
+	f("happiness", "happiness")
+	f("happy", "happy")
+
+	t.Run("plural paradigms", func(t *testing.T) {
+		for _, pair := range [][2]string{
+			{"box", "boxes"},
+			{"church", "churches"},
+			{"wish", "wishes"},
+			{"match", "matches"},
+			{"tax", "taxes"},
+			{"dress", "dresses"},
+			{"buzz", "buzzes"},
+			{"horse", "horses"},
+			{"size", "sizes"},
+			{"city", "cities"},
+			{"day", "days"},
+			{"play", "plays"},
+		} {
+			require.Equal(t, s.Stem(pair[0]), s.Stem(pair[1]), pair[1])
+		}
+	})
+}
+
 func BenchmarkEnglishStemmer_Stem(b *testing.B) {
 	stemmer := &EnglishStemmer{}
 
@@ -0,0 +1,459 @@
+package stemmer
+
+import "strings"
+
+var (
+	etStopWords = map[string]struct{}{
+		"aga":   {},
+		"ei":    {},
+		"et":    {},
+		"ja":    {},
+		"juba":  {},
+		"ka":    {},
+		"kas":   {},
+		"kes":   {},
+		"kui":   {},
+		"kuid":  {},
+		"kõik":  {},
+		"ma":    {},
+		"me":    {},
+		"meie":  {},
+		"mida":  {},
+		"mina":  {},
+		"mis":   {},
+		"mitte": {},
+		"nad":   {},
+		"need":  {},
+		"nemad": {},
+		"nii":   {},
+		"ning":  {},
+		"oled":  {},
+		"olema": {},
+		"oleme": {},
+		"olen":  {},
+		"olete": {},
+		"oli":   {},
+		"olid":  {},
+		"oma":   {},
+		"on":    {},
+		"pole":  {},
+		"sa":    {},
+		"see":   {},
+		"seda":  {},
+		"selle": {},
+		"siin":  {},
+		"siis":  {},
+		"sina":  {},
+		"ta":    {},
+		"te":    {},
+		"teie":  {},
+		"tema":  {},
+		"veel":  {},
+		"või":   {},
+		"väga":  {},
+		"üle":   {},
+	}
+
+	// etVerbExceptions maps the forms of irregular verbs to their stem.
+	etVerbExceptions = map[string]string{
+		"joon":      "joo",
+		"jood":      "joo",
+		"joob":      "joo",
+		"joome":     "joo",
+		"joote":     "joo",
+		"joovad":    "joo",
+		"jõin":      "joo",
+		"jõid":      "joo",
+		"jõi":       "joo",
+		"jõime":     "joo",
+		"jõite":     "joo",
+		"juua":      "joo",
+		"juuakse":   "joo",
+		"joodi":     "joo",
+		"joodud":    "joo",
+		"jooksen":   "jooks",
+		"jooksed":   "jooks",
+		"jookseb":   "jooks",
+		"jookseme":  "jooks",
+		"jooksete":  "jooks",
+		"jooksevad": "jooks",
+		"jooksin":   "jooks",
+		"jooksis":   "jooks",
+		"jooksid":   "jooks",
+		"jooksime":  "jooks",
+		"jooksite":  "jooks",
+		"jooksma":   "jooks",
+		"joosta":    "jooks",
+		"joostakse": "jooks",
+		"joosti":    "jooks",
+		"joostud":   "jooks",
+		"loon":      "loo",
+		"lood":      "loo",
+		"loob":      "loo",
+		"loome":     "loo",
+		"loote":     "loo",
+		"loovad":    "loo",
+		"lõin":      "loo",
+		"lõid":      "loo",
+		"lõi":       "loo",
+		"lõime":     "loo",
+		"lõite":     "loo",
+		"luua":      "loo",
+		"luuakse":   "loo",
+		"loodi":     "loo",
+		"loodud":    "loo",
+		"lähen":     "mine",
+		"lähed":     "mine",
+		"läheb":     "mine",
+		"läheme":    "mine",
+		"lähete":    "mine",
+		"lähevad":   "mine",
+		"läksin":    "mine",
+		"läksid":    "mine",
+		"läks":      "mine",
+		"läksime":   "mine",
+		"läksite":   "mine",
+		"minna":     "mine",
+		"minnakse":  "mine",
+		"mindi":     "mine",
+		"näen":      "näge",
+		"näed":      "näge",
+		"näeb":      "näge",
+		"näeme":     "näge",
+		"näete":     "näge",
+		"näevad":    "näge",
+		"nägin":     "näge",
+		"nägid":     "näge",
+		"nägi":      "näge",
+		"nägime":    "näge",
+		"nägite":    "näge",
+		"näha":      "näge",
+		"nähakse":   "näge",
+		"nähti":     "näge",
+		"nähtud":    "näge",
+		"olen":      "ole",
+		"oled":      "ole",
+		"oleme":     "ole",
+		"olete":     "ole",
+		"olin":      "ole",
+		"olid":      "ole",
+		"oli":       "ole",
+		"olime":     "ole",
+		"olite":     "ole",
+		"olla":      "ole",
+		"ollakse":   "ole",
+		"oldi":      "ole",
+		"oldud":     "ole",
+		"panen":     "pane",
+		"paned":     "pane",
+		"paneb":     "pane",
+		"paneme":    "pane",
+		"panete":    "pane",
+		"panevad":   "pane",
+		"panin":     "pane",
+		"panid":     "pane",
+		"pani":      "pane",
+		"panime":    "pane",
+		"panite":    "pane",
+		"panna":     "pane",
+		"pannakse":  "pane",
+		"pandi":     "pane",
+		"pandud":    "pane",
+		"saan":      "saa",
+		"saad":      "saa",
+		"saab":      "saa",
+		"saame":     "saa",
+		"saate":     "saa",
+		"saavad":    "saa",
+		"sain":      "saa",
+		"said":      "saa",
+		"sai":       "saa",
+		"saime":     "saa",
+		"saite":     "saa",
+		"saada":     "saa",
+		"saadakse":  "saa",
+		"saadi":     "saa",
+		"saadud":    "saa",
+		"söön":      "söö",
+		"sööd":      "söö",
+		"sööb":      "söö",
+		"sööme":     "söö",
+		"söövad":    "söö",
+		"sõin":      "söö",
+		"sõid":      "söö",
+		"sõi":       "söö",
+		"sõime":     "söö",
+		"sõite":     "söö",
+		"süüa":      "söö",
+		"süüakse":   "söö",
+		"söödi":     "söö",
+		"söödud":    "söö",
+		"teen":      "tege",
+		"teed":      "tege",
+		"teeb":      "tege",
+		"teeme":     "tege",
+		"teete":     "tege",
+		"teevad":    "tege",
+		"tegin":     "tege",
+		"tegid":     "tege",
+		"tegi":      "tege",
+		"tegime":    "tege",
+		"tegite":    "tege",
+		"teha":      "tege",
+		"tehakse":   "tege",
+		"tehti":     "tege",
+		"tehtud":    "tege",
+		"toon":      "too",
+		"tood":      "too",
+		"toob":      "too",
+		"toome":     "too",
+		"toote":     "too",
+		"toovad":    "too",
+		"tõin":      "too",
+		"tõid":      "too",
+		"tõi":       "too",
+		"tõime":     "too",
+		"tõite":     "too",
+		"tuua":      "too",
+		"tuuakse":   "too",
+		"toodi":     "too",
+		"toodud":    "too",
+		"tulen":     "tule",
+		"tuled":     "tule",
+		"tulin":     "tule",
+		"tulid":     "tule",
+		"tuli":      "tule",
+		"tulime":    "tule",
+		"tulite":    "tule",
+		"tulla":     "tule",
+		"tullakse":  "tule",
+		"tuldi":     "tule",
+		"tulnud":    "tule",
+	}
+
+	// etVerbSuffixes are the verb endings, longest first. Those in
+	// etVerbSuffixesAfterVowel must follow a vowel; the participle and
+	// conditional endings may also follow a consonant, as in jooksnud. The
+	// passive participle -tud is left alone, as it also ends plurals such as
+	// raamatud.
+	etVerbSuffixes = []string{
+		"nuksime", "nuksite", "nuksid", "nuksin", "ksime", "ksite", "takse",
+		"dakse", "ksid", "ksin", "mast", "mata", "maks", "nuks", "sime", "site",
+		"nud", "sid", "sin", "vad", "da", "ma", "me", "te", "b",
+	}
+	etVerbSuffixesAfterVowel = map[string]struct{}{
+		"ksime": {}, "ksite": {}, "takse": {}, "dakse": {}, "ksid": {},
+		"ksin": {}, "mast": {}, "mata": {}, "maks": {}, "sime": {}, "site": {},
+		"sid": {}, "sin": {}, "vad": {}, "da": {}, "ma": {}, "me": {}, "te": {},
+		"b": {},
+	}
+
+	// etSpecialNounEndings map the inflected stems of the -lane, -line and
+	// -mine derivations back to the nominative.
+	etSpecialNounEndings = map[string]string{
+		"lasse": "lane", "laste": "lane", "lase": "lane", "lasi": "lane",
+		"last":  "lane",
+		"lisse": "line", "liste": "line", "lise": "line", "lisi": "line",
+		"list":  "line",
+		"misse": "mine", "miste": "mine", "mise": "mine", "misi": "mine",
+		"mist": "mine",
+	}
+	etSpecialNounSuffixes = []string{
+		"lasse", "laste", "lisse", "liste", "misse", "miste", "lase", "lasi",
+		"last", "lise", "lisi", "list", "mise", "misi", "mist",
+	}
+
+	// etCaseSuffixes are the case endings, longest first. They follow a
+	// short a, e, i, o or u or a long vowel; t, the partitive, only follows
+	// u or a long vowel.
+	etCaseSuffixes = []string{
+		"sse", "ga", "ks", "le", "lt", "na", "ni", "st", "ta", "l", "s", "t",
+	}
+
+	// etPluralSuffixes are the endings of the plural nominative, genitive
+	// and partitive. The partitive -id loses its d here and its i in
+	// iPlural.
+	etPluralSuffixes = []string{"de", "te", "d"}
+
+	// etDegreeSuffixes are what is left of the comparative -em and the
+	// superlative -im once the other endings are gone: kiirem and kiiremini
+	// become kiire, suurimaid becomes suuri.
+	etDegreeSuffixes = []string{"mai", "ma", "mi", "m"}
+)
+
+type EstonianStemmer struct{}
+
+// NewEstonianStemmer creates a new EstonianStemmer.
+func NewEstonianStemmer() *EstonianStemmer {
+	return &EstonianStemmer{}
+}
+
+// Stem returns the stem of the given word, following the Snowball Estonian
+// algorithm. The forms of irregular verbs are looked up; other words lose
+// the -gi or -ki clitic and then either a verb ending or their noun endings,
+// one kind after another. All endings are removed inside R1 only.
+func (s EstonianStemmer) Stem(word string) string {
+	word = strings.ToLower(word)
+	if s.isStopWord(word) {
+		return word
+	}
+	if stem, ok := etVerbExceptions[word]; ok {
+		return stem
+	}
+
+	r1 := regionAfterVowelConsonant(word, 0, s.isVowel)
+
+	word = s.emphasis(word, r1)
+	if stem, ok := s.verb(word, r1); ok {
+		word = stem
+	} else {
+		word = s.substantive(word, r1)
+	}
+	return s.undoubleKPT(word, r1)
+}
+
+// emphasis removes the clitic -gi after a vowel or a voiced consonant, or
+// -ki after a voiceless one.
+func (s EstonianStemmer) emphasis(word string, r1 int) string {
+	suffix := longestSuffix(region(word, r1), []string{"gi", "ki"})
+	if suffix == "" {
+		return word
+	}
+	stem := word[:len(word)-len(suffix)]
+
+	voiceless := strings.ContainsRune("kptgbdshfšž", lastRune(stem))
+	if voiceless != (suffix == "ki") {
+		return word
+	}
+	return stem
+}
+
+// verb removes a verb ending and reports whether it found one.
+func (s EstonianStemmer) verb(word string, r1 int) (string, bool) {
+	suffix := longestSuffix(region(word, r1), etVerbSuffixes)
+	if suffix == "" {
+		return word, false
+	}
+	stem := word[:len(word)-len(suffix)]
+	if _, ok := etVerbSuffixesAfterVowel[suffix]; ok && !s.isVowel(lastRune(stem)) {
+		return word, false
+	}
+	return stem, true
+}
+
+// substantive removes the endings of nouns and adjectives: case endings
+// first, then plural markers, the stems of -lane, -line and -mine, degrees of
+// comparison, the plural i and the participle -nu.
+func (s EstonianStemmer) substantive(word string, r1 int) string {
+	word = s.caseEnding(word, r1)
+	word = s.pluralEnding(word, r1)
+	word = s.specialNounEnding(word, r1)
+	word = s.degree(word, r1)
+	word = s.iPlural(word, r1)
+	return s.nu(word, r1)
+}
+
+// specialNounEnding turns the stems of eestlase, inimesi and the like back
+// into the nominative ending.
+func (s EstonianStemmer) specialNounEnding(word string, r1 int) string {
+	suffix := longestSuffix(region(word, r1), etSpecialNounSuffixes)
+	if suffix == "" {
+		return word
+	}
+	return word[:len(word)-len(suffix)] + etSpecialNounEndings[suffix]
+}
+
+// caseEnding removes a case ending that follows a stem vowel.
+func (s EstonianStemmer) caseEnding(word string, r1 int) string {
+	suffix := longestSuffix(region(word, r1), etCaseSuffixes)
+	if suffix == "" {
+		return word
+	}
+	stem := word[:len(word)-len(suffix)]
+
+	switch {
+	case s.isLongVowel(stem):
+	case suffix == "t" && strings.HasSuffix(stem, "u"):
+	case suffix != "t" && strings.ContainsRune("aeiou", lastRune(stem)):
+	default:
+		return word
+	}
+	return stem
+}
+
+// pluralEnding removes the plural -de, -te or -d after a vowel.
+func (s EstonianStemmer) pluralEnding(word string, r1 int) string {
+	return s.trimAfter(word, r1, etPluralSuffixes, s.isVowel)
+}
+
+// degree removes the comparative and superlative markers after e or i.
+func (s EstonianStemmer) degree(word string, r1 int) string {
+	return s.trimAfter(word, r1, etDegreeSuffixes, func(r rune) bool {
+		return r == 'e' || r == 'i'
+	})
+}
+
+// iPlural removes the plural i after a short a, e, i, o or u, as in
+// raamatui from raamatuid.
+func (s EstonianStemmer) iPlural(word string, r1 int) string {
+	return s.trimAfter(word, r1, []string{"i"}, func(r rune) bool {
+		return strings.ContainsRune("aeiou", r)
+	})
+}
+
+// nu removes the -nu of an active past participle left without its case
+// ending, as in tulnuga. It must follow a consonant other than n, which
+// keeps plurals such as linnu.
+func (s EstonianStemmer) nu(word string, r1 int) string {
+	return s.trimAfter(word, r1, []string{"nu"}, func(r rune) bool {
+		return r != 'n' && !s.isVowel(r)
+	})
+}
+
+// undoubleKPT shortens a final kk, pp or tt inside R1 that follows a
+// vowel, as the strong grade of a stem doubles them.
+func (s EstonianStemmer) undoubleKPT(word string, r1 int) string {
+	for _, double := range []string{"kk", "pp", "tt"} {
+		if !strings.HasSuffix(region(word, r1), double) {
+			continue
+		}
+		if s.isVowel(lastRune(word[:len(word)-len(double)])) {
+			return word[:len(word)-1]
+		}
+	}
+	return word
+}
+
+// trimAfter removes the longest of suffixes that lies inside R1 when the
+// letter in front of it satisfies before.
+func (s EstonianStemmer) trimAfter(word string, r1 int, suffixes []string, before func(rune) bool) string {
+	suffix := longestSuffix(region(word, r1), suffixes)
+	if suffix == "" {
+		return word
+	}
+	if stem := word[:len(word)-len(suffix)]; before(lastRune(stem)) {
+		return stem
+	}
+	return word
+}
+
+// isLongVowel reports whether word ends with a doubled vowel.
+func (s EstonianStemmer) isLongVowel(word string) bool {
+	last := lastRune(word)
+	return s.isVowel(last) && lastRune(strings.TrimSuffix(word, string(last))) == last
+}
+
+// isStopWord returns true if the given word is a stop word.
+func (s EstonianStemmer) isStopWord(word string) bool {
+	_, found := etStopWords[word]
+	return found
+}
+
+func (s EstonianStemmer) isVowel(r rune) bool {
+	switch r {
+	case 'a', 'e', 'i', 'o', 'u', 'õ', 'ä', 'ö', 'ü':
+		return true
+	default:
+		return false
+	}
+}
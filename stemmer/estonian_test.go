@@ -0,0 +1,119 @@
+package stemmer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEstonianStemmer(t *testing.T) {
+	s := NewEstonianStemmer()
+	require.NotNil(t, s)
+}
+
+func TestEstonianStemmer_isStopWord(t *testing.T) {
+	s := NewEstonianStemmer()
+	require.True(t, s.isStopWord("ja"))
+	require.False(t, s.isStopWord("linn"))
+}
+
+func TestEstonianStemmer_Stem(t *testing.T) {
+	s := NewEstonianStemmer()
+	t.Run("stop word", func(t *testing.T) {
+		require.Equal(t, "ja", s.Stem("ja"))
+		require.Equal(t, "või", s.Stem("VÕI"))
+	})
+
+	f := func(word, stem string) {
+		t.Helper()
+		require.Equal(t, stem, s.Stem(word))
+	}
+
+	f("linn", "linn")
+	f("linna", "linna")
+	f("linnas", "linna")
+	f("linnast", "linna")
+	f("linnasse", "linna")
+	f("linnale", "linna")
+	f("linnalt", "linna")
+	f("linnaks", "linna")
+	f("linnani", "linna")
+	f("linnata", "linna")
+	f("linnaga", "linna")
+	f("linnad", "linna")
+	f("linnade", "linna")
+	f("linnadesse", "linna")
+	f("linnasid", "linna")
+	f("raamat", "raamat")
+	f("raamatu", "raamatu")
+	f("raamatut", "raamatu")
+	f("raamatus", "raamatu")
+	f("raamatud", "raamatu")
+	f("raamatuid", "raamatu")
+	f("raamatutes", "raamatu")
+	f("maja", "maja")
+	f("majas", "maja")
+	f("majad", "maja")
+	f("majadest", "maja")
+	f("kass", "kass")
+	f("kassi", "kassi")
+	f("kassid", "kassi")
+	f("tulema", "tule")
+	f("tuleb", "tule")
+	f("tuleme", "tule")
+	f("tulevad", "tule")
+	f("tuleksime", "tule")
+	f("tulen", "tule")
+	f("tulid", "tule")
+	f("lugema", "luge")
+	f("lugesid", "luge")
+	f("kirjutama", "kirjuta")
+	f("kirjutab", "kirjuta")
+	f("kirjutada", "kirjuta")
+	f("kirjutatakse", "kirjuta")
+	f("jooksma", "jooks")
+	f("jooksin", "jooks")
+	f("jooksnud", "jooks")
+	f("näen", "näge")
+	f("nägi", "näge")
+	f("läks", "mine")
+	f("sõber", "sõber")
+	f("sõbra", "sõbra")
+	f("sõbraga", "sõbra")
+	f("sõbrad", "sõbra")
+	f("tänavgi", "tänav")
+	f("eestlane", "eestlane")
+	f("eestlased", "eestlane")
+	f("eestlasega", "eestlane")
+	f("eestlasi", "eestlane")
+	f("suurem", "suure")
+	f("suurema", "suure")
+	f("suuremad", "suure")
+	f("suurim", "suuri")
+	f("suurima", "suuri")
+	f("suurimaid", "suuri")
+	f("kiire", "kiire")
+	f("kiirem", "kiire")
+	f("kiiremini", "kiire")
+	f("kõrgemast", "kõrge")
+	f("linnu", "linnu")
+	f("kapp", "kapp")
+	f("Eesti", "eesti")
+}
+
+func TestEstonianStemmer_caseEnding(t *testing.T) {
+	s := NewEstonianStemmer()
+
+	f := func(word, expected string) {
+		t.Helper()
+		r1 := regionAfterVowelConsonant(word, 0, s.isVowel)
+		require.Equal(t, expected, s.caseEnding(word, r1))
+	}
+
+	f("linnasse", "linna")
+	f("raamatus", "raamatu")
+	f("raamatut", "raamatu")
+	f("raamat", "raamat")
+	f("ülikoolis", "ülikooli")
+	f("sõber", "sõber")
+}
@@ -0,0 +1,476 @@
+package stemmer
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+var (
+	fiStopWords = map[string]struct{}{
+		"olla":     {},
+		"olen":     {},
+		"olet":     {},
+		"on":       {},
+		"olemme":   {},
+		"olette":   {},
+		"ovat":     {},
+		"ole":      {},
+		"oli":      {},
+		"olisi":    {},
+		"olisit":   {},
+		"olisin":   {},
+		"olisimme": {},
+		"olisitte": {},
+		"olisivat": {},
+		"olit":     {},
+		"olin":     {},
+		"olimme":   {},
+		"olitte":   {},
+		"olivat":   {},
+		"ollut":    {},
+		"olleet":   {},
+		"en":       {},
+		"et":       {},
+		"ei":       {},
+		"emme":     {},
+		"ette":     {},
+		"eivät":    {},
+		"minä":     {},
+		"minun":    {},
+		"minut":    {},
+		"minua":    {},
+		"minussa":  {},
+		"minusta":  {},
+		"minuun":   {},
+		"minulla":  {},
+		"minulta":  {},
+		"minulle":  {},
+		"sinä":     {},
+		"sinun":    {},
+		"sinut":    {},
+		"sinua":    {},
+		"sinussa":  {},
+		"sinusta":  {},
+		"sinuun":   {},
+		"sinulla":  {},
+		"sinulta":  {},
+		"sinulle":  {},
+		"hän":      {},
+		"hänen":    {},
+		"hänet":    {},
+		"häntä":    {},
+		"hänessä":  {},
+		"hänestä":  {},
+		"häneen":   {},
+		"hänellä":  {},
+		"häneltä":  {},
+		"hänelle":  {},
+		"me":       {},
+		"meidän":   {},
+		"meidät":   {},
+		"meitä":    {},
+		"meissä":   {},
+		"meistä":   {},
+		"meihin":   {},
+		"meillä":   {},
+		"meiltä":   {},
+		"meille":   {},
+		"te":       {},
+		"teidän":   {},
+		"teidät":   {},
+		"teitä":    {},
+		"teissä":   {},
+		"teistä":   {},
+		"teihin":   {},
+		"teillä":   {},
+		"teiltä":   {},
+		"teille":   {},
+		"he":       {},
+		"heidän":   {},
+		"heidät":   {},
+		"heitä":    {},
+		"heissä":   {},
+		"heistä":   {},
+		"heihin":   {},
+		"heillä":   {},
+		"heiltä":   {},
+		"heille":   {},
+		"tämä":     {},
+		"tämän":    {},
+		"tätä":     {},
+		"tässä":    {},
+		"tästä":    {},
+		"tähän":    {},
+		"tällä":    {},
+		"tältä":    {},
+		"tälle":    {},
+		"tänä":     {},
+		"täksi":    {},
+		"tuo":      {},
+		"tuon":     {},
+		"tuota":    {},
+		"tuossa":   {},
+		"tuosta":   {},
+		"tuohon":   {},
+		"tuolla":   {},
+		"tuolta":   {},
+		"tuolle":   {},
+		"se":       {},
+		"sen":      {},
+		"sitä":     {},
+		"siinä":    {},
+		"siitä":    {},
+		"siihen":   {},
+		"sillä":    {},
+		"siltä":    {},
+		"sille":    {},
+		"siksi":    {},
+		"nämä":     {},
+		"näiden":   {},
+		"näitä":    {},
+		"näissä":   {},
+		"näistä":   {},
+		"näihin":   {},
+		"näillä":   {},
+		"näiltä":   {},
+		"näille":   {},
+		"nuo":      {},
+		"noiden":   {},
+		"noita":    {},
+		"noissa":   {},
+		"noista":   {},
+		"noihin":   {},
+		"noilla":   {},
+		"noilta":   {},
+		"noille":   {},
+		"ne":       {},
+		"niiden":   {},
+		"niitä":    {},
+		"niissä":   {},
+		"niistä":   {},
+		"niihin":   {},
+		"niillä":   {},
+		"niiltä":   {},
+		"niille":   {},
+		"kuka":     {},
+		"kenen":    {},
+		"kenet":    {},
+		"ketä":     {},
+		"kenessä":  {},
+		"kenestä":  {},
+		"keneen":   {},
+		"kenellä":  {},
+		"keneltä":  {},
+		"kenelle":  {},
+		"mikä":     {},
+		"minkä":    {},
+		"mitä":     {},
+		"missä":    {},
+		"mistä":    {},
+		"mihin":    {},
+		"millä":    {},
+		"miltä":    {},
+		"mille":    {},
+		"joka":     {},
+		"jonka":    {},
+		"jota":     {},
+		"jossa":    {},
+		"josta":    {},
+		"johon":    {},
+		"jolla":    {},
+		"jolta":    {},
+		"jolle":    {},
+		"jotka":    {},
+		"joiden":   {},
+		"joita":    {},
+		"joissa":   {},
+		"joista":   {},
+		"joihin":   {},
+		"joilla":   {},
+		"joilta":   {},
+		"joille":   {},
+		"että":     {},
+		"ja":       {},
+		"jos":      {},
+		"koska":    {},
+		"kuin":     {},
+		"mutta":    {},
+		"niin":     {},
+		"sekä":     {},
+		"tai":      {},
+		"vaan":     {},
+		"vai":      {},
+		"vaikka":   {},
+		"kanssa":   {},
+		"mukaan":   {},
+		"noin":     {},
+		"poikki":   {},
+		"yli":      {},
+		"kun":      {},
+		"nyt":      {},
+		"itse":     {},
+	}
+
+	fiParticles   = []string{"kaan", "kään", "han", "hän", "kin", "sti", "ko", "kö", "pa", "pä"}
+	fiPossessives = []string{"mme", "nne", "nsa", "nsä", "an", "en", "ni", "si", "än"}
+
+	fiCaseEndings = []string{
+		"seen", "siin", "tten", "den", "han", "hen", "hin", "hon", "hän", "hön",
+		"ine", "ksi", "lla", "lle", "llä", "lta", "ltä", "ssa", "ssä", "sta", "stä",
+		"tta", "ttä", "na", "nä", "ta", "tä", "a", "n", "ä",
+	}
+
+	fiOtherEndings = []string{
+		"imma", "immi", "immä", "impa", "impi", "impä", "eja", "ejä", "mma", "mmi",
+		"mmä", "mpa", "mpi", "mpä",
+	}
+
+	fiLongVowels = []string{"aa", "ee", "ii", "oo", "uu", "ää", "öö"}
+
+	// fiParticleEndings are the letters a particle such as kin or han may
+	// follow.
+	fiParticleEndings = "aeinotuyäö"
+)
+
+type FinnishStemmer struct{}
+
+// NewFinnishStemmer creates a new FinnishStemmer.
+func NewFinnishStemmer() *FinnishStemmer {
+	return &FinnishStemmer{}
+}
+
+// Stem returns the stem of the given word.
+func (s FinnishStemmer) Stem(word string) string {
+	word = strings.ToLower(word)
+	if s.isStopWord(word) {
+		return word
+	}
+
+	r1, r2 := s.regions(word)
+
+	word = s.particle(word, r1, r2)
+	word = s.possessive(word, r1)
+
+	var removed bool
+	word, removed = s.caseEnding(word, r1)
+	word = s.otherEnding(word, r2)
+
+	if removed {
+		word = s.iPlural(word, r1)
+	} else {
+		word = s.tPlural(word, r1, r2)
+	}
+
+	return s.tidy(word, r1)
+}
+
+// particle removes a particle such as kin, kaan or han, and the adverbial
+// sti in R2.
+func (s FinnishStemmer) particle(word string, r1, r2 int) string {
+	suffix := longestSuffix(region(word, r1), fiParticles)
+	if suffix == "" {
+		return word
+	}
+	stem := word[:len(word)-len(suffix)]
+
+	if suffix == "sti" {
+		if !inRegion(word, suffix, r2) {
+			return word
+		}
+	} else if !strings.ContainsRune(fiParticleEndings, lastRune(stem)) {
+		return word
+	}
+	return stem
+}
+
+func (s FinnishStemmer) possessive(word string, r1 int) string {
+	suffix := longestSuffix(region(word, r1), fiPossessives)
+	if suffix == "" {
+		return word
+	}
+	stem := word[:len(word)-len(suffix)]
+
+	switch suffix {
+	case "si":
+		if strings.HasSuffix(stem, "k") {
+			return word
+		}
+	case "ni":
+		if strings.HasSuffix(stem, "kse") {
+			stem = stem[:len(stem)-1] + "i"
+		}
+	case "an":
+		if longestSuffix(stem, []string{"lla", "lta", "ssa", "sta", "na", "ta"}) == "" {
+			return word
+		}
+	case "än":
+		if longestSuffix(stem, []string{"llä", "ltä", "ssä", "stä", "nä", "tä"}) == "" {
+			return word
+		}
+	case "en":
+		if longestSuffix(stem, []string{"lle", "ine"}) == "" {
+			return word
+		}
+	}
+	return stem
+}
+
+// caseEnding removes a case ending in R1 and reports whether it did.
+func (s FinnishStemmer) caseEnding(word string, r1 int) (string, bool) {
+	suffix := longestSuffix(region(word, r1), fiCaseEndings)
+	if suffix == "" {
+		return word, false
+	}
+	stem := word[:len(word)-len(suffix)]
+
+	// These endings only count under their condition; otherwise the final n
+	// is handled as the genitive ending.
+	switch suffix {
+	case "siin", "den", "tten":
+		if !s.endsWithVI(stem) {
+			suffix, stem = "n", word[:len(word)-1]
+		}
+	case "seen":
+		if longestSuffix(stem, fiLongVowels) == "" {
+			suffix, stem = "n", word[:len(word)-1]
+		}
+	}
+
+	switch suffix {
+	case "han", "hen", "hin", "hon", "hän", "hön":
+		// The illative repeats the vowel before it.
+		vowel := suffix[1 : len(suffix)-1]
+		if !strings.HasSuffix(stem, vowel) {
+			return word, false
+		}
+	case "n":
+		if longestSuffix(stem, fiLongVowels) != "" || strings.HasSuffix(stem, "ie") {
+			stem = stem[:len(stem)-len(string(lastRune(stem)))]
+		}
+	case "a", "ä":
+		last, size := utf8.DecodeLastRuneInString(stem)
+		if !s.isVowel(last) || stem[:len(stem)-size] == "" || s.isVowel(lastRune(stem[:len(stem)-size])) {
+			return word, false
+		}
+	case "tta", "ttä":
+		if !strings.HasSuffix(stem, "e") {
+			return word, false
+		}
+	}
+	return stem, true
+}
+
+func (s FinnishStemmer) otherEnding(word string, r2 int) string {
+	suffix := longestSuffix(region(word, r2), fiOtherEndings)
+	if suffix == "" {
+		return word
+	}
+	stem := word[:len(word)-len(suffix)]
+
+	switch suffix {
+	case "mpi", "mpa", "mpä", "mmi", "mma", "mmä":
+		if strings.HasSuffix(stem, "po") {
+			return word
+		}
+	}
+	return stem
+}
+
+// iPlural removes the plural i or j in R1.
+func (s FinnishStemmer) iPlural(word string, r1 int) string {
+	switch lastRune(region(word, r1)) {
+	case 'i', 'j':
+		return word[:len(word)-1]
+	}
+	return word
+}
+
+// tPlural removes the plural t after a vowel in R1, then a comparative mma
+// in R2.
+func (s FinnishStemmer) tPlural(word string, r1, r2 int) string {
+	rv := region(word, r1)
+	if !strings.HasSuffix(rv, "t") || !s.isVowel(lastRune(rv[:len(rv)-1])) {
+		return word
+	}
+	word = word[:len(word)-1]
+
+	suffix := longestSuffix(region(word, r2), []string{"imma", "mma"})
+	if suffix == "" {
+		return word
+	}
+	stem := word[:len(word)-len(suffix)]
+	if suffix == "mma" && strings.HasSuffix(stem, "po") {
+		return word
+	}
+	return stem
+}
+
+func (s FinnishStemmer) tidy(word string, r1 int) string {
+	if longestSuffix(region(word, r1), fiLongVowels) != "" {
+		word = word[:len(word)-len(string(lastRune(word)))]
+	}
+
+	rv := region(word, r1)
+	if last, size := utf8.DecodeLastRuneInString(rv); strings.ContainsRune("aeiä", last) {
+		if before := rv[:len(rv)-size]; before != "" && !s.isVowel(lastRune(before)) {
+			word = word[:len(word)-size]
+		}
+	}
+
+	rv = region(word, r1)
+	if strings.HasSuffix(rv, "oj") || strings.HasSuffix(rv, "uj") {
+		word = word[:len(word)-1]
+	}
+
+	if strings.HasSuffix(region(word, r1), "jo") {
+		word = word[:len(word)-1]
+	}
+
+	return s.undoubleLastConsonant(word)
+}
+
+// undoubleLastConsonant removes one letter of the last consonant of word
+// when that consonant is doubled.
+func (s FinnishStemmer) undoubleLastConsonant(word string) string {
+	runes := []rune(word)
+	for i := len(runes) - 1; i > 0; i-- {
+		if s.isVowel(runes[i]) {
+			continue
+		}
+		if runes[i-1] == runes[i] {
+			runes = append(runes[:i], runes[i+1:]...)
+		}
+		break
+	}
+	return string(runes)
+}
+
+// endsWithVI reports whether word ends with an i that follows a vowel other
+// than y.
+func (s FinnishStemmer) endsWithVI(word string) bool {
+	if !strings.HasSuffix(word, "i") {
+		return false
+	}
+	before := lastRune(word[:len(word)-1])
+	return before != 'y' && s.isVowel(before)
+}
+
+// isStopWord returns true if the given word is a stop word.
+func (s FinnishStemmer) isStopWord(word string) bool {
+	_, found := fiStopWords[word]
+	return found
+}
+
+func (s FinnishStemmer) isVowel(r rune) bool {
+	switch r {
+	case 'a', 'e', 'i', 'o', 'u', 'y', 'ä', 'ö':
+		return true
+	default:
+		return false
+	}
+}
+
+// regions returns the byte offsets of R1 and R2.
+func (s FinnishStemmer) regions(word string) (int, int) {
+	return standardRegions(word, s.isVowel)
+}
@@ -0,0 +1,601 @@
+package stemmer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFinnishStemmer(t *testing.T) {
+	s := NewFinnishStemmer()
+	require.NotNil(t, s)
+}
+
+func TestFinnishStemmer_isStopWord(t *testing.T) {
+	s := NewFinnishStemmer()
+	require.True(t, s.isStopWord("ja"))
+	require.False(t, s.isStopWord("talo"))
+}
+
+func TestFinnishStemmer_Stem(t *testing.T) {
+	s := NewFinnishStemmer()
+	t.Run("stop word", func(t *testing.T) {
+		require.Equal(t, "ja", s.Stem("ja"))
+		require.Equal(t, "ja", s.Stem("Ja"))
+	})
+
+	f := func(word, stem string) {
+		t.Helper()
+		require.Equal(t, stem, s.Stem(word))
+	}
+
+	f("kirja", "kirj")
+	f("kirjan", "kirj")
+	f("kirjat", "kirj")
+	f("kirjassa", "kirj")
+	f("kirjasta", "kirj")
+	f("kirjaan", "kirj")
+	f("kirjalla", "kirj")
+	f("kirjalta", "kirj")
+	f("kirjalle", "kirj")
+	f("kirjana", "kirj")
+	f("kirjaksi", "kirj")
+	f("kirjoja", "kirj")
+	f("kirjojen", "kirj")
+	f("kirjoissa", "kirj")
+	f("kirjoista", "kirj")
+	f("kirjoihin", "kirj")
+	f("kirjoilla", "kirj")
+	f("kirjani", "kirj")
+	f("kirjasi", "kirj")
+	f("kirjansa", "kirj")
+	f("kirjamme", "kirj")
+	f("kirjanne", "kirj")
+	f("kirjakin", "kirj")
+	f("kirjakaan", "kirj")
+	f("kirjako", "kirj")
+	f("kirjahan", "kirj")
+	f("talo", "talo")
+	f("talon", "talo")
+	f("talot", "talo")
+	f("talossa", "talo")
+	f("talosta", "talo")
+	f("taloon", "talo")
+	f("talolla", "talo")
+	f("talolta", "talo")
+	f("talolle", "talo")
+	f("talona", "talo")
+	f("taloksi", "talo")
+	f("taloja", "talo")
+	f("talojen", "talo")
+	f("taloissa", "talo")
+	f("taloista", "talo")
+	f("taloihin", "talo")
+	f("taloilla", "talo")
+	f("taloni", "talo")
+	f("talomme", "talo")
+	f("katu", "katu")
+	f("kadun", "kadu")
+	f("kadulla", "kadu")
+	f("katuja", "katu")
+	f("katujen", "katu")
+	f("kaupunki", "kaupunk")
+	f("kaupungin", "kaupung")
+	f("kaupungissa", "kaupung")
+	f("kaupunkiin", "kaupunk")
+	f("kaupunkeja", "kaupunk")
+	f("kaupunkien", "kaupunk")
+	f("kaupungeissa", "kaupung")
+	f("maa", "maa")
+	f("maan", "maan")
+	f("maassa", "maas")
+	f("maasta", "maas")
+	f("maahan", "maaha")
+	f("maata", "maata")
+	f("maiden", "maide")
+	f("maissa", "mais")
+	f("maihin", "maih")
+	f("työ", "työ")
+	f("työn", "työn")
+	f("työssä", "työs")
+	f("työstä", "työs")
+	f("työhön", "työhö")
+	f("työtä", "työtä")
+	f("töitä", "töitä")
+	f("töissä", "töis")
+	f("vesi", "vesi")
+	f("veden", "vede")
+	f("vedessä", "vede")
+	f("vettä", "vet")
+	f("vesiä", "ves")
+	f("vesien", "ves")
+	f("käsi", "käsi")
+	f("käden", "käde")
+	f("kädessä", "käde")
+	f("kättä", "kät")
+	f("käsiä", "käs")
+	f("käsien", "käs")
+	f("ihminen", "ihmin")
+	f("ihmisen", "ihmis")
+	f("ihmistä", "ihm")
+	f("ihmiset", "ihmis")
+	f("ihmisiä", "ihmis")
+	f("ihmisten", "ihmist")
+	f("ihmisille", "ihmis")
+	f("suomi", "suomi")
+	f("suomen", "suome")
+	f("suomessa", "suome")
+	f("suomeen", "suome")
+	f("suomea", "suome")
+	f("suomalainen", "suomalain")
+	f("suomalaisen", "suomalais")
+	f("suomalaiset", "suomalais")
+	f("suomalaisia", "suomalais")
+	f("suomalaisten", "suomalaist")
+	f("hyvä", "hyvä")
+	f("hyvän", "hyvä")
+	f("hyvää", "hyvä")
+	f("hyvät", "hyvä")
+	f("hyviä", "hyv")
+	f("hyvien", "hyv")
+	f("parempi", "paremp")
+	f("parempaa", "paremp")
+	f("paras", "paras")
+	f("parhaat", "parh")
+	f("kaunis", "kaunis")
+	f("kauniin", "kaun")
+	f("kaunista", "kaun")
+	f("kauniita", "kauni")
+	f("kauniimpi", "kauniimp")
+	f("kauniimpaa", "kauniimp")
+	f("suuri", "suuri")
+	f("suuren", "suure")
+	f("suurta", "suur")
+	f("suuret", "suure")
+	f("suuria", "suur")
+	f("suurempi", "suuremp")
+	f("suurempaa", "suuremp")
+	f("suurimman", "suurim")
+	f("nopea", "nope")
+	f("nopeasti", "nopeast")
+	f("hitaasti", "hitaast")
+	f("kauniisti", "kauniist")
+	f("tarkasti", "tarkast")
+	f("huolellisesti", "huolellis")
+	f("lukea", "luke")
+	f("luen", "luen")
+	f("luet", "luet")
+	f("lukee", "luke")
+	f("luemme", "luem")
+	f("luette", "luet")
+	f("lukevat", "lukev")
+	f("luki", "luki")
+	f("lukisi", "luki")
+	f("lukenut", "lukenu")
+	f("lukeneet", "luken")
+	f("luettu", "luetu")
+	f("lukeminen", "lukemin")
+	f("lukemisen", "lukemis")
+	f("lukija", "lukij")
+	f("lukijan", "lukij")
+	f("lukijat", "lukij")
+	f("puhua", "puhu")
+	f("puhun", "puhu")
+	f("puhuu", "puhu")
+	f("puhumme", "puhu")
+	f("puhuvat", "puhuv")
+	f("puhui", "puhui")
+	f("puhunut", "puhunu")
+	f("puhuttu", "puhutu")
+	f("puhuminen", "puhumin")
+	f("kirjoittaa", "kirjoit")
+	f("kirjoitan", "kirjoit")
+	f("kirjoitti", "kirjoit")
+	f("kirjoittanut", "kirjoittanu")
+	f("kirjoitettu", "kirjoitetu")
+	f("kirjoittaja", "kirjoittaj")
+	f("kirjoittajan", "kirjoittaj")
+	f("kirjoittajat", "kirjoittaj")
+	f("tehdä", "tehd")
+	f("teen", "teen")
+	f("tekee", "teke")
+	f("teemme", "teem")
+	f("tekevät", "tekev")
+	f("teki", "teki")
+	f("tehnyt", "tehny")
+	f("tehty", "tehty")
+	f("tekeminen", "tekemin")
+	f("mennä", "men")
+	f("menen", "mene")
+	f("menee", "mene")
+	f("menemme", "mene")
+	f("menevät", "menev")
+	f("meni", "meni")
+	f("mennyt", "meny")
+	f("tulla", "tul")
+	f("tulen", "tule")
+	f("tulee", "tule")
+	f("tulemme", "tule")
+	f("tulevat", "tulev")
+	f("tuli", "tuli")
+	f("tullut", "tulu")
+	f("tulevaisuus", "tulevaisuus")
+	f("tulevaisuuden", "tulevaisuud")
+	f("tulevaisuudessa", "tulevaisuud")
+	f("opiskelija", "opiskelij")
+	f("opiskelijan", "opiskelij")
+	f("opiskelijat", "opiskelij")
+	f("opiskelijoiden", "opiskelij")
+	f("opiskelijoille", "opiskelij")
+	f("opettaja", "opettaj")
+	f("opettajan", "opettaj")
+	f("opettajat", "opettaj")
+	f("opettajien", "opettaj")
+	f("koulu", "koulu")
+	f("koulun", "koulu")
+	f("koulussa", "koulu")
+	f("kouluun", "koulu")
+	f("kouluja", "koulu")
+	f("koulujen", "koulu")
+	f("yliopisto", "yliopisto")
+	f("yliopiston", "yliopisto")
+	f("yliopistossa", "yliopisto")
+	f("yliopistoon", "yliopisto")
+	f("yliopistot", "yliopisto")
+	f("yliopistojen", "yliopisto")
+	f("kieli", "kieli")
+	f("kielen", "kiele")
+	f("kielessä", "kiele")
+	f("kieltä", "kiel")
+	f("kieliä", "kiel")
+	f("kielten", "kielt")
+	f("kielien", "kiel")
+	f("sana", "sana")
+	f("sanan", "sana")
+	f("sanat", "sana")
+	f("sanoja", "sano")
+	f("sanojen", "sano")
+	f("sanoa", "sano")
+	f("sanoi", "sanoi")
+	f("sanonut", "sanonu")
+	f("ystävä", "ystäv")
+	f("ystävän", "ystäv")
+	f("ystävät", "ystäv")
+	f("ystäviä", "ystäv")
+	f("ystävien", "ystäv")
+	f("ystävälle", "ystäv")
+	f("ystäväni", "ystäv")
+	f("ystävällinen", "ystävällin")
+	f("ystävällisesti", "ystävällis")
+	f("päivä", "päivä")
+	f("päivän", "päivä")
+	f("päivänä", "päivä")
+	f("päivät", "päivä")
+	f("päiviä", "päiv")
+	f("päivien", "päiv")
+	f("päivittäin", "päivit")
+	f("vuosi", "vuosi")
+	f("vuoden", "vuode")
+	f("vuonna", "vuon")
+	f("vuotta", "vuot")
+	f("vuodet", "vuode")
+	f("vuosia", "vuos")
+	f("vuosien", "vuos")
+	f("aika", "aika")
+	f("ajan", "aja")
+	f("aikaa", "aika")
+	f("ajat", "aja")
+	f("aikoja", "aiko")
+	f("aikojen", "aiko")
+	f("elämä", "eläm")
+	f("elämän", "eläm")
+	f("elämää", "eläm")
+	f("elämässä", "eläm")
+	f("tietokone", "tietokon")
+	f("tietokoneen", "tietokon")
+	f("tietokoneella", "tietokon")
+	f("tietokoneet", "tietokon")
+	f("tietokoneita", "tietokon")
+	f("tietokoneiden", "tietokon")
+	f("hallitus", "hallitus")
+	f("hallituksen", "hallituks")
+	f("hallitusta", "hallitu")
+	f("hallitukset", "hallituks")
+	f("hallituksia", "hallituks")
+	f("kysymys", "kysymys")
+	f("kysymyksen", "kysymyks")
+	f("kysymystä", "kysymy")
+	f("kysymykset", "kysymyks")
+	f("kysymyksiä", "kysymyks")
+	f("vastaus", "vastaus")
+	f("vastauksen", "vastauks")
+	f("vastausta", "vastau")
+	f("vastaukset", "vastauks")
+	f("rakkaus", "rakkaus")
+	f("rakkauden", "rakkaud")
+	f("rakkautta", "rakkaut")
+	f("terveys", "terveys")
+	f("terveyden", "terveyd")
+	f("terveyttä", "terveyt")
+	f("mahdollisuus", "mahdollisuus")
+	f("mahdollisuuden", "mahdollisuud")
+	f("mahdollisuuksia", "mahdollisuuks")
+	f("tärkeä", "tärk")
+	f("tärkeän", "tärkeä")
+	f("tärkeää", "tärkeä")
+	f("tärkeitä", "tärk")
+	f("tärkeämpi", "tärkeämp")
+	f("tärkein", "tärk")
+	f("tärkeimmät", "tärkeim")
+	f("tärkeimpiä", "tärkeimp")
+	f("kotona", "koto")
+	f("kotiin", "kot")
+	f("kotoa", "koto")
+	f("talvi", "talv")
+	f("talven", "talv")
+	f("talvella", "talv")
+	f("kesä", "kesä")
+	f("kesän", "kesä")
+	f("kesällä", "kesä")
+	f("kesää", "kesä")
+	f("järvi", "järv")
+	f("järven", "järv")
+	f("järvellä", "järv")
+	f("järviä", "järv")
+	f("metsä", "mets")
+	f("metsän", "mets")
+	f("metsässä", "mets")
+	f("metsiä", "mets")
+	f("metsien", "mets")
+	f("saari", "saari")
+	f("saaren", "saare")
+	f("saarella", "saare")
+	f("saaria", "saar")
+	f("poika", "poika")
+	f("pojan", "poja")
+	f("poikaa", "poika")
+	f("pojat", "poja")
+	f("poikia", "poik")
+	f("poikien", "poik")
+	f("tyttö", "tytö")
+	f("tytön", "tytö")
+	f("tyttöä", "tytö")
+	f("tytöt", "tytö")
+	f("tyttöjä", "tyttöj")
+	f("tyttöjen", "tyttöj")
+	f("lapsi", "lap")
+	f("lapsen", "laps")
+	f("lasta", "las")
+	f("lapset", "laps")
+	f("lapsia", "laps")
+	f("lasten", "last")
+	f("äiti", "äiti")
+	f("äidin", "äid")
+	f("äitiä", "äit")
+	f("isä", "isä")
+	f("isän", "isä")
+	f("isää", "isä")
+	f("veli", "veli")
+	f("veljen", "velj")
+	f("veljeä", "velj")
+	f("sisko", "sisko")
+	f("siskon", "sisko")
+	f("siskoa", "sisko")
+	f("kauppa", "kaup")
+	f("kaupan", "kaupa")
+	f("kaupassa", "kaupa")
+	f("kauppaan", "kaup")
+	f("kauppoja", "kaupo")
+	f("kauppojen", "kaupo")
+	f("katto", "kato")
+	f("katon", "kato")
+	f("kattoa", "kato")
+	f("tupa", "tupa")
+	f("tuvan", "tuva")
+	f("tupaan", "tupa")
+	f("lintu", "lintu")
+	f("linnun", "linu")
+	f("lintua", "lintu")
+	f("linnut", "linu")
+	f("lintuja", "lintu")
+	f("huone", "huone")
+	f("huoneen", "huone")
+	f("huoneessa", "huone")
+	f("huoneeseen", "huone")
+	f("huoneita", "huone")
+	f("huoneiden", "huone")
+	f("huoneisiin", "huone")
+	f("perhe", "perh")
+	f("perheen", "perh")
+	f("perheessä", "perh")
+	f("perheeseen", "perh")
+	f("perheitä", "perh")
+	f("perheiden", "perh")
+	f("vene", "vene")
+	f("veneen", "vene")
+	f("veneessä", "vene")
+	f("veneitä", "vene")
+	f("kone", "kone")
+	f("koneen", "kone")
+	f("koneet", "kone")
+	f("koneita", "kone")
+	f("koneiden", "kone")
+	f("kysyä", "kysy")
+	f("kysyn", "kysy")
+	f("kysyy", "kysyy")
+	f("kysyi", "kysyi")
+	f("kysynyt", "kysyny")
+	f("kysytty", "kysyty")
+	f("ymmärtää", "ymmärt")
+	f("ymmärrän", "ymmär")
+	f("ymmärsi", "ymmär")
+	f("ymmärtänyt", "ymmärtäny")
+	f("haluta", "halu")
+	f("haluan", "halua")
+	f("haluaa", "halua")
+	f("halusi", "halu")
+	f("halunnut", "halunu")
+	f("tietää", "tietä")
+	f("tiedän", "tiedä")
+	f("tiesi", "tiesi")
+	f("tiennyt", "tieny")
+	f("nähdä", "nähd")
+	f("näen", "näen")
+	f("näkee", "näke")
+	f("näki", "näki")
+	f("nähnyt", "nähny")
+	f("nähty", "nähty")
+	f("asua", "asu")
+	f("asun", "asu")
+	f("asuu", "asu")
+	f("asui", "asui")
+	f("asunut", "asunu")
+	f("asunto", "asunto")
+	f("asunnon", "asuno")
+	f("asunnossa", "asuno")
+	f("asuntoja", "asunto")
+	f("asukas", "asukas")
+	f("asukkaan", "asuk")
+	f("asukkaat", "asuk")
+	f("asukkaita", "asuk")
+	f("asukkaiden", "asuk")
+	f("rikas", "rikas")
+	f("rikkaan", "rik")
+	f("rikkaat", "rik")
+	f("rikkaita", "rik")
+	f("onnellinen", "onnellin")
+	f("onnellisen", "onnellis")
+	f("onnellisia", "onnellis")
+	f("onnellisuus", "onnellisuus")
+	f("onnellisuuden", "onnellisuud")
+	f("kaikki", "kaik")
+	f("kaikkien", "kaik")
+	f("kaikkia", "kaik")
+	f("kaikille", "kaik")
+	f("jokainen", "jokain")
+	f("jokaisen", "jokais")
+	f("jokaista", "joka")
+	f("itsenäisyys", "itsenäisyys")
+	f("itsenäisyyden", "itsenäisyyd")
+	f("kansainvälinen", "kansainvälin")
+	f("kansainvälisen", "kansainvälis")
+	f("kansainvälisesti", "kansainvälis")
+	f("taloudellinen", "taloudellin")
+	f("taloudellisen", "taloudellis")
+	f("taloudellisesti", "taloudellis")
+	f("yhteiskunta", "yhteiskun")
+	f("yhteiskunnan", "yhteiskun")
+	f("yhteiskunnassa", "yhteiskun")
+	f("yhteiskuntaa", "yhteiskunt")
+	f("yhteiskunnallinen", "yhteiskunnallin")
+	f("tutkimus", "tutkimus")
+	f("tutkimuksen", "tutkimuks")
+	f("tutkimuksessa", "tutkimuks")
+	f("tutkimukset", "tutkimuks")
+	f("tutkimuksia", "tutkimuks")
+	f("tutkimusten", "tutkimust")
+	f("tutkija", "tutkij")
+	f("tutkijan", "tutkij")
+	f("tutkijat", "tutkij")
+	f("tutkijoiden", "tutkij")
+	f("kehitys", "kehitys")
+	f("kehityksen", "kehityks")
+	f("kehittää", "kehit")
+	f("kehitti", "kehit")
+	f("kehittänyt", "kehittäny")
+	f("kehittäminen", "kehittämin")
+	f("kehittyä", "kehity")
+	f("kehittyneet", "kehittyn")
+	f("johtaja", "johtaj")
+	f("johtajan", "johtaj")
+	f("johtajat", "johtaj")
+	f("johtajien", "johtaj")
+	f("johtaminen", "johtamin")
+	f("johtamisen", "johtamis")
+	f("mukana", "muka")
+	f("kanssamme", "kan")
+	f("kanssani", "kan")
+	f("kanssasi", "kan")
+	f("tarina", "tar")
+	f("tarinan", "tarin")
+	f("tarinat", "tarin")
+	f("tarinoita", "tarino")
+	f("tarinoiden", "tarino")
+	f("maailma", "maailm")
+	f("maailman", "maailm")
+	f("maailmassa", "maailm")
+	f("maailmaan", "maailm")
+	f("maailmoja", "maailmo")
+	f("tähti", "täht")
+	f("tähden", "tähd")
+	f("tähteä", "täht")
+	f("tähdet", "tähd")
+	f("tähtiä", "täht")
+	f("tähtien", "täht")
+	f("kuu", "kuu")
+	f("kuun", "kuun")
+	f("kuuta", "kuuta")
+	f("aurinko", "aur")
+	f("auringon", "auringo")
+	f("aurinkoa", "aurinko")
+	f("meri", "meri")
+	f("meren", "mere")
+	f("merellä", "mere")
+	f("merta", "mer")
+	f("meriä", "mer")
+	f("kala", "kala")
+	f("kalan", "kala")
+	f("kalaa", "kala")
+	f("kaloja", "kalo")
+	f("kalojen", "kalo")
+	f("kalastaa", "kalast")
+	f("kalastaja", "kalastaj")
+	f("kalastajat", "kalastaj")
+	f("ruoka", "ruoka")
+	f("ruoan", "ruoan")
+	f("ruokaa", "ruoka")
+	f("ruokia", "ruok")
+	f("ruokien", "ruok")
+	f("juoda", "juoda")
+	f("juon", "juon")
+	f("juo", "juo")
+	f("joi", "joi")
+	f("juonut", "juonu")
+	f("syödä", "syödä")
+	f("syön", "syön")
+	f("syö", "syö")
+	f("söi", "söi")
+	f("syönyt", "syöny")
+	f("kahvi", "kahv")
+	f("kahvin", "kahv")
+	f("kahvia", "kahv")
+	f("kahvila", "kahvil")
+	f("kahvilassa", "kahvil")
+	f("kahviloita", "kahvilo")
+	f("tee", "tee")
+	f("teetä", "teetä")
+	f("leipä", "leipä")
+	f("leivän", "leivä")
+	f("leipää", "leipä")
+	f("leipiä", "leip")
+	f("maito", "maito")
+	f("maidon", "maido")
+	f("maitoa", "maito")
+}
+
+func TestFinnishStemmer_regions(t *testing.T) {
+	s := NewFinnishStemmer()
+
+	f := func(word, r1, r2 string) {
+		t.Helper()
+		r1Start, r2Start := s.regions(word)
+		require.Equal(t, r1, word[r1Start:])
+		require.Equal(t, r2, word[r2Start:])
+	}
+
+	f("kirjoittaja", "joittaja", "taja")
+	f("yliopisto", "iopisto", "isto")
+	f("ihminen", "minen", "en")
+	f("äiti", "i", "")
+	f("työ", "", "")
+}
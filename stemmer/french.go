@@ -0,0 +1,645 @@
+package stemmer
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+)
+
+var (
+	frStopWords = map[string]struct{}{
+		"au":      {},
+		"aux":     {},
+		"avec":    {},
+		"ce":      {},
+		"ces":     {},
+		"dans":    {},
+		"de":      {},
+		"des":     {},
+		"du":      {},
+		"elle":    {},
+		"en":      {},
+		"et":      {},
+		"eux":     {},
+		"il":      {},
+		"je":      {},
+		"la":      {},
+		"le":      {},
+		"leur":    {},
+		"lui":     {},
+		"ma":      {},
+		"mais":    {},
+		"me":      {},
+		"même":    {},
+		"mes":     {},
+		"moi":     {},
+		"mon":     {},
+		"ne":      {},
+		"nos":     {},
+		"notre":   {},
+		"nous":    {},
+		"on":      {},
+		"ou":      {},
+		"par":     {},
+		"pas":     {},
+		"pour":    {},
+		"qu":      {},
+		"que":     {},
+		"qui":     {},
+		"sa":      {},
+		"se":      {},
+		"ses":     {},
+		"son":     {},
+		"sur":     {},
+		"ta":      {},
+		"te":      {},
+		"tes":     {},
+		"toi":     {},
+		"ton":     {},
+		"tu":      {},
+		"un":      {},
+		"une":     {},
+		"vos":     {},
+		"votre":   {},
+		"vous":    {},
+		"c":       {},
+		"d":       {},
+		"j":       {},
+		"l":       {},
+		"à":       {},
+		"m":       {},
+		"n":       {},
+		"s":       {},
+		"t":       {},
+		"y":       {},
+		"été":     {},
+		"étée":    {},
+		"étées":   {},
+		"étés":    {},
+		"étant":   {},
+		"suis":    {},
+		"es":      {},
+		"est":     {},
+		"sommes":  {},
+		"êtes":    {},
+		"sont":    {},
+		"serai":   {},
+		"sera":    {},
+		"ai":      {},
+		"as":      {},
+		"avons":   {},
+		"avez":    {},
+		"ont":     {},
+		"eu":      {},
+		"avait":   {},
+		"ceci":    {},
+		"cela":    {},
+		"celà":    {},
+		"cet":     {},
+		"cette":   {},
+		"ici":     {},
+		"ils":     {},
+		"les":     {},
+		"leurs":   {},
+		"quel":    {},
+		"quels":   {},
+		"quelle":  {},
+		"quelles": {},
+		"sans":    {},
+		"soi":     {},
+	}
+
+	// frElisions are the elided articles and pronouns that may be glued to
+	// the front of a word with an apostrophe.
+	frElisions = []string{
+		"quoiqu'",
+		"puisqu'",
+		"lorsqu'",
+		"jusqu'",
+		"qu'",
+		"c'",
+		"d'",
+		"j'",
+		"l'",
+		"m'",
+		"n'",
+		"s'",
+		"t'",
+	}
+
+	frRVPrefixes = []string{"par", "col", "tap"}
+
+	frStandardSuffixes = []string{
+		"issements",
+		"issement",
+		"atrices",
+		"amment",
+		"ateurs",
+		"atrice",
+		"emment",
+		"ements",
+		"euses",
+		"istes",
+		"ables",
+		"ances",
+		"ateur",
+		"ation",
+		"ement",
+		"ences",
+		"iqUes",
+		"ismes",
+		"logie",
+		"usion",
+		"ution",
+		"ations",
+		"logies",
+		"usions",
+		"utions",
+		"ments",
+		"able",
+		"ance",
+		"eaux",
+		"ence",
+		"euse",
+		"iqUe",
+		"isme",
+		"iste",
+		"ités",
+		"ives",
+		"ment",
+		"aux",
+		"eux",
+		"ifs",
+		"ité",
+		"ive",
+		"if",
+	}
+
+	frIVerbSuffixes = []string{
+		"issantes",
+		"issaIent",
+		"issions",
+		"issante",
+		"issants",
+		"iraIent",
+		"irions",
+		"issais",
+		"issait",
+		"issant",
+		"issent",
+		"issiez",
+		"issons",
+		"irais",
+		"irait",
+		"irent",
+		"iriez",
+		"irons",
+		"iront",
+		"isses",
+		"issez",
+		"irai",
+		"iras",
+		"irez",
+		"isse",
+		"îmes",
+		"îtes",
+		"ies",
+		"ira",
+		"ie",
+		"ir",
+		"is",
+		"it",
+		"ît",
+		"i",
+	}
+
+	frVerbSuffixes = []string{
+		"eraIent",
+		"assions",
+		"assiez",
+		"assent",
+		"erions",
+		"asses",
+		"erais",
+		"erait",
+		"eriez",
+		"erons",
+		"eront",
+		"aIent",
+		"èrent",
+		"antes",
+		"asse",
+		"ante",
+		"ants",
+		"âmes",
+		"âtes",
+		"erai",
+		"eras",
+		"erez",
+		"ions",
+		"ais",
+		"ait",
+		"ant",
+		"era",
+		"ées",
+		"iez",
+		"ai",
+		"as",
+		"ât",
+		"ée",
+		"er",
+		"ez",
+		"és",
+		"a",
+		"é",
+	}
+
+	frResidualSuffixes = []string{"Ière", "ière", "Ier", "ier", "ion", "e", "ë"}
+
+	frUndoubleSuffixes = []string{"eill", "enn", "onn", "ett", "ell"}
+
+	frMarkerReplacer = strings.NewReplacer("I", "i", "U", "u", "Y", "y")
+)
+
+type FrenchStemmer struct{}
+
+// NewFrenchStemmer creates a new FrenchStemmer.
+func NewFrenchStemmer() *FrenchStemmer {
+	return &FrenchStemmer{}
+}
+
+// Stem returns the stem of the given word.
+func (s FrenchStemmer) Stem(word string) string {
+	word = strings.ToLower(word)
+	word = s.removeElision(word)
+	if s.isStopWord(word) {
+		return word
+	}
+
+	word = s.markVowels(word)
+	rv, r1, r2 := s.regions(word)
+
+	var removed bool
+	if word, removed = s.suffixStep(word, rv, r1, r2); !removed {
+		word = s.residualSuffix(word, rv, r2)
+	}
+
+	word = s.undouble(word)
+	word = s.unaccent(word)
+
+	return frMarkerReplacer.Replace(word)
+}
+
+// removeElision strips an elided article or pronoun such as l' or qu' from
+// the front of word.
+func (s FrenchStemmer) removeElision(word string) string {
+	word = strings.ReplaceAll(word, "’", "'")
+	for _, prefix := range frElisions {
+		if strings.HasPrefix(word, prefix) && len(word) > len(prefix) {
+			return word[len(prefix):]
+		}
+	}
+	return word
+}
+
+// markVowels upper-cases u, i and y where they act as consonants, so that
+// they are no longer treated as vowels.
+func (s FrenchStemmer) markVowels(word string) string {
+	runes := []rune(word)
+	for i := 0; i < len(runes)-1; {
+		switch {
+		case s.isVowel(runes[i]) && (runes[i+1] == 'u' || runes[i+1] == 'i') && i+2 < len(runes) && s.isVowel(runes[i+2]):
+			runes[i+1] = unicode.ToUpper(runes[i+1])
+		case s.isVowel(runes[i]) && runes[i+1] == 'y':
+			runes[i+1] = 'Y'
+		case runes[i] == 'y' && s.isVowel(runes[i+1]):
+			runes[i] = 'Y'
+		case runes[i] == 'q' && runes[i+1] == 'u':
+			runes[i+1] = 'U'
+		default:
+			i++
+		}
+	}
+	return string(runes)
+}
+
+// suffixStep runs the standard suffix removal, falling back to the verb
+// suffixes, and reports whether any of them removed an ending.
+func (s FrenchStemmer) suffixStep(word string, rv, r1, r2 int) (string, bool) {
+	stem, ok := s.standardSuffix(word, rv, r1, r2)
+	if !ok {
+		stem, ok = s.iVerbSuffix(stem, rv)
+	}
+	if !ok {
+		stem, ok = s.verbSuffix(stem, rv, r2)
+	}
+	if !ok {
+		return stem, false
+	}
+
+	if strings.HasSuffix(stem, "Y") {
+		stem = stem[:len(stem)-1] + "i"
+	} else if strings.HasSuffix(stem, "ç") {
+		stem = strings.TrimSuffix(stem, "ç") + "c"
+	}
+	return stem, true
+}
+
+// standardSuffix removes the standard suffixes. It reports false when no
+// ending was removed and the verb suffixes should be tried; the adverbial
+// -ment endings are removed but still report false.
+func (s FrenchStemmer) standardSuffix(word string, rv, r1, r2 int) (string, bool) {
+	suffix := longestSuffix(word, frStandardSuffixes)
+	if suffix == "" {
+		return word, false
+	}
+	stem := word[:len(word)-len(suffix)]
+
+	switch suffix {
+	case "ance", "iqUe", "isme", "able", "iste", "eux", "ances", "iqUes", "ismes", "ables", "istes":
+		if !inRegion(word, suffix, r2) {
+			return word, false
+		}
+		return stem, true
+
+	case "atrice", "ateur", "ation", "atrices", "ateurs", "ations":
+		if !inRegion(word, suffix, r2) {
+			return word, false
+		}
+		return s.replaceIc(stem, r2), true
+
+	case "logie", "logies":
+		if !inRegion(word, suffix, r2) {
+			return word, false
+		}
+		return stem + "log", true
+
+	case "usion", "ution", "usions", "utions":
+		if !inRegion(word, suffix, r2) {
+			return word, false
+		}
+		return stem + "u", true
+
+	case "ence", "ences":
+		if !inRegion(word, suffix, r2) {
+			return word, false
+		}
+		return stem + "ent", true
+
+	case "ement", "ements":
+		if !inRegion(word, suffix, rv) {
+			return word, false
+		}
+		return s.afterEment(stem, rv, r1, r2), true
+
+	case "ité", "ités":
+		if !inRegion(word, suffix, r2) {
+			return word, false
+		}
+		switch next := longestSuffix(stem, []string{"abil", "ic", "iv"}); next {
+		case "abil":
+			if inRegion(stem, next, r2) {
+				stem = stem[:len(stem)-len(next)]
+			} else {
+				stem = stem[:len(stem)-len(next)] + "abl"
+			}
+		case "ic":
+			if inRegion(stem, next, r2) {
+				stem = stem[:len(stem)-len(next)]
+			} else {
+				stem = stem[:len(stem)-len(next)] + "iqU"
+			}
+		case "iv":
+			if inRegion(stem, next, r2) {
+				stem = stem[:len(stem)-len(next)]
+			}
+		}
+		return stem, true
+
+	case "if", "ive", "ifs", "ives":
+		if !inRegion(word, suffix, r2) {
+			return word, false
+		}
+		if strings.HasSuffix(stem, "at") && inRegion(stem, "at", r2) {
+			stem = s.replaceIc(stem[:len(stem)-2], r2)
+		}
+		return stem, true
+
+	case "eaux":
+		return stem + "eau", true
+
+	case "aux":
+		if !inRegion(word, suffix, r1) {
+			return word, false
+		}
+		return stem + "al", true
+
+	case "euse", "euses":
+		if inRegion(word, suffix, r2) {
+			return stem, true
+		}
+		if inRegion(word, suffix, r1) {
+			return stem + "eux", true
+		}
+		return word, false
+
+	case "issement", "issements":
+		if !inRegion(word, suffix, r1) || stem == "" || s.isVowel(lastRune(stem)) {
+			return word, false
+		}
+		return stem, true
+
+	case "amment":
+		if inRegion(word, suffix, rv) {
+			word = stem + "ant"
+		}
+		return word, false
+
+	case "emment":
+		if inRegion(word, suffix, rv) {
+			word = stem + "ent"
+		}
+		return word, false
+
+	case "ment", "ments":
+		if stem != "" && s.isVowel(lastRune(stem)) && len(stem)-len(string(lastRune(stem))) >= rv {
+			word = stem
+		}
+		return word, false
+	}
+
+	return word, false
+}
+
+// afterEment handles the endings that may precede a removed -ement.
+func (s FrenchStemmer) afterEment(stem string, rv, r1, r2 int) string {
+	next := longestSuffix(stem, []string{"Ièr", "ièr", "eus", "iqU", "abl", "iv"})
+	if next == "" {
+		return stem
+	}
+	rest := stem[:len(stem)-len(next)]
+
+	switch next {
+	case "iv":
+		if inRegion(stem, next, r2) {
+			stem = rest
+			if strings.HasSuffix(stem, "at") && inRegion(stem, "at", r2) {
+				stem = stem[:len(stem)-2]
+			}
+		}
+	case "eus":
+		if inRegion(stem, next, r2) {
+			stem = rest
+		} else if inRegion(stem, next, r1) {
+			stem = rest + "eux"
+		}
+	case "abl", "iqU":
+		if inRegion(stem, next, r2) {
+			stem = rest
+		}
+	case "ièr", "Ièr":
+		if inRegion(stem, next, rv) {
+			stem = rest + "i"
+		}
+	}
+	return stem
+}
+
+// replaceIc removes a preceding "ic" inside R2, or turns it into "iqU".
+func (s FrenchStemmer) replaceIc(stem string, r2 int) string {
+	if !strings.HasSuffix(stem, "ic") {
+		return stem
+	}
+	if inRegion(stem, "ic", r2) {
+		return stem[:len(stem)-2]
+	}
+	return stem[:len(stem)-2] + "iqU"
+}
+
+// iVerbSuffix removes the verb endings beginning with i, which must be
+// preceded by a non-vowel inside RV.
+func (s FrenchStemmer) iVerbSuffix(word string, rv int) (string, bool) {
+	suffix := longestSuffix(region(word, rv), frIVerbSuffixes)
+	if suffix == "" {
+		return word, false
+	}
+
+	stem := word[:len(word)-len(suffix)]
+	if len(stem) <= rv || s.isVowel(lastRune(stem)) {
+		return word, false
+	}
+	return stem, true
+}
+
+func (s FrenchStemmer) verbSuffix(word string, rv, r2 int) (string, bool) {
+	suffix := longestSuffix(region(word, rv), frVerbSuffixes)
+	if suffix == "" {
+		return word, false
+	}
+	stem := word[:len(word)-len(suffix)]
+
+	switch suffix {
+	case "ions":
+		if !inRegion(word, suffix, r2) {
+			return word, false
+		}
+	case "âmes", "ât", "âtes", "a", "ai", "aIent", "ais", "ait", "ant", "ante", "antes", "ants",
+		"as", "asse", "assent", "asses", "assiez", "assions":
+		if strings.HasSuffix(stem, "e") && inRegion(stem, "e", rv) {
+			stem = stem[:len(stem)-1]
+		}
+	}
+	return stem, true
+}
+
+func (s FrenchStemmer) residualSuffix(word string, rv, r2 int) string {
+	if strings.HasSuffix(word, "s") && len(word) > 1 {
+		if !strings.ContainsRune("aiouès", lastRune(word[:len(word)-1])) {
+			word = word[:len(word)-1]
+		}
+	}
+
+	suffix := longestSuffix(region(word, rv), frResidualSuffixes)
+	stem := word[:len(word)-len(suffix)]
+
+	switch suffix {
+	case "ion":
+		if inRegion(word, suffix, r2) && len(stem) > rv && (strings.HasSuffix(stem, "s") || strings.HasSuffix(stem, "t")) {
+			return stem
+		}
+	case "ier", "ière", "Ier", "Ière":
+		return stem + "i"
+	case "e":
+		return stem
+	case "ë":
+		if strings.HasSuffix(stem, "gu") && len(stem)-2 >= rv {
+			return stem
+		}
+	}
+	return word
+}
+
+// undouble removes the last letter of a doubled -enn, -onn, -ett, -ell or
+// -eill ending.
+func (s FrenchStemmer) undouble(word string) string {
+	if longestSuffix(word, frUndoubleSuffixes) != "" {
+		return word[:len(word)-1]
+	}
+	return word
+}
+
+// unaccent turns é or è followed by at least one non-vowel at the end of
+// the word into e.
+func (s FrenchStemmer) unaccent(word string) string {
+	end := len(word)
+	for end > 0 && !s.isVowel(lastRune(word[:end])) {
+		end -= len(string(lastRune(word[:end])))
+	}
+	if end == len(word) {
+		return word
+	}
+
+	head := word[:end]
+	if strings.HasSuffix(head, "é") || strings.HasSuffix(head, "è") {
+		return head[:len(head)-len("é")] + "e" + word[end:]
+	}
+	return word
+}
+
+// isStopWord returns true if the given word is a stop word.
+func (s FrenchStemmer) isStopWord(word string) bool {
+	_, found := frStopWords[word]
+	return found
+}
+
+func (s FrenchStemmer) isVowel(r rune) bool {
+	switch r {
+	case 'a', 'e', 'i', 'o', 'u', 'y', 'â', 'à', 'ë', 'é', 'ê', 'è', 'ï', 'î', 'ô', 'û', 'ù':
+		return true
+	default:
+		return false
+	}
+}
+
+// regions returns the byte offsets of RV, R1 and R2.
+func (s FrenchStemmer) regions(word string) (int, int, int) {
+	r1, r2 := standardRegions(word, s.isVowel)
+	runes := []rune(word)
+	rv := len(word)
+
+	switch {
+	case len(runes) >= 3 && s.isVowel(runes[0]) && s.isVowel(runes[1]):
+		rv = len(string(runes[:3]))
+	case len(runes) >= 3 && slices.Contains(frRVPrefixes, string(runes[:3])):
+		rv = 3
+	default:
+		for i := 1; i < len(runes); i++ {
+			if s.isVowel(runes[i]) {
+				rv = len(string(runes[:i+1]))
+				break
+			}
+		}
+	}
+
+	return rv, r1, r2
+}
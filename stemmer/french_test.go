@@ -0,0 +1,349 @@
+package stemmer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFrenchStemmer(t *testing.T) {
+	s := NewFrenchStemmer()
+	require.NotNil(t, s)
+}
+
+func TestFrenchStemmer_isStopWord(t *testing.T) {
+	s := NewFrenchStemmer()
+	require.True(t, s.isStopWord("avec"))
+	require.False(t, s.isStopWord("pomme"))
+}
+
+func TestFrenchStemmer_Stem(t *testing.T) {
+	s := NewFrenchStemmer()
+	t.Run("stop word", func(t *testing.T) {
+		require.Equal(t, "avec", s.Stem("avec"))
+		require.Equal(t, "avec", s.Stem("Avec"))
+	})
+
+	t.Run("elision", func(t *testing.T) {
+		require.Equal(t, "homm", s.Stem("l'homme"))
+		require.Equal(t, "homm", s.Stem("L’homme"))
+		require.Equal(t, "il", s.Stem("qu'il"))
+		require.Equal(t, "accord", s.Stem("d'accord"))
+		require.Equal(t, "aujourd'hui", s.Stem("aujourd'hui"))
+		require.Equal(t, "amour", s.Stem("jusqu'amour"))
+	})
+
+	f := func(word, stem string) {
+		t.Helper()
+		require.Equal(t, stem, s.Stem(word))
+	}
+
+	f("abandonner", "abandon")
+	f("abaissement", "abaissement")
+	f("abolition", "abolit")
+	f("abondamment", "abond")
+	f("absolument", "absolu")
+	f("accablement", "accabl")
+	f("accepter", "accept")
+	f("accompagnaient", "accompagn")
+	f("accomplissement", "accompl")
+	f("accueillir", "accueil")
+	f("achèterons", "achet")
+	f("acquisition", "acquisit")
+	f("actionnaires", "actionnair")
+	f("activement", "activ")
+	f("admirablement", "admir")
+	f("adoration", "ador")
+	f("affaiblissement", "affaibl")
+	f("agréable", "agréabl")
+	f("aimerions", "aim")
+	f("aisément", "ais")
+	f("allemandes", "allemand")
+	f("amicalement", "amical")
+	f("amitié", "amiti")
+	f("amoureuse", "amour")
+	f("amoureux", "amour")
+	f("anciennement", "ancien")
+	f("animaux", "animal")
+	f("annonçait", "annonc")
+	f("apercevoir", "apercevoir")
+	f("apparaissent", "apparaissent")
+	f("appartement", "appart")
+	f("applaudissements", "applaud")
+	f("apprendre", "apprendr")
+	f("arrivèrent", "arriv")
+	f("assurance", "assur")
+	f("attentivement", "attent")
+	f("aucunement", "aucun")
+	f("autorités", "autor")
+	f("avancement", "avanc")
+	f("aventures", "aventur")
+	f("banquiers", "banqui")
+	f("bâtiments", "bât")
+	f("beaucoup", "beaucoup")
+	f("bibliothèques", "bibliothequ")
+	f("blessures", "blessur")
+	f("bonheur", "bonheur")
+	f("bruyamment", "brui")
+	f("cachaient", "cach")
+	f("calmement", "calm")
+	f("capitale", "capital")
+	f("catholiques", "cathol")
+	f("certainement", "certain")
+	f("chaleureusement", "chaleur")
+	f("changements", "chang")
+	f("chansons", "chanson")
+	f("chapeaux", "chapeau")
+	f("chevaux", "cheval")
+	f("chirurgien", "chirurgien")
+	f("citoyennes", "citoyen")
+	f("clairement", "clair")
+	f("colonies", "colon")
+	f("commencement", "commenc")
+	f("commerciale", "commercial")
+	f("complètement", "complet")
+	f("comprendrait", "comprendr")
+	f("conclusions", "conclus")
+	f("confiance", "confianc")
+	f("connaissances", "connaiss")
+	f("considérablement", "consider")
+	f("construction", "construct")
+	f("continuellement", "continuel")
+	f("conversations", "convers")
+	f("courageusement", "courag")
+	f("cruellement", "cruel")
+	f("curiosité", "curios")
+	f("dangereuse", "danger")
+	f("décidément", "décid")
+	f("découvertes", "découvert")
+	f("définitivement", "définit")
+	f("délicatesse", "délicatess")
+	f("demanderaient", "demand")
+	f("dépendance", "dépend")
+	f("désespérément", "désesper")
+	f("destruction", "destruct")
+	f("développement", "développ")
+	f("difficultés", "difficult")
+	f("directement", "direct")
+	f("discrètement", "discret")
+	f("doucement", "douc")
+	f("économiques", "économ")
+	f("éducation", "éduc")
+	f("effectivement", "effect")
+	f("égalité", "égal")
+	f("élégamment", "éleg")
+	f("émotions", "émot")
+	f("employaient", "emploi")
+	f("encouragement", "encourag")
+	f("énergiquement", "énerg")
+	f("enfance", "enfanc")
+	f("ensemble", "ensembl")
+	f("entièrement", "entier")
+	f("entreprises", "entrepris")
+	f("environnement", "environ")
+	f("époustouflant", "époustoufl")
+	f("évidemment", "évident")
+	f("exactement", "exact")
+	f("exceptionnellement", "exceptionnel")
+	f("exigences", "exigent")
+	f("explications", "expliqu")
+	f("extraordinairement", "extraordinair")
+	f("facilement", "facil")
+	f("faiblesse", "faibless")
+	f("familles", "famill")
+	f("fantastique", "fantast")
+	f("favorablement", "favor")
+	f("félicitations", "félicit")
+	f("finalement", "final")
+	f("finissaient", "fin")
+	f("fréquemment", "fréquent")
+	f("gentillesse", "gentilless")
+	f("gouvernement", "gouvern")
+	f("gracieusement", "gracieux")
+	f("grandissant", "grand")
+	f("habitudes", "habitud")
+	f("heureusement", "heureux")
+	f("histoires", "histoir")
+	f("honnêtement", "honnêt")
+	f("humanité", "human")
+	f("illuminations", "illumin")
+	f("immédiatement", "immédiat")
+	f("importance", "import")
+	f("impossibilité", "impossibil")
+	f("indépendance", "indépend")
+	f("infiniment", "infin")
+	f("intelligemment", "intelligent")
+	f("intéressante", "intéress")
+	f("jalousie", "jalous")
+	f("jardinier", "jardini")
+	f("joyeusement", "joyeux")
+	f("justement", "just")
+	f("lentement", "lent")
+	f("libérations", "liber")
+	f("librement", "libr")
+	f("longuement", "longu")
+	f("lumineuses", "lumin")
+	f("magnifiquement", "magnif")
+	f("maintenant", "mainten")
+	f("maisons", "maison")
+	f("malheureusement", "malheur")
+	f("manifestations", "manifest")
+	f("marchandises", "marchandis")
+	f("mélancolie", "mélancol")
+	f("merveilleusement", "merveil")
+	f("ministères", "minister")
+	f("modernisation", "modernis")
+	f("mouvements", "mouv")
+	f("naturellement", "naturel")
+	f("nécessairement", "nécessair")
+	f("nerveusement", "nerveux")
+	f("nouvelles", "nouvel")
+	f("obligations", "oblig")
+	f("observations", "observ")
+	f("occasionnellement", "occasionnel")
+	f("opérations", "oper")
+	f("orgueilleuse", "orgueil")
+	f("ouvertement", "ouvert")
+	f("parfaitement", "parfait")
+	f("particulièrement", "particuli")
+	f("patiemment", "patient")
+	f("personnalités", "personnal")
+	f("philosophiques", "philosoph")
+	f("poliment", "pol")
+	f("politiquement", "polit")
+	f("portugaises", "portugais")
+	f("positivement", "posit")
+	f("précisément", "précis")
+	f("premièrement", "premi")
+	f("probablement", "probabl")
+	f("professionnelle", "professionnel")
+	f("profondément", "profond")
+	f("propositions", "proposit")
+	f("prudemment", "prudent")
+	f("publiquement", "publiqu")
+	f("qualités", "qualit")
+	f("rapidement", "rapid")
+	f("rarement", "rar")
+	f("réellement", "réel")
+	f("régulièrement", "réguli")
+	f("relations", "relat")
+	f("remarquablement", "remarqu")
+	f("responsabilités", "respons")
+	f("richesses", "richess")
+	f("rigoureusement", "rigour")
+	f("sagement", "sag")
+	f("sentiments", "sent")
+	f("sérieusement", "sérieux")
+	f("seulement", "seul")
+	f("silencieusement", "silenci")
+	f("simplement", "simpl")
+	f("sincèrement", "sincer")
+	f("sociétés", "societ")
+	f("soigneusement", "soigneux")
+	f("souvenirs", "souvenir")
+	f("spécialement", "spécial")
+	f("subitement", "subit")
+	f("suffisamment", "suffis")
+	f("tellement", "tel")
+	f("terriblement", "terribl")
+	f("totalement", "total")
+	f("tranquillement", "tranquill")
+	f("travaillaient", "travaill")
+	f("universités", "univers")
+	f("utilisation", "utilis")
+	f("véritablement", "vérit")
+	f("victorieuses", "victori")
+	f("violemment", "violent")
+	f("vivement", "viv")
+	f("volontairement", "volontair")
+	f("vraiment", "vrai")
+	f("finissions", "fin")
+	f("finirent", "fin")
+	f("grandissaient", "grand")
+	f("réussissons", "réuss")
+	f("choisirez", "chois")
+	f("bâtissent", "bât")
+	f("rougissait", "roug")
+	f("aimerais", "aim")
+	f("chanteront", "chant")
+	f("parlâmes", "parl")
+	f("regardassent", "regard")
+	f("mangeait", "mang")
+	f("mangeaient", "mang")
+	f("étudiants", "étudi")
+	f("continuèrent", "continu")
+	f("voyageurs", "voyageur")
+	f("croyance", "croyanc")
+	f("ennuyeux", "ennui")
+	f("payer", "pai")
+	f("tuer", "tu")
+	f("jouer", "jou")
+	f("louée", "lou")
+	f("bouillir", "bouill")
+	f("cueillir", "cueil")
+	f("queue", "queu")
+	f("quiconque", "quiconqu")
+	f("acquérir", "acquer")
+	f("aiguë", "aiguë")
+	f("ambiguës", "ambigu")
+	f("essayer", "essai")
+	f("employer", "emploi")
+	f("paysannes", "paysann")
+	f("crayons", "crayon")
+	f("nationalement", "national")
+	f("généralisation", "généralis")
+	f("nationalisations", "nationalis")
+	f("inventivité", "invent")
+	f("sensibilité", "sensibil")
+	f("authenticité", "authent")
+	f("vivacité", "vivac")
+	f("adaptatrice", "adapt")
+	f("identificateurs", "identif")
+	f("passivement", "passiv")
+	f("criminalité", "criminal")
+	f("possibilités", "possibil")
+	f("musicienne", "musicien")
+	f("bonne", "bon")
+	f("bonnes", "bon")
+	f("ancienneté", "anciennet")
+	f("nette", "net")
+	f("personnelles", "personnel")
+}
+
+func TestFrenchStemmer_markVowels(t *testing.T) {
+	s := NewFrenchStemmer()
+
+	f := func(input, expected string) {
+		t.Helper()
+		require.Equal(t, expected, s.markVowels(input))
+	}
+
+	f("jouer", "joUer")
+	f("ennuie", "ennuIe")
+	f("yeux", "Yeux")
+	f("quand", "qUand")
+	f("croyance", "croYance")
+	f("pays", "paYs")
+	f("lui", "lui")
+	f("", "")
+}
+
+func TestFrenchStemmer_regions(t *testing.T) {
+	s := NewFrenchStemmer()
+
+	f := func(word, rv, r1, r2 string) {
+		t.Helper()
+		rvStart, r1Start, r2Start := s.regions(word)
+		require.Equal(t, rv, word[rvStart:])
+		require.Equal(t, r1, word[r1Start:])
+		require.Equal(t, r2, word[r2Start:])
+	}
+
+	f("fameusement", "meusement", "eusement", "ement")
+	f("aimer", "er", "er", "")
+	f("adorer", "rer", "orer", "er")
+	f("voler", "ler", "er", "")
+	f("tapis", "is", "is", "")
+	f("parade", "ade", "ade", "e")
+	f("colet", "et", "et", "")
+}
@@ -0,0 +1,251 @@
+package stemmer
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+var (
+	deStopWords = map[string]struct{}{
+		"aber":  {},
+		"alle":  {},
+		"als":   {},
+		"also":  {},
+		"am":    {},
+		"an":    {},
+		"auch":  {},
+		"auf":   {},
+		"aus":   {},
+		"bei":   {},
+		"bin":   {},
+		"bis":   {},
+		"bist":  {},
+		"da":    {},
+		"damit": {},
+		"dann":  {},
+		"das":   {},
+		"dass":  {},
+		"dein":  {},
+		"dem":   {},
+		"den":   {},
+		"der":   {},
+		"des":   {},
+		"dich":  {},
+		"die":   {},
+		"dir":   {},
+		"doch":  {},
+		"du":    {},
+		"durch": {},
+		"ein":   {},
+		"eine":  {},
+		"einem": {},
+		"einen": {},
+		"einer": {},
+		"eines": {},
+		"er":    {},
+		"es":    {},
+		"euch":  {},
+		"für":   {},
+		"hat":   {},
+		"hatte": {},
+		"ich":   {},
+		"ihm":   {},
+		"ihn":   {},
+		"ihr":   {},
+		"im":    {},
+		"in":    {},
+		"ist":   {},
+		"ja":    {},
+		"kein":  {},
+		"man":   {},
+		"mich":  {},
+		"mir":   {},
+		"mit":   {},
+		"nach":  {},
+		"nicht": {},
+		"noch":  {},
+		"nun":   {},
+		"nur":   {},
+		"ob":    {},
+		"oder":  {},
+		"ohne":  {},
+		"sehr":  {},
+		"sein":  {},
+		"sich":  {},
+		"sie":   {},
+		"sind":  {},
+		"so":    {},
+		"über":  {},
+		"um":    {},
+		"und":   {},
+		"uns":   {},
+		"unter": {},
+		"vom":   {},
+		"von":   {},
+		"vor":   {},
+		"war":   {},
+		"waren": {},
+		"was":   {},
+		"weil":  {},
+		"wenn":  {},
+		"wer":   {},
+		"wie":   {},
+		"wir":   {},
+		"wird":  {},
+		"wo":    {},
+		"zu":    {},
+		"zum":   {},
+		"zur":   {},
+	}
+
+	deStep1Suffixes = []string{"ern", "em", "en", "er", "es", "e", "s"}
+	deStep2Suffixes = []string{"est", "en", "er", "st"}
+	deStep3Suffixes = []string{"isch", "heit", "keit", "lich", "end", "ung", "ig", "ik"}
+
+	deSEnding  = "bdfghklmnrt"
+	deStEnding = "bdfghklmnt"
+
+	// deTranscriptionReplacer reads ae, oe and ue as umlauts, leaving the u
+	// of qu alone.
+	deTranscriptionReplacer = strings.NewReplacer("qu", "qu", "ae", "ä", "oe", "ö", "ue", "ü")
+
+	dePostludeReplacer = strings.NewReplacer("Y", "y", "U", "u", "ä", "a", "ö", "o", "ü", "u")
+)
+
+type GermanStemmer struct {
+	// transcribedUmlauts enables the german2 variant, which reads ae, oe
+	// and ue as ä, ö and ü.
+	transcribedUmlauts bool
+}
+
+// NewGermanStemmer creates a new GermanStemmer.
+func NewGermanStemmer() *GermanStemmer {
+	return &GermanStemmer{}
+}
+
+// NewGerman2Stemmer creates a GermanStemmer for the german2 variant, which
+// also handles umlauts written as ae, oe and ue.
+func NewGerman2Stemmer() *GermanStemmer {
+	return &GermanStemmer{transcribedUmlauts: true}
+}
+
+// Stem returns the stem of the given word.
+func (s GermanStemmer) Stem(word string) string {
+	word = strings.ToLower(word)
+	if s.isStopWord(word) {
+		return word
+	}
+
+	word = strings.ReplaceAll(word, "ß", "ss")
+	word = s.markVowels(word)
+	if s.transcribedUmlauts {
+		word = deTranscriptionReplacer.Replace(word)
+	}
+
+	r1, r2 := s.regions(word)
+
+	word = s.step1(word, r1)
+	word = s.step2(word, r1)
+	word = s.step3(word, r1, r2)
+
+	return dePostludeReplacer.Replace(word)
+}
+
+func (s GermanStemmer) step1(word string, r1 int) string {
+	suffix := longestSuffix(word, deStep1Suffixes)
+	if suffix == "" || !inRegion(word, suffix, r1) {
+		return word
+	}
+	stem := word[:len(word)-len(suffix)]
+
+	switch suffix {
+	case "e", "en", "es":
+		if strings.HasSuffix(stem, "niss") {
+			stem = stem[:len(stem)-1]
+		}
+	case "s":
+		if stem == "" || !strings.ContainsRune(deSEnding, lastRune(stem)) {
+			return word
+		}
+	}
+	return stem
+}
+
+func (s GermanStemmer) step2(word string, r1 int) string {
+	suffix := longestSuffix(word, deStep2Suffixes)
+	if suffix == "" || !inRegion(word, suffix, r1) {
+		return word
+	}
+	stem := word[:len(word)-len(suffix)]
+
+	if suffix == "st" {
+		// The st-ending letter must itself be preceded by at least 3 letters.
+		if utf8.RuneCountInString(stem) < 4 || !strings.ContainsRune(deStEnding, lastRune(stem)) {
+			return word
+		}
+	}
+	return stem
+}
+
+func (s GermanStemmer) step3(word string, r1, r2 int) string {
+	suffix := longestSuffix(word, deStep3Suffixes)
+	if suffix == "" || !inRegion(word, suffix, r2) {
+		return word
+	}
+	stem := word[:len(word)-len(suffix)]
+
+	switch suffix {
+	case "end", "ung":
+		if strings.HasSuffix(stem, "ig") && !strings.HasSuffix(stem, "eig") && inRegion(stem, "ig", r2) {
+			stem = stem[:len(stem)-2]
+		}
+	case "ig", "ik", "isch":
+		if strings.HasSuffix(stem, "e") {
+			return word
+		}
+	case "lich", "heit":
+		if next := longestSuffix(stem, []string{"er", "en"}); next != "" && inRegion(stem, next, r1) {
+			stem = stem[:len(stem)-len(next)]
+		}
+	case "keit":
+		if next := longestSuffix(stem, []string{"lich", "ig"}); next != "" && inRegion(stem, next, r2) {
+			stem = stem[:len(stem)-len(next)]
+		}
+	}
+	return stem
+}
+
+// markVowels upper-cases u and y between vowels, so that they are treated
+// as consonants.
+func (s GermanStemmer) markVowels(word string) string {
+	runes := []rune(word)
+	for i := 1; i < len(runes)-1; i++ {
+		if (runes[i] == 'u' || runes[i] == 'y') && s.isVowel(runes[i-1]) && s.isVowel(runes[i+1]) {
+			runes[i] = unicode.ToUpper(runes[i])
+		}
+	}
+	return string(runes)
+}
+
+// isStopWord returns true if the given word is a stop word.
+func (s GermanStemmer) isStopWord(word string) bool {
+	_, found := deStopWords[word]
+	return found
+}
+
+func (s GermanStemmer) isVowel(r rune) bool {
+	switch r {
+	case 'a', 'e', 'i', 'o', 'u', 'y', 'ä', 'ö', 'ü':
+		return true
+	default:
+		return false
+	}
+}
+
+// regions returns the byte offsets of R1 and R2. R1 is adjusted so that at
+// least 3 letters precede it.
+func (s GermanStemmer) regions(word string) (int, int) {
+	r1, r2 := standardRegions(word, s.isVowel)
+	return atLeastLetters(word, r1, 3), r2
+}
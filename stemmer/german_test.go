@@ -0,0 +1,320 @@
+package stemmer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGermanStemmer(t *testing.T) {
+	s := NewGermanStemmer()
+	require.NotNil(t, s)
+	require.False(t, s.transcribedUmlauts)
+
+	s = NewGerman2Stemmer()
+	require.NotNil(t, s)
+	require.True(t, s.transcribedUmlauts)
+}
+
+func TestGermanStemmer_isStopWord(t *testing.T) {
+	s := NewGermanStemmer()
+	require.True(t, s.isStopWord("und"))
+	require.False(t, s.isStopWord("apfel"))
+}
+
+func TestGermanStemmer_Stem(t *testing.T) {
+	s := NewGermanStemmer()
+	t.Run("stop word", func(t *testing.T) {
+		require.Equal(t, "und", s.Stem("und"))
+		require.Equal(t, "und", s.Stem("Und"))
+	})
+
+	f := func(word, stem string) {
+		t.Helper()
+		require.Equal(t, stem, s.Stem(word))
+	}
+
+	f("abenteuerlich", "abenteu")
+	f("abgeschlossen", "abgeschloss")
+	f("abhängigkeit", "abhang")
+	f("ablehnung", "ablehn")
+	f("abschließend", "abschliess")
+	f("absichtlich", "absicht")
+	f("achtung", "achtung")
+	f("ähnlichkeiten", "ahnlich")
+	f("allgemeinen", "allgemein")
+	f("altertümlich", "altertum")
+	f("amtlichen", "amtlich")
+	f("angelegenheiten", "angeleg")
+	f("angenehm", "angenehm")
+	f("ankündigung", "ankund")
+	f("anstrengend", "anstreng")
+	f("arbeitern", "arbeit")
+	f("arbeitslosigkeit", "arbeitslos")
+	f("ärztlichen", "arztlich")
+	f("aufgaben", "aufgab")
+	f("aufmerksamkeit", "aufmerksam")
+	f("augenblicklich", "augenblick")
+	f("ausbildung", "ausbild")
+	f("ausgezeichnet", "ausgezeichnet")
+	f("äußerst", "ausserst")
+	f("auswirkungen", "auswirk")
+	f("bäckerei", "backerei")
+	f("bedeutung", "bedeut")
+	f("bedingungen", "beding")
+	f("beeindruckend", "beeindruck")
+	f("befreiung", "befreiung")
+	f("begegnungen", "begegn")
+	f("begeisterung", "begeister")
+	f("behandlung", "behandl")
+	f("beherrschung", "beherrsch")
+	f("bekanntschaft", "bekanntschaft")
+	f("beleidigung", "beleid")
+	f("bemerkungen", "bemerk")
+	f("beobachtungen", "beobacht")
+	f("beratungen", "berat")
+	f("bereitschaft", "bereitschaft")
+	f("berühmtesten", "beruhmt")
+	f("beschäftigung", "beschaft")
+	f("beschreibungen", "beschreib")
+	f("besonderheiten", "besond")
+	f("beständigkeit", "bestand")
+	f("bestimmungen", "bestimm")
+	f("betrachtung", "betracht")
+	f("bevölkerung", "bevolker")
+	f("bewegungen", "beweg")
+	f("bezahlung", "bezahl")
+	f("blumen", "blum")
+	f("bräuche", "brauch")
+	f("brüderlich", "brud")
+	f("büchern", "buch")
+	f("dankbarkeit", "dankbar")
+	f("darstellungen", "darstell")
+	f("dauerhaft", "dauerhaft")
+	f("deutlichkeit", "deutlich")
+	f("dichterisch", "dichter")
+	f("dringend", "dringend")
+	f("dunkelheit", "dunkel")
+	f("ehrlichkeit", "ehrlich")
+	f("eigenschaften", "eigenschaft")
+	f("eindeutig", "eindeut")
+	f("einfachheit", "einfach")
+	f("einrichtungen", "einricht")
+	f("empfindlich", "empfind")
+	f("endgültig", "endgult")
+	f("entdeckungen", "entdeck")
+	f("entscheidungen", "entscheid")
+	f("entschlossenheit", "entschloss")
+	f("entwicklung", "entwickl")
+	f("erfahrungen", "erfahr")
+	f("erfolgreich", "erfolgreich")
+	f("ergebnisse", "ergebnis")
+	f("erinnerungen", "erinner")
+	f("erkenntnisse", "erkenntnis")
+	f("erklärungen", "erklar")
+	f("erlaubnis", "erlaubnis")
+	f("ernährung", "ernahr")
+	f("erreichbar", "erreichbar")
+	f("erscheinungen", "erschein")
+	f("erwartungen", "erwart")
+	f("erzählungen", "erzahl")
+	f("fähigkeiten", "fahig")
+	f("fahrzeugen", "fahrzeug")
+	f("feierlich", "feierlich")
+	f("feindlichen", "feindlich")
+	f("festlichkeiten", "festlich")
+	f("flüssigkeit", "flussig")
+	f("forschungen", "forschung")
+	f("freiheit", "freiheit")
+	f("freundlichkeit", "freundlich")
+	f("fröhlich", "frohlich")
+	f("führungen", "fuhrung")
+	f("gärten", "gart")
+	f("gebäuden", "gebaud")
+	f("gefährlich", "gefahr")
+	f("gefühlen", "gefuhl")
+	f("gegenständen", "gegenstand")
+	f("geheimnisse", "geheimnis")
+	f("gelegenheiten", "geleg")
+	f("gemeinschaft", "gemeinschaft")
+	f("genauigkeit", "genau")
+	f("gerechtigkeit", "gerecht")
+	f("geschichten", "geschicht")
+	f("geschwindigkeit", "geschwind")
+	f("gesellschaftlichen", "gesellschaft")
+	f("gesundheit", "gesund")
+	f("gewohnheiten", "gewohn")
+	f("glücklicherweise", "glucklicherweis")
+	f("gründlich", "grundlich")
+	f("häuser", "haus")
+	f("heimlich", "heimlich")
+	f("herrlichkeit", "herrlich")
+	f("hoffnungslos", "hoffnungslos")
+	f("höflichkeit", "hoflich")
+	f("häufigkeit", "haufig")
+	f("jugendlichen", "jugend")
+	f("kämpfen", "kampf")
+	f("kenntnisse", "kenntnis")
+	f("kindheit", "kindheit")
+	f("kirchlichen", "kirchlich")
+	f("kleinigkeiten", "kleinig")
+	f("krankheiten", "krankheit")
+	f("künstlerisch", "kunstler")
+	f("landschaften", "landschaft")
+	f("lebendig", "lebend")
+	f("leidenschaftlich", "leidenschaft")
+	f("lieblingsbücher", "lieblingsbuch")
+	f("lösungen", "losung")
+	f("mädchen", "madch")
+	f("männlichkeit", "mannlich")
+	f("mannschaften", "mannschaft")
+	f("menschlichkeit", "menschlich")
+	f("möglichkeiten", "moglich")
+	f("mündlich", "mundlich")
+	f("nachbarschaft", "nachbarschaft")
+	f("natürlich", "natur")
+	f("notwendigkeit", "notwend")
+	f("öffentlichkeit", "offent")
+	f("ordnungen", "ordnung")
+	f("persönlichkeiten", "person")
+	f("politischen", "polit")
+	f("regierungen", "regier")
+	f("reinigung", "reinig")
+	f("richtigkeit", "richtig")
+	f("rücksichtslos", "rucksichtslos")
+	f("schönheit", "schonheit")
+	f("schwierigkeiten", "schwierig")
+	f("selbstständigkeit", "selbststand")
+	f("sicherheit", "sich")
+	f("sorgfältig", "sorgfalt")
+	f("spannend", "spannend")
+	f("staatlichen", "staatlich")
+	f("städtischen", "stadtisch")
+	f("strömungen", "stromung")
+	f("täglich", "taglich")
+	f("tätigkeiten", "tatig")
+	f("traurigkeit", "traurig")
+	f("übersetzungen", "ubersetz")
+	f("überzeugung", "uberzeug")
+	f("umgebung", "umgeb")
+	f("unabhängigkeit", "unabhang")
+	f("unglücklich", "ungluck")
+	f("unterhaltung", "unterhalt")
+	f("unterschiede", "unterschied")
+	f("veränderungen", "verander")
+	f("verantwortung", "verantwort")
+	f("verbindungen", "verbind")
+	f("vergangenheit", "vergang")
+	f("verhältnisse", "verhaltnis")
+	f("verletzungen", "verletz")
+	f("vermutlich", "vermut")
+	f("versammlungen", "versamml")
+	f("verständlich", "verstand")
+	f("vorbereitungen", "vorbereit")
+	f("vorstellungen", "vorstell")
+	f("wahrscheinlichkeit", "wahrschein")
+	f("wirklichkeit", "wirklich")
+	f("wissenschaftlichen", "wissenschaft")
+	f("wohnungen", "wohnung")
+	f("zeitungen", "zeitung")
+	f("zufriedenheit", "zufried")
+	f("zuverlässigkeit", "zuverlass")
+	f("zusammenarbeit", "zusammenarbeit")
+	f("häuschen", "hausch")
+	f("ergebnissen", "ergebnis")
+	f("kenntnissen", "kenntnis")
+	f("gehst", "gehst")
+	f("sagst", "sagst")
+	f("liebst", "lieb")
+	f("bleibst", "bleib")
+	f("machst", "mach")
+	f("kommst", "komm")
+	f("denkst", "denk")
+	f("spielst", "spiel")
+	f("größten", "grosst")
+	f("fußball", "fussball")
+	f("straße", "strass")
+	f("grüßen", "gruss")
+	f("schließlich", "schliesslich")
+	f("mauer", "mau")
+	f("bauern", "bau")
+	f("treue", "treu")
+	f("neues", "neu")
+	f("freuen", "freu")
+	f("ausbauen", "ausbau")
+	f("quelle", "quell")
+	f("bequem", "bequ")
+	f("aufeinander", "aufeinand")
+	f("kleinste", "klein")
+	f("schönste", "schon")
+}
+
+func TestGerman2Stemmer_Stem(t *testing.T) {
+	s := NewGerman2Stemmer()
+
+	f := func(word, stem string) {
+		t.Helper()
+		require.Equal(t, stem, s.Stem(word))
+	}
+
+	f("haeuser", "haus")
+	f("häuser", "haus")
+	f("muede", "mud")
+	f("gruenden", "grund")
+	f("groeße", "gross")
+	f("uebersetzungen", "ubersetz")
+	f("oeffentlichkeit", "offent")
+	f("aerztlichen", "arztlich")
+	f("maedchen", "madch")
+	f("froehlich", "frohlich")
+	f("bruederlich", "brud")
+	f("natuerlich", "natur")
+	f("moeglichkeiten", "moglich")
+	f("maennlichkeit", "mannlich")
+	f("staedtischen", "stadtisch")
+	f("buechern", "buch")
+
+	// The u of qu and a u between vowels are not part of an umlaut.
+	f("quelle", "quell")
+	f("bequem", "bequ")
+	f("mauer", "mau")
+	f("treue", "treu")
+	f("abenteuer", "abenteu")
+
+	// Genuine vowel sequences are read as umlauts too.
+	f("poesie", "posi")
+	f("aktuell", "aktull")
+}
+
+func TestGermanStemmer_markVowels(t *testing.T) {
+	s := NewGermanStemmer()
+
+	f := func(input, expected string) {
+		t.Helper()
+		require.Equal(t, expected, s.markVowels(input))
+	}
+
+	f("mauer", "maUer")
+	f("bayern", "baYern")
+	f("bauen", "baUen")
+	f("ufer", "ufer")
+	f("typ", "typ")
+	f("", "")
+}
+
+func TestGermanStemmer_regions(t *testing.T) {
+	s := NewGermanStemmer()
+
+	f := func(word, r1, r2 string) {
+		t.Helper()
+		r1Start, r2Start := s.regions(word)
+		require.Equal(t, r1, word[r1Start:])
+		require.Equal(t, r2, word[r2Start:])
+	}
+
+	f("beobachtet", "achtet", "htet")
+	f("aber", "r", "")
+	f("ab", "", "")
+	f("öffentlich", "entlich", "tlich")
+	f("mädchen", "chen", "")
+}
@@ -0,0 +1,633 @@
+package stemmer
+
+import (
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// grStep removes the longest of its suffixes and then, for the stems that
+// match one of its exceptions, puts part of the suffix back.
+type grStep struct {
+	suffixes   []string
+	exceptions []grException
+}
+
+// grException matches a stem that is one of words, ends with one of endings
+// or ends with a letter from vowels, unless the stem ends with one of
+// unless. The first exception that matches appends restore to the stem.
+type grException struct {
+	words   []string
+	endings []string
+	vowels  string
+	unless  []string
+	restore string
+}
+
+var (
+	grStopWords = map[string]struct{}{
+		"αλλα":   {},
+		"απο":    {},
+		"αυτη":   {},
+		"αυτο":   {},
+		"για":    {},
+		"δεν":    {},
+		"ειναι":  {},
+		"ενα":    {},
+		"εωσ":    {},
+		"η":      {},
+		"θα":     {},
+		"και":    {},
+		"με":     {},
+		"μη":     {},
+		"μην":    {},
+		"μια":    {},
+		"να":     {},
+		"ο":      {},
+		"οι":     {},
+		"οπωσ":   {},
+		"οτι":    {},
+		"που":    {},
+		"πωσ":    {},
+		"σε":     {},
+		"στα":    {},
+		"στην":   {},
+		"στισ":   {},
+		"στο":    {},
+		"στουσ":  {},
+		"τα":     {},
+		"την":    {},
+		"τησ":    {},
+		"τισ":    {},
+		"το":     {},
+		"τον":    {},
+		"του":    {},
+		"τουσ":   {},
+		"των":    {},
+		"ωσ":     {},
+		"ωστοσο": {},
+	}
+
+	grAccentReplacer = strings.NewReplacer(
+		"ά", "α", "έ", "ε", "ή", "η", "ί", "ι", "ΐ", "ι", "ϊ", "ι",
+		"ό", "ο", "ύ", "υ", "ΰ", "υ", "ϋ", "υ", "ώ", "ω", "ς", "σ",
+	)
+
+	// grIrregulars are the endings of nouns with an irregular stem, and the
+	// stem each of them is replaced with.
+	grIrregulars = []struct{ ending, stem string }{
+		{"καθεστωτοσ", "καθεστ"},
+		{"καθεστωτων", "καθεστ"},
+		{"καθεστωτα", "καθεστ"},
+		{"γεγονοτοσ", "γεγον"},
+		{"γεγονοτων", "γεγον"},
+		{"καθεστωσ", "καθεστ"},
+		{"γεγονοτα", "γεγον"},
+		{"τατογιου", "τατο"},
+		{"τατογιων", "τατο"},
+		{"ολογιου", "ολο"},
+		{"ολογιων", "ολο"},
+		{"τατογια", "τατο"},
+		{"σκαγιου", "σκα"},
+		{"σκαγιων", "σκα"},
+		{"κρεατοσ", "κρε"},
+		{"κρεατων", "κρε"},
+		{"περατοσ", "περ"},
+		{"περατων", "περ"},
+		{"τερατοσ", "τερ"},
+		{"τερατων", "τερ"},
+		{"γεγονοσ", "γεγον"},
+		{"φαγιου", "φα"},
+		{"φαγιων", "φα"},
+		{"σκαγια", "σκα"},
+		{"ολογια", "ολο"},
+		{"σογιου", "σο"},
+		{"σογιων", "σο"},
+		{"κρεατα", "κρε"},
+		{"περατη", "περ"},
+		{"περατα", "περ"},
+		{"τερατα", "τερ"},
+		{"φωτοσ", "φω"},
+		{"φωτων", "φω"},
+		{"φαγια", "φα"},
+		{"σογια", "σο"},
+		{"κρεασ", "κρε"},
+		{"περασ", "περ"},
+		{"τερασ", "τερ"},
+		{"φωτα", "φω"},
+		{"φωσ", "φω"},
+	}
+
+	grIzaStep = grStep{
+		suffixes: []string{
+			"ιζουμε", "ιζουνε", "ιζαμε", "ιζατε", "ιζανε", "ιζεισ", "ιζετε", "ιζουν", "ιζεσ",
+			"ιζαν", "ιζει", "ιζα", "ιζε", "ιζω",
+		},
+		exceptions: []grException{
+			{words: []string{"αναμπα", "εμπα", "επα", "ξαναπα", "πα", "περιπα", "αθρο", "συναθρο", "δανε"}, restore: "ι"},
+			{words: []string{
+				"μαρκ", "κορν", "αμπαρ", "αρρ", "βαθυρι", "βαρκ", "β", "βολβορ", "γκρ", "γλυκορ",
+				"γλυκυρ", "ιμπ", "λ", "λου", "μαρ", "μ", "πρ", "μπρ", "πολυρ", "π", "ρ", "πιπερορ",
+			}, restore: "ιζ"},
+		},
+	}
+
+	grOthikaStep = grStep{
+		suffixes: []string{"ωθηκαμε", "ωθηκατε", "ωθηκανε", "ωθηκεσ", "ωθηκαν", "ωθηκα", "ωθηκε"},
+		exceptions: []grException{
+			{words: []string{"αλ", "βι", "εν", "υψ", "λι", "ζω", "σ", "χ"}, restore: "ων"},
+		},
+	}
+
+	grIsaStep = grStep{
+		suffixes: []string{"ισαμε", "ισατε", "ισανε", "ισεσ", "ισαν", "ισα", "ισε"},
+		exceptions: []grException{
+			{words: []string{
+				"αναμπα", "αθρο", "εμπα", "εσε", "εσωκλε", "επα", "ξαναπα", "επε", "περιπα", "συναθρο",
+				"δανε", "κλε", "χαρτοπα", "εξαρχα", "μετεπε", "αποκλε", "απεκλε", "εκλε", "πε",
+			}, restore: "ι"},
+			{words: []string{
+				"αν", "αφ", "γε", "γιγαντοαφ", "γκε", "δημοκρατ", "κομ", "γκ", "μ", "π", "πουκαμ",
+				"ολο", "λαρ",
+			}, restore: "ισ"},
+		},
+	}
+
+	grIsoStep = grStep{
+		suffixes: []string{"ισουμε", "ισουνε", "ισεισ", "ισετε", "ισουν", "ισει", "ισω"},
+		exceptions: []grException{
+			{words: []string{
+				"αναμπα", "εμπα", "εσε", "εσωκλε", "επα", "ξαναπα", "επε", "περιπα", "συναθρο", "δανε",
+				"κλε", "χαρτοπα", "εξαρχα", "μετεπε", "αποκλε", "απεκλε", "εκλε", "πε",
+			}, restore: "ι"},
+		},
+	}
+
+	grIstosStep = grStep{
+		suffixes: []string{
+			"ιστουσ", "ιστοσ", "ιστου", "ιστοι", "ιστων", "ιστησ", "ιστεσ", "ιστο", "ιστε", "ιστη",
+			"ιστα",
+		},
+		exceptions: []grException{
+			{words: []string{"δανε", "συναθρο", "κλε", "σε", "εσωκλε", "ασε", "πλε"}, restore: "ι"},
+			{words: []string{
+				"μ", "π", "απ", "αρ", "ηδ", "κτ", "σκ", "σχ", "υψ", "φα", "χρ", "χτ", "ακτ", "αορ",
+				"ασχ", "ατα", "αχν", "αχτ", "γεμ", "γυρ", "εμπ", "ευπ", "εχθ", "ηφα", "καθ", "κακ",
+				"κυλ", "λυγ", "μακ", "μεγ", "ταχ", "φιλ", "χωρ",
+			}, restore: "ιστ"},
+		},
+	}
+
+	grIsmosStep = grStep{
+		suffixes: []string{"ισμουσ", "ισμοι", "ισμοσ", "ισμου", "ισμων", "ισμο"},
+		exceptions: []grException{
+			{words: []string{"σε", "μετασε", "μικροσε", "εγκλε", "αποκλε"}, restore: "ισμ"},
+			{words: []string{"δανε", "αντιδανε"}, restore: "ι"},
+		},
+	}
+
+	// grIsmosAdjectives are adjective stems that lose their final ικ or ιν
+	// before -ισμός.
+	grIsmosAdjectives = []string{
+		"αγνωστικ", "ατομικ", "γνωστικ", "εθνικ", "εκλεκτικ", "σκεπτικ", "τοπικ",
+		"αλεξανδριν", "βυζαντιν", "θεατριν",
+	}
+
+	grArakiStep = grStep{
+		suffixes: []string{"ουδακια", "αρακια", "ουδακι", "αρακι"},
+		exceptions: []grException{
+			{words: []string{"σ", "χ"}, restore: "αρακ"},
+		},
+	}
+
+	grAkiStep = grStep{
+		suffixes: []string{"αρακια", "ιτσασ", "ιτσεσ", "ιτσων", "αρακι", "ακια", "ιτσα", "ακι"},
+		exceptions: []grException{
+			{words: []string{
+				"βαμβ", "βρ", "καιμ", "κον", "κορ", "λαβρ", "λουλ", "μερ", "μουστ", "ναγκασ", "πλ",
+				"ρ", "ρυ", "σ", "σκ", "σοκ", "σπαν", "τζ", "φαρμ", "χ", "καπακ", "αλισφ", "αμβρ",
+				"ανθρ", "κ", "φυλ", "κατραπ", "κλιμ", "μαλ", "σλοβ", "φ", "σφ", "τσεχοσλοβ",
+			}, restore: "ακ"},
+			{words: []string{
+				"β", "βαλ", "γιαν", "γλ", "ζ", "ηγουμεν", "καρδ", "κον", "μακρυν", "νυφ", "πατερ",
+				"π", "σκ", "τοσ", "τριπολ",
+			}, restore: "ιτσ"},
+			{endings: []string{"κορ"}, restore: "ιτσ"},
+		},
+	}
+
+	grIdioStep = grStep{
+		suffixes: []string{"ιδιων", "ιδιο", "ιδια"},
+		exceptions: []grException{
+			{words: []string{"αιφν", "ιρ", "ολο", "ψαλ"}, restore: "ιδ"},
+			{endings: []string{"ε", "παιχν"}, restore: "ιδ"},
+		},
+	}
+
+	grIskosStep = grStep{
+		suffixes: []string{"ισκοσ", "ισκου", "ισκο", "ισκε"},
+		exceptions: []grException{
+			{words: []string{"δ", "ιβ", "μην", "ρ", "φραγκ", "λυκ", "οβελ"}, restore: "ισκ"},
+		},
+	}
+
+	// grAdesStems are the nouns whose plural in -αδες keeps no αδ.
+	grAdesStems = []string{"οκ", "μαμ", "μαν", "μπαμπ", "πατερ", "γιαγι", "νταντ", "κυρ", "θει", "πεθερ"}
+
+	grEdesStep = grStep{
+		suffixes: []string{"εδεσ", "εδων"},
+		exceptions: []grException{
+			{endings: []string{"οπ", "ιπ", "εμπ", "υπ", "γηπ", "δαπ", "κρασπ", "μιλ"}, restore: "εδ"},
+		},
+	}
+
+	grOudesStep = grStep{
+		suffixes: []string{"ουδεσ", "ουδων"},
+		exceptions: []grException{
+			{endings: []string{
+				"αρκ", "καλιακ", "πεταλ", "λιχ", "πλεξ", "σκ", "σ", "φλ", "φρ", "βελ", "λουλ", "χν",
+				"σπ", "τραγ", "φε",
+			}, restore: "ουδ"},
+		},
+	}
+
+	grEosStep = grStep{
+		suffixes: []string{"εωσ", "εων"},
+		exceptions: []grException{
+			{words: []string{"θ", "δ", "ελ", "γαλ", "ν", "π", "ιδ", "παρ"}, restore: "ε"},
+		},
+	}
+
+	grIaStep = grStep{
+		suffixes: []string{"ιου", "ιων", "ια"},
+		exceptions: []grException{
+			{vowels: "αεηιουω", restore: "ι"},
+		},
+	}
+
+	grIkosStep = grStep{
+		suffixes: []string{"ικου", "ικων", "ικα", "ικο"},
+		exceptions: []grException{
+			{vowels: "αεηιουω", restore: "ικ"},
+			{words: []string{
+				"αλ", "αδ", "ενδ", "αμαν", "αμμοχαλ", "ηθ", "ανηθ", "αντιδ", "φυσ", "βρωμ", "γερ",
+				"εξωδ", "καλπ", "καλλιν", "καταδ", "μουλ", "μπαν", "μπαγιατ", "μπολ", "μποσ", "νιτ",
+				"ξικ", "συνομηλ", "πετσ", "πιτσ", "πικαντ", "πλιατσ", "ποστελν", "πρωτοδ", "σερτ",
+				"συναδ", "τσαμ", "υποδ", "φιλον", "φυλοδ", "χασ",
+			}, restore: "ικ"},
+		},
+	}
+
+	grAgameStep = grStep{suffixes: []string{"ηθηκαμε", "ουσαμε", "αγαμε", "ησαμε", "ηκαμε"}}
+
+	grAmeStep = grStep{
+		suffixes: []string{"αμε"},
+		exceptions: []grException{
+			{words: []string{
+				"αναπ", "αποθ", "αποκ", "αποστ", "βουβ", "ξεθ", "ουλ", "πεθ", "πικρ", "ποτ", "σιχ", "χ",
+			}, restore: "αμ"},
+		},
+	}
+
+	grAganeStep = grStep{
+		suffixes: []string{
+			"ιουντανε", "ιοντανε", "ουντανε", "ηθηκανε", "ιοτανε", "οντανε", "ουσανε", "αγανε",
+			"ησανε", "οτανε", "ηκανε",
+		},
+		exceptions: []grException{
+			{words: []string{"τρ", "τσ"}, restore: "αγαν"},
+		},
+	}
+
+	grAneStep = grStep{
+		suffixes: []string{"ανε"},
+		exceptions: []grException{
+			{vowels: "αεηιοω", restore: "αν"},
+			{words: []string{
+				"βετερ", "βουλκ", "βραχμ", "γ", "δραδουμ", "θ", "καλπουζ", "καστελ", "κορμορ",
+				"λαοπλ", "μωαμεθ", "μ", "μουσουλμ", "ν", "ουλ", "π", "πελεκ", "πλ", "πολισ",
+				"πορτολ", "σαρακατσ", "σουλτ", "τσαρλατ", "ορφ", "τσιγγ", "τσοπ", "φωτοστεφ", "χ",
+				"ψυχοπλ", "αγ", "γαλ", "γερ", "δεκ", "διπλ", "αμερικαν", "ουρ", "πιθ", "πουριτ",
+				"σ", "ζωντ", "ικ", "καστ", "κοπ", "λιχ", "λουθηρ", "μαιντ", "μελ", "σιγ", "σπ",
+				"στεγ", "τραγ", "τσαγ", "φ", "ερ", "αδαπ", "αθιγγ", "αμηχ", "ανικ", "ανοργ", "απηγ",
+				"απιθ", "ατσιγγ", "βασ", "βασκ", "βαθυγαλ", "βιομηχ", "βραχυκ", "διατ", "διαφ",
+				"ενοργ", "θυσ", "καπνοβιομηχ", "καταγαλ", "κλιβ", "κοιλαρφ", "λιβ", "μεγλοβιομηχ",
+				"μικροβιομηχ", "νταβ", "ξηροκλιβ", "ολιγοδαμ", "ολογαλ", "πενταρφ", "περηφ",
+				"περιτρ", "πλατ", "πολυδαπ", "πολυμηχ", "στεφ", "ταβ", "τετ", "υπερηφ", "υποκοπ",
+				"χαμηλοδαπ", "ψηλοταβ",
+			}, restore: "αν"},
+		},
+	}
+
+	grIseteStep = grStep{suffixes: []string{"ησετε"}}
+
+	grEteStep = grStep{
+		suffixes: []string{"ετε"},
+		exceptions: []grException{
+			{vowels: "αεηιοω", restore: "ετ"},
+			{endings: []string{
+				"οδ", "αιρ", "φορ", "ταθ", "διαθ", "σχ", "ενδ", "ευρ", "τιθ", "υπερθ", "ραθ", "ενθ",
+				"ροθ", "σθ", "πυρ", "αιν", "συνδ", "συν", "συνθ", "χωρ", "πον", "βρ", "καθ", "ευθ",
+				"εκθ", "νετ", "ρον", "αρκ", "βαρ", "βολ", "ωφελ",
+			}, restore: "ετ"},
+			{words: []string{
+				"αβαρ", "βεν", "εναρ", "αβρ", "αδ", "αθ", "αν", "απλ", "βαρον", "ντρ", "σκ", "κοπ",
+				"μπορ", "νιφ", "παγ", "παρακαλ", "σερπ", "σκελ", "συρφ", "τοκ", "υ", "δ", "εμ",
+				"θαρρ", "θ",
+			}, restore: "ετ"},
+		},
+	}
+
+	grOntasStep = grStep{
+		suffixes: []string{"οντασ", "ωντασ"},
+		exceptions: []grException{
+			{words: []string{"αρχ"}, restore: "οντ"},
+			{endings: []string{"κρε"}, restore: "ωντ"},
+		},
+	}
+
+	grOmasteStep = grStep{
+		suffixes: []string{"ιομαστε", "ομαστε"},
+		exceptions: []grException{
+			{words: []string{"ον"}, restore: "ομαστ"},
+		},
+	}
+
+	grIesteStep = grStep{
+		suffixes: []string{"ιεστε"},
+		exceptions: []grException{
+			{words: []string{"π", "απ", "συμπ", "ασυμπ", "ακαταπ", "αμεταμφ"}, restore: "ιεστ"},
+		},
+	}
+
+	grEsteStep = grStep{
+		suffixes: []string{"εστε"},
+		exceptions: []grException{
+			{words: []string{"αλ", "αρ", "εκτελ", "ζ", "μ", "ξ", "παρακαλ", "προ", "νισ"}, restore: "εστ"},
+		},
+	}
+
+	grIthikaStep = grStep{suffixes: []string{"ηθηκεσ", "ηθηκα", "ηθηκε"}}
+
+	grIkaStep = grStep{
+		suffixes: []string{"ηκεσ", "ηκα", "ηκε"},
+		exceptions: []grException{
+			{endings: []string{"σκωλ", "σκουλ", "ναρθ", "σφ", "οθ", "πιθ"}, restore: "ηκ"},
+			{words: []string{"διαθ", "θ", "παρακαταθ", "προσθ", "συνθ"}, restore: "ηκ"},
+		},
+	}
+
+	grOusaStep = grStep{
+		suffixes: []string{"ουσεσ", "ουσα", "ουσε"},
+		exceptions: []grException{
+			{endings: []string{
+				"ποδαρ", "βλεπ", "πανταχ", "φρυδ", "μαντιλ", "μαλλ", "κυματ", "λαχ", "ληγ", "φαγ",
+				"ομ", "πρωτ",
+			}, restore: "ουσ"},
+			{words: []string{
+				"φαρμακ", "χαδ", "αγκ", "αναρρ", "βρομ", "εκλιπ", "λαμπιδ", "λεχ", "μ", "πατ", "ρ",
+				"λ", "μεδ", "μεσαζ", "υποτειν", "αμ", "αιθ", "ανηκ", "δεσποζ", "ενδιαφερ", "δε",
+				"δευτερευ", "καθαρευ", "πλε", "τσα",
+			}, restore: "ουσ"},
+		},
+	}
+
+	grAgaStep = grStep{
+		suffixes: []string{"αγεσ", "αγα", "αγε"},
+		exceptions: []grException{
+			{endings: []string{"κολλ"}, restore: "αγ"},
+			{
+				endings: []string{"οφ", "πελ", "χορτ", "λλ", "σφ", "ρπ", "φρ", "πρ", "λοχ", "σμην"},
+				unless:  []string{"ψοφ", "ναυλοχ"},
+				restore: "αγ",
+			},
+			{
+				words: []string{
+					"αβαστ", "πολυφ", "αδηφ", "παμφ", "ρ", "ασπ", "αφ", "αμαλ", "αμαλλι", "ανυστ",
+					"απερ", "ασπαρ", "αχαρ", "δερβεν", "δροσοπ", "ξεφ", "νεοπ", "νομοτ", "ολοπ",
+					"ομοτ", "προστ", "προσωποπ", "συμπ", "συντ", "τ", "υποτ", "χαρ", "αειπ", "αιμοστ",
+					"ανυπ", "αποτ", "αρτιπ", "διατ", "εν", "επιτ", "κροκαλοπ", "σιδηροπ", "λ", "ναυ",
+					"ουλαμ", "ουρ", "π", "τρ", "μ",
+				},
+				unless:  []string{"ψοφ", "ναυλοχ"},
+				restore: "αγ",
+			},
+		},
+	}
+
+	grIseStep = grStep{
+		suffixes: []string{"ησου", "ησε", "ησα"},
+		exceptions: []grException{
+			{words: []string{"ν", "χερσον", "δωδεκαν", "ερημον", "μεγαλον", "επταν"}, restore: "ησ"},
+		},
+	}
+
+	grAsteStep = grStep{
+		suffixes: []string{"αστε"},
+		exceptions: []grException{
+			{words: []string{
+				"ασβ", "σβ", "αχρ", "χρ", "απλ", "αειμν", "δυσχρ", "ευχρ", "κοινοχρ", "παλιμψ",
+			}, restore: "αστ"},
+		},
+	}
+
+	grOuneStep = grStep{
+		suffixes: []string{"ησουνε", "ηθουνε", "ουνε"},
+		exceptions: []grException{
+			{words: []string{"ν", "ρ", "σπι", "στραβομουτσ", "κακομουτσ", "εξων"}, restore: "ουν"},
+		},
+	}
+
+	grOumeStep = grStep{
+		suffixes: []string{"ησουμε", "ηθουμε", "ουμε"},
+		exceptions: []grException{
+			{words: []string{"παρασουσ", "φ", "χ", "ωριοπλ", "αζ", "αλλοσουσ", "ασουσ"}, restore: "ουμ"},
+		},
+	}
+
+	grMataSuffixes = []string{"ματων", "ματοσ", "ματα"}
+
+	grInflectionSuffixes = []string{
+		"ιοντουσαν", "ιομασταν", "ιοσασταν", "ιουμαστε", "οντουσαν", "ιεμαστε", "ιεσαστε",
+		"ιομουνα", "ιοσαστε", "ιοσουνα", "ιουνται", "ιουνταν", "ηθηκατε", "ομασταν", "οσασταν",
+		"ουμαστε", "ιομουν", "ιονταν", "ιοσουν", "ηθειτε", "ηθηκαν", "ομουνα", "οσαστε",
+		"οσουνα", "ουνται", "ουνταν", "ουσατε", "αγατε", "ιεμαι", "ιεται", "ιεσαι", "ιοταν",
+		"ιουμα", "ηθεισ", "ηθουν", "ηκατε", "ησατε", "ησουν", "ομουν", "ονται", "ονταν",
+		"οσουν", "ουμαι", "ουσαν", "αγαν", "αμαι", "ασαι", "αται", "ειτε", "εσαι", "εται",
+		"ηδεσ", "ηδων", "ηθει", "ηκαν", "ησαν", "ησει", "ησεσ", "ομαι", "οταν", "αει", "εισ",
+		"ηθω", "ησω", "ουν", "ουσ", "αν", "ασ", "αω", "ει", "εσ", "ησ", "οι", "οσ", "ου", "υσ",
+		"ων", "α", "ε", "ι", "η", "ο", "υ", "ω",
+	}
+
+	grComparativeSuffixes = []string{"εστερ", "εστατ", "οτερ", "οτατ", "υτερ", "υτατ", "ωτερ", "ωτατ"}
+)
+
+// GreekStemmer implements the Snowball Greek stemmer, which is based on the
+// algorithm of Ntais. Words are stemmed without tonos or dialytika and with
+// a final ς written as σ.
+type GreekStemmer struct{}
+
+// NewGreekStemmer creates a new GreekStemmer.
+func NewGreekStemmer() *GreekStemmer {
+	return &GreekStemmer{}
+}
+
+// Stem returns the stem of the given word.
+func (s GreekStemmer) Stem(word string) string {
+	word = grAccentReplacer.Replace(strings.ToLower(word))
+	if s.isStopWord(word) {
+		return word
+	}
+	if utf8.RuneCountInString(word) < 3 {
+		return word
+	}
+
+	// inflected is cleared by every step that removes a derivational suffix;
+	// the inflectional ending is only removed from words none of them took.
+	inflected := true
+	run := func(steps ...func(string) (string, bool)) {
+		for _, step := range steps {
+			var removed bool
+			if word, removed = step(word); removed {
+				inflected = false
+			}
+		}
+	}
+
+	run(s.irregular, grIzaStep.apply, grOthikaStep.apply, s.isa, grIsoStep.apply,
+		grIstosStep.apply, s.ismos, grArakiStep.apply, grAkiStep.apply, grIdioStep.apply,
+		grIskosStep.apply)
+
+	word = s.ades(word)
+	word, _ = grEdesStep.apply(word)
+	word, _ = grOudesStep.apply(word)
+
+	run(grEosStep.apply, grIaStep.apply, grIkosStep.apply, s.ame, grAganeStep.apply,
+		grAneStep.apply, grIseteStep.apply, grEteStep.apply, grOntasStep.apply,
+		grOmasteStep.apply, grIesteStep.apply, grEsteStep.apply, grIthikaStep.apply,
+		grIkaStep.apply, grOusaStep.apply, grIseStep.apply, grAgaStep.apply,
+		grAsteStep.apply, grOuneStep.apply, grOumeStep.apply)
+
+	if suffix := longestSuffix(word, grMataSuffixes); suffix != "" {
+		word = word[:len(word)-len(suffix)] + "μα"
+	}
+	if inflected {
+		word = s.trim(word, grInflectionSuffixes)
+	}
+	return s.trim(word, grComparativeSuffixes)
+}
+
+// irregular replaces the ending of a noun with an irregular stem.
+func (s GreekStemmer) irregular(word string) (string, bool) {
+	for _, irregular := range grIrregulars {
+		if strings.HasSuffix(word, irregular.ending) {
+			return word[:len(word)-len(irregular.ending)] + irregular.stem, true
+		}
+	}
+	return word, false
+}
+
+// isa removes the -ισα aorist endings. The word ισα itself keeps ισ.
+func (s GreekStemmer) isa(word string) (string, bool) {
+	if word == "ισα" {
+		return "ισ", true
+	}
+	return grIsaStep.apply(word)
+}
+
+// ismos removes the -ισμός endings, and the ικ or ιν of a few adjectives
+// before them.
+func (s GreekStemmer) ismos(word string) (string, bool) {
+	suffix := longestSuffix(word, grIsmosStep.suffixes)
+	if suffix == "" {
+		return word, false
+	}
+	stem := word[:len(word)-len(suffix)]
+	if restored, ok := grIsmosStep.restore(stem); ok {
+		return restored, true
+	}
+	if longestSuffix(stem, grIsmosAdjectives) != "" {
+		// Both ικ and ιν are two letters long.
+		return stem[:len(stem)-len("ικ")], true
+	}
+	return stem, true
+}
+
+// ame removes the -αμε verb endings. The verb αγαμε only loses its final ε.
+func (s GreekStemmer) ame(word string) (string, bool) {
+	if word == "αγαμε" {
+		return "αγαμ", false
+	}
+	word, removed := grAgameStep.apply(word)
+	word, removedAme := grAmeStep.apply(word)
+	return word, removed || removedAme
+}
+
+// ades removes the -αδες plural endings, keeping αδ for all but a few nouns.
+func (s GreekStemmer) ades(word string) string {
+	suffix := longestSuffix(word, []string{"αδεσ", "αδων"})
+	if suffix == "" {
+		return word
+	}
+	stem := word[:len(word)-len(suffix)]
+	for _, ending := range grAdesStems {
+		if strings.HasSuffix(stem, ending) {
+			return stem
+		}
+	}
+	return stem + "αδ"
+}
+
+// trim removes the longest of suffixes from word.
+func (s GreekStemmer) trim(word string, suffixes []string) string {
+	return word[:len(word)-len(longestSuffix(word, suffixes))]
+}
+
+// apply removes the longest suffix of the step from word and restores part
+// of it for the first exception the stem matches. It reports whether a
+// suffix was removed.
+func (st grStep) apply(word string) (string, bool) {
+	suffix := longestSuffix(word, st.suffixes)
+	if suffix == "" {
+		return word, false
+	}
+	stem := word[:len(word)-len(suffix)]
+	if restored, ok := st.restore(stem); ok {
+		return restored, true
+	}
+	return stem, true
+}
+
+// restore appends the restore part of the first exception that stem
+// matches, and reports whether there was one.
+func (st grStep) restore(stem string) (string, bool) {
+	for _, e := range st.exceptions {
+		if e.matches(stem) {
+			return stem + e.restore, true
+		}
+	}
+	return stem, false
+}
+
+func (e grException) matches(stem string) bool {
+	for _, ending := range e.unless {
+		if strings.HasSuffix(stem, ending) {
+			return false
+		}
+	}
+	if slices.Contains(e.words, stem) {
+		return true
+	}
+	for _, ending := range e.endings {
+		if strings.HasSuffix(stem, ending) {
+			return true
+		}
+	}
+	return stem != "" && strings.ContainsRune(e.vowels, lastRune(stem))
+}
+
+// isStopWord returns true if the given word is a stop word.
+func (s GreekStemmer) isStopWord(word string) bool {
+	_, found := grStopWords[word]
+	return found
+}
@@ -0,0 +1,250 @@
+package stemmer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGreekStemmer(t *testing.T) {
+	s := NewGreekStemmer()
+	require.NotNil(t, s)
+}
+
+func TestGreekStemmer_isStopWord(t *testing.T) {
+	s := NewGreekStemmer()
+	require.True(t, s.isStopWord("και"))
+	require.False(t, s.isStopWord("λογοσ"))
+}
+
+func TestGreekStemmer_Stem(t *testing.T) {
+	s := NewGreekStemmer()
+	t.Run("stop word", func(t *testing.T) {
+		require.Equal(t, "και", s.Stem("και"))
+		require.Equal(t, "απο", s.Stem("Από"))
+	})
+
+	t.Run("normalization", func(t *testing.T) {
+		require.Equal(t, "νυχτ", s.Stem("ΝΎΧΤΑ"))
+		require.Equal(t, "προιον", s.Stem("ΠΡΟΪΌΝ"))
+		require.Equal(t, "λογ", s.Stem("ΛΌΓΟΣ"))
+		require.Equal(t, "λογ", s.Stem("λόγοσ"))
+	})
+
+	f := func(word, stem string) {
+		t.Helper()
+		require.Equal(t, stem, s.Stem(word))
+	}
+
+	f("άνθρωπος", "ανθρωπ")
+	f("ανθρώπου", "ανθρωπ")
+	f("άνθρωποι", "ανθρωπ")
+	f("ανθρώπων", "ανθρωπ")
+	f("ανθρώπους", "ανθρωπ")
+	f("ΑΝΘΡΩΠΟΣ", "ανθρωπ")
+	f("λόγος", "λογ")
+	f("λόγου", "λογ")
+	f("λόγοι", "λογ")
+	f("καλός", "καλ")
+	f("καλή", "καλ")
+	f("καλό", "καλ")
+	f("καλύτερος", "καλ")
+	f("καλύτερη", "καλ")
+	f("μεγαλύτερος", "μεγαλ")
+	f("ωραιότατος", "ωραι")
+	f("σπίτι", "σπιτ")
+	f("σπιτιού", "σπιτ")
+	f("σπίτια", "σπιτ")
+	f("θάλασσα", "θαλασσ")
+	f("θάλασσες", "θαλασσ")
+	f("θαλασσών", "θαλασσ")
+	f("παιδί", "παιδ")
+	f("παιδιά", "πα")
+	f("παιδιών", "πα")
+	f("γράφω", "γραφ")
+	f("γράφεις", "γραφ")
+	f("γράφει", "γραφ")
+	f("γράφουμε", "γραφ")
+	f("γράφετε", "γραφ")
+	f("γράφουν", "γραφ")
+	f("έγραψα", "εγραψ")
+	f("έγραψες", "εγραψ")
+	f("γράψαμε", "γραψ")
+	f("γράφοντας", "γραφ")
+	f("αγαπώ", "αγαπ")
+	f("αγαπάμε", "αγαπ")
+	f("αγαπάτε", "αγαπατ")
+	f("αγάπησα", "αγαπ")
+	f("αγαπήσαμε", "αγαπ")
+	f("αγαπήθηκα", "αγαπ")
+	f("αγαπήθηκε", "αγαπ")
+	f("αγαπημένος", "αγαπημεν")
+	f("δουλεύω", "δουλευ")
+	f("δουλειά", "δουλει")
+	f("δουλειές", "δουλει")
+	f("ελληνικός", "ελληνικ")
+	f("ελληνική", "ελληνικ")
+	f("ελληνικά", "ελλην")
+	f("ελληνικού", "ελλην")
+	f("ελληνικών", "ελλην")
+	f("πολιτικός", "πολιτικ")
+	f("πολιτική", "πολιτικ")
+	f("πολιτικής", "πολιτικ")
+	f("πολιτικών", "πολιτ")
+	f("κράτος", "κρατ")
+	f("κράτους", "κρατ")
+	f("κρατών", "κρατ")
+	f("κρέας", "κρε")
+	f("κρέατος", "κρε")
+	f("κρέατα", "κρε")
+	f("φως", "φω")
+	f("φωτός", "φω")
+	f("φώτα", "φω")
+	f("γεγονός", "γεγον")
+	f("γεγονότος", "γεγον")
+	f("γεγονότα", "γεγον")
+	f("καθεστώς", "καθεστ")
+	f("καθεστώτος", "καθεστ")
+	f("μάθημα", "μαθημ")
+	f("μαθήματα", "μαθημ")
+	f("μαθημάτων", "μαθημ")
+	f("μαθήματος", "μαθημ")
+	f("πρόβλημα", "προβλημ")
+	f("προβλήματα", "προβλημ")
+	f("προβλημάτων", "προβλημ")
+	f("σύστημα", "συστημ")
+	f("συστήματα", "συστημ")
+	f("εθνικισμός", "εθν")
+	f("εθνικισμού", "εθν")
+	f("ρεαλισμός", "ρεαλ")
+	f("τουρισμός", "τουρ")
+	f("τουρισμού", "τουρ")
+	f("καπιταλιστής", "καπιταλ")
+	f("ρεαλιστής", "ρεαλ")
+	f("ρεαλιστές", "ρεαλ")
+	f("βιβλίο", "βιβλι")
+	f("βιβλία", "βιβλ")
+	f("βιβλίων", "βιβλ")
+	f("βιβλιοθήκη", "βιβλιοθηκ")
+	f("βιβλιοθήκες", "βιβλιοθηκ")
+	f("πόλη", "πολ")
+	f("πόλεις", "πολ")
+	f("πόλεων", "πολ")
+	f("πόλεως", "πολ")
+	f("γυναίκα", "γυναικ")
+	f("γυναίκες", "γυναικ")
+	f("γυναικών", "γυναικ")
+	f("μητέρα", "μητερ")
+	f("πατέρας", "πατερ")
+	f("πατέρες", "πατερ")
+	f("πατεράδες", "πατερ")
+	f("μαμάδες", "μαμ")
+	f("ψαράδες", "ψαραδ")
+	f("ψαράδων", "ψαραδ")
+	f("καφέδες", "καφ")
+	f("παππούδες", "παππ")
+	f("αλεπούδες", "αλεπ")
+	f("σκαμπό", "σκαμπ")
+	f("σκαμπουδάκι", "σκαμπ")
+	f("σπιτάκι", "σπιτ")
+	f("σπιτάκια", "σπιτ")
+	f("κοριτσάκι", "κοριτσ")
+	f("κοπέλα", "κοπελ")
+	f("κοπελίτσα", "κοπελ")
+	f("κοπελίτσες", "κοπελ")
+	f("κορίτσι", "κοριτσ")
+	f("κορίτσια", "κοριτσ")
+	f("εξετάζω", "εξεταζ")
+	f("εξετάζουμε", "εξεταζ")
+	f("εξετάσεις", "εξετασ")
+	f("οργανώνω", "οργανων")
+	f("οργανώθηκα", "οργαν")
+	f("οργανώθηκε", "οργαν")
+	f("οργάνωση", "οργανωσ")
+	f("οργανώσεις", "οργανωσ")
+	f("τραγουδάω", "τραγουδ")
+	f("τραγούδι", "τραγουδ")
+	f("τραγούδια", "τραγουδ")
+	f("τραγουδιστής", "τραγουδ")
+	f("τραγουδίστρια", "τραγουδιστρ")
+	f("ταξιδεύω", "ταξιδευ")
+	f("ταξίδι", "ταξιδ")
+	f("ταξίδια", "ταξ")
+	f("ταξιδιού", "ταξιδ")
+	f("πηγαίνω", "πηγαιν")
+	f("πήγαμε", "πηγ")
+	f("πήγανε", "πηγ")
+	f("φύγαμε", "φυγ")
+	f("έφυγαν", "εφυγ")
+	f("ήρθανε", "ηρθ")
+	f("έφαγα", "εφ")
+	f("φάγαμε", "φ")
+	f("τρώγαμε", "τρωγ")
+	f("λέγοντας", "λεγ")
+	f("λέγαμε", "λεγ")
+	f("είπαμε", "ειπ")
+	f("είπατε", "ειπατ")
+	f("κάνετε", "καν")
+	f("κάνατε", "κανατ")
+	f("έχετε", "εχ")
+	f("είχατε", "ειχατ")
+	f("μιλάμε", "μιλ")
+	f("μιλήσαμε", "μιλ")
+	f("μιλούσα", "μιλ")
+	f("μιλούσες", "μιλ")
+	f("μιλούσε", "μιλ")
+	f("τρέχουμε", "τρεχ")
+	f("τρέχουνε", "τρεχ")
+	f("θέλουνε", "θελ")
+	f("θέλουμε", "θελ")
+	f("ήμαστε", "ημ")
+	f("είμαστε", "ειμ")
+	f("έρχομαστε", "ερχ")
+	f("ερχόμαστε", "ερχ")
+	f("κάθεστε", "καθ")
+	f("πιστεύετε", "πιστευ")
+	f("ζωντανός", "ζωνταν")
+	f("ζωντανή", "ζωνταν")
+	f("Ελλάδα", "ελλαδ")
+	f("Ελλάδας", "ελλαδ")
+	f("ΕΛΛΗΝΙΚΗ", "ελληνικ")
+	f("ΑΘΗΝΑ", "αθην")
+	f("Αθήνα", "αθην")
+	f("Αθηνών", "αθην")
+	f("ΠΡΟΪΌΝ", "προιον")
+	f("προϊόν", "προιον")
+	f("προϊόντα", "προιοντ")
+	f("προϊόντος", "προιοντ")
+	f("υποψήφιος", "υποψηφι")
+	f("υποψηφίων", "υποψηφ")
+	f("δημοκρατία", "δημοκρατ")
+	f("δημοκρατίας", "δημοκρατι")
+	f("δημοκρατικός", "δημοκρατικ")
+	f("οικονομία", "οικονομ")
+	f("οικονομίας", "οικονομι")
+	f("οικονομικός", "οικονομικ")
+	f("οικονομικά", "οικονομ")
+	f("ανάπτυξη", "αναπτυξ")
+	f("αναπτύξεως", "αναπτυξ")
+	f("ευρωπαϊκός", "ευρωπαικ")
+	f("ευρωπαϊκή", "ευρωπαικ")
+	f("ευρωπαϊκής", "ευρωπαικ")
+}
+
+func TestGreekStep_apply(t *testing.T) {
+	stem, removed := grIzaStep.apply("μαρκιζω")
+	require.True(t, removed)
+	require.Equal(t, "μαρκιζ", stem)
+
+	stem, removed = grIzaStep.apply("καθαριζω")
+	require.True(t, removed)
+	require.Equal(t, "καθαρ", stem)
+
+	stem, removed = grIaStep.apply("ρολοια")
+	require.True(t, removed)
+	require.Equal(t, "ρολοι", stem)
+
+	stem, removed = grIzaStep.apply("λογοσ")
+	require.False(t, removed)
+	require.Equal(t, "λογοσ", stem)
+}
@@ -0,0 +1,95 @@
+package stemmer
+
+import "strings"
+
+var (
+	hiStopWords = map[string]struct{}{
+		"अपनी":  {},
+		"अपने":  {},
+		"आप":    {},
+		"इस":    {},
+		"उस":    {},
+		"एक":    {},
+		"और":    {},
+		"कर":    {},
+		"का":    {},
+		"कि":    {},
+		"किसी":  {},
+		"की":    {},
+		"कुछ":   {},
+		"के":    {},
+		"को":    {},
+		"कोई":   {},
+		"जब":    {},
+		"जो":    {},
+		"तक":    {},
+		"तब":    {},
+		"तो":    {},
+		"था":    {},
+		"थी":    {},
+		"थे":    {},
+		"नहीं":  {},
+		"ने":    {},
+		"पर":    {},
+		"भी":    {},
+		"में":   {},
+		"मैं":   {},
+		"यदि":   {},
+		"यह":    {},
+		"या":    {},
+		"ये":    {},
+		"लिए":   {},
+		"लेकिन": {},
+		"वह":    {},
+		"वे":    {},
+		"वो":    {},
+		"सभी":   {},
+		"साथ":   {},
+		"से":    {},
+		"हम":    {},
+		"ही":    {},
+		"है":    {},
+		"हैं":   {},
+		"हो":    {},
+	}
+
+	// hiSuffixes are the inflectional suffixes of the light stemmer, written
+	// both with vowel signs and with independent vowels.
+	hiSuffixes = []string{
+		"ाइयाँ", "ाइयां", "ाइयों", "ाऊंगा", "ाऊंगी", "ाएंगी", "ाएंगे", "इयाँ",
+		"इयां", "इयों", "ऊंगा", "ऊंगी", "एंगी", "एंगे", "ताएं", "ताओं", "नाएं",
+		"नाओं", "ाएगा", "ाएगी", "ाओगी", "ाओगे", "ातीं", "ियाँ", "ियां", "ियों",
+		"ूंगा", "ूंगी", "ेंगी", "ेंगे", "एगा", "एगी", "ओगी", "ओगे", "तीं", "ाइए",
+		"ाईं", "ाएं", "ाओं", "ाकर", "ाता", "ाती", "ाते", "ाना", "ाने", "ाया",
+		"ुआं", "ुएं", "ुओं", "ेगा", "ेगी", "ोगी", "ोगे", "आँ", "आं", "इँ", "इं",
+		"इए", "ईं", "उँ", "उं", "ऊं", "एँ", "एं", "ओं", "कर", "ता", "ती", "ते",
+		"ना", "नी", "ने", "ाँ", "ां", "ाई", "ाए", "ाओ", "िए", "ीं", "ें", "ों",
+		"आ", "ए", "ओ", "ा", "ि", "ी", "ु", "ू", "े", "ो",
+	}
+)
+
+type HindiStemmer struct{}
+
+// NewHindiStemmer creates a new HindiStemmer.
+func NewHindiStemmer() *HindiStemmer {
+	return &HindiStemmer{}
+}
+
+// Stem returns the stem of the given word. It removes the longest suffix
+// that leaves at least one letter. Suffixes are matched and counted as whole
+// runes, so vowel signs and the virama are never split from their letters.
+func (s HindiStemmer) Stem(word string) string {
+	word = strings.ToLower(word)
+	if s.isStopWord(word) {
+		return word
+	}
+
+	suffix := longestSuffix(region(word, atLeastLetters(word, 0, 1)), hiSuffixes)
+	return word[:len(word)-len(suffix)]
+}
+
+// isStopWord returns true if the given word is a stop word.
+func (s HindiStemmer) isStopWord(word string) bool {
+	_, found := hiStopWords[word]
+	return found
+}
@@ -0,0 +1,66 @@
+package stemmer
+
+import (
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHindiStemmer(t *testing.T) {
+	s := NewHindiStemmer()
+	require.NotNil(t, s)
+}
+
+func TestHindiStemmer_isStopWord(t *testing.T) {
+	s := NewHindiStemmer()
+	require.True(t, s.isStopWord("और"))
+	require.False(t, s.isStopWord("किताब"))
+}
+
+func TestHindiStemmer_Stem(t *testing.T) {
+	s := NewHindiStemmer()
+	t.Run("stop word", func(t *testing.T) {
+		require.Equal(t, "और", s.Stem("और"))
+	})
+
+	t.Run("whole runes", func(t *testing.T) {
+		for _, word := range []string{"का", "की", "है", "ाएंगे", "क्", "a"} {
+			stem := s.Stem(word)
+			require.True(t, utf8.ValidString(stem), word)
+			require.NotEmpty(t, stem, word)
+		}
+		require.Equal(t, "ख", s.Stem("खा"))
+	})
+
+	f := func(word, stem string) {
+		t.Helper()
+		require.Equal(t, stem, s.Stem(word))
+	}
+
+	f("लड़का", "लड़क")
+	f("लड़के", "लड़क")
+	f("लड़कों", "लड़क")
+	f("लड़कियाँ", "लड़क")
+	f("लड़कियों", "लड़क")
+	f("किताब", "किताब")
+	f("किताबें", "किताब")
+	f("किताबों", "किताब")
+	f("जाएगा", "ज")
+	f("जाएंगे", "ज")
+	f("करता", "कर")
+	f("करती", "कर")
+	f("करते", "कर")
+	f("करना", "कर")
+	f("करके", "करक")
+	f("खाया", "ख")
+	f("खाएगी", "ख")
+	f("बोलीं", "बोल")
+	f("अच्छा", "अच्छ")
+	f("अच्छी", "अच्छ")
+	f("अच्छे", "अच्छ")
+	f("भारतीय", "भारतीय")
+	f("राजनीति", "राजनीत")
+	f("सरकार", "सरकार")
+	f("सरकारों", "सरकार")
+}
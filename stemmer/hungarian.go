@@ -0,0 +1,389 @@
+package stemmer
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+var (
+	huStopWords = map[string]struct{}{
+		"a":          {},
+		"ahogy":      {},
+		"ahol":       {},
+		"aki":        {},
+		"akik":       {},
+		"akkor":      {},
+		"alatt":      {},
+		"által":      {},
+		"általában":  {},
+		"amely":      {},
+		"amelyek":    {},
+		"amelyekben": {},
+		"amelyeket":  {},
+		"amelyet":    {},
+		"amelynek":   {},
+		"ami":        {},
+		"amit":       {},
+		"amolyan":    {},
+		"amíg":       {},
+		"amikor":     {},
+		"át":         {},
+		"abban":      {},
+		"ahhoz":      {},
+		"annak":      {},
+		"arra":       {},
+		"arról":      {},
+		"az":         {},
+		"azok":       {},
+		"azon":       {},
+		"azt":        {},
+		"azzal":      {},
+		"azért":      {},
+		"aztán":      {},
+		"azután":     {},
+		"azonban":    {},
+		"bár":        {},
+		"be":         {},
+		"belül":      {},
+		"benne":      {},
+		"csak":       {},
+		"de":         {},
+		"e":          {},
+		"eddig":      {},
+		"egész":      {},
+		"egy":        {},
+		"egyes":      {},
+		"egyetlen":   {},
+		"egyéb":      {},
+		"egyik":      {},
+		"egyre":      {},
+		"ekkor":      {},
+		"el":         {},
+		"elég":       {},
+		"ellen":      {},
+		"elő":        {},
+		"először":    {},
+		"előtt":      {},
+		"első":       {},
+		"én":         {},
+		"éppen":      {},
+		"ebben":      {},
+		"ehhez":      {},
+		"emilyen":    {},
+		"ennek":      {},
+		"erre":       {},
+		"ez":         {},
+		"ezt":        {},
+		"ezek":       {},
+		"ezen":       {},
+		"ezzel":      {},
+		"ezért":      {},
+		"és":         {},
+		"fel":        {},
+		"felé":       {},
+		"hanem":      {},
+		"hiszen":     {},
+		"hogy":       {},
+		"hogyan":     {},
+		"igen":       {},
+		"így":        {},
+		"illetve":    {},
+		"ilyen":      {},
+		"ilyenkor":   {},
+		"ismét":      {},
+		"itt":        {},
+		"jó":         {},
+		"jól":        {},
+		"jobban":     {},
+		"kell":       {},
+		"kellett":    {},
+		"keresztül":  {},
+		"ki":         {},
+		"kívül":      {},
+		"között":     {},
+		"közül":      {},
+		"legalább":   {},
+		"lehet":      {},
+		"lehetett":   {},
+		"legyen":     {},
+		"lenne":      {},
+		"lenni":      {},
+		"lesz":       {},
+		"lett":       {},
+		"maga":       {},
+		"magát":      {},
+		"majd":       {},
+		"már":        {},
+		"más":        {},
+		"másik":      {},
+		"meg":        {},
+		"még":        {},
+		"mellett":    {},
+		"mert":       {},
+		"mely":       {},
+		"melyek":     {},
+		"mi":         {},
+		"mit":        {},
+		"míg":        {},
+		"miért":      {},
+		"milyen":     {},
+		"mikor":      {},
+		"minden":     {},
+		"mindent":    {},
+		"mindenki":   {},
+		"mindig":     {},
+		"mint":       {},
+		"mintha":     {},
+		"mivel":      {},
+		"most":       {},
+		"nagy":       {},
+		"nagyobb":    {},
+		"nagyon":     {},
+		"ne":         {},
+		"néha":       {},
+		"nekem":      {},
+		"neki":       {},
+		"nem":        {},
+		"néhány":     {},
+		"nélkül":     {},
+		"nincs":      {},
+		"olyan":      {},
+		"ott":        {},
+		"össze":      {},
+		"ő":          {},
+		"ők":         {},
+		"őket":       {},
+		"pedig":      {},
+		"persze":     {},
+		"rá":         {},
+		"s":          {},
+		"saját":      {},
+		"sem":        {},
+		"semmi":      {},
+		"sok":        {},
+		"sokat":      {},
+		"sokkal":     {},
+		"számára":    {},
+		"szemben":    {},
+		"szerint":    {},
+		"szinte":     {},
+		"talán":      {},
+		"tehát":      {},
+		"teljes":     {},
+		"tovább":     {},
+		"továbbá":    {},
+		"több":       {},
+		"úgy":        {},
+		"ugyanis":    {},
+		"új":         {},
+		"újabb":      {},
+		"újra":       {},
+		"után":       {},
+		"utána":      {},
+		"utolsó":     {},
+		"vagy":       {},
+		"vagyis":     {},
+		"valaki":     {},
+		"valami":     {},
+		"valamint":   {},
+		"való":       {},
+		"vagyok":     {},
+		"van":        {},
+		"vannak":     {},
+		"volt":       {},
+		"voltam":     {},
+		"voltak":     {},
+		"voltunk":    {},
+		"vissza":     {},
+		"vele":       {},
+		"viszont":    {},
+		"volna":      {},
+	}
+
+	// huDigraphs are the letters written with more than one character, which
+	// count as a single consonant when finding R1.
+	huDigraphs = []string{"dzs", "cs", "gy", "ly", "ny", "sz", "ty", "zs"}
+
+	// huDoubles are the doubled consonants, digraphs included. The slice is
+	// ordered longest first.
+	huDoubles = []string{
+		"ccs", "ggy", "lly", "nny", "ssz", "tty", "zzs", "bb", "cc", "dd", "ff",
+		"gg", "jj", "kk", "ll", "mm", "nn", "pp", "rr", "ss", "tt", "vv", "zz",
+	}
+
+	huCaseSuffixes = []string{
+		"anként", "enként", "képpen", "onként", "ként", "képp", "ban", "ben", "ból",
+		"ből", "hez", "hoz", "höz", "kor", "nak", "nek", "nál", "nél", "ról", "ről",
+		"tól", "től", "val", "vel", "ért", "an", "at", "ba", "be", "en", "et", "ig",
+		"on", "ot", "ra", "re", "ul", "vá", "vé", "ön", "öt", "ül", "n", "t",
+	}
+
+	huOwnedSuffixes = []string{
+		"aké", "eké", "oké", "áké", "áéi", "éké", "ééi", "öké", "ké", "éi", "éé",
+		"é",
+	}
+
+	huSingOwnerSuffixes = []string{
+		"ájuk", "éjük", "juk", "jük", "unk", "ánk", "énk", "ünk", "ad", "am", "ed",
+		"em", "ja", "je", "nk", "od", "om", "uk", "ád", "ám", "éd", "ém", "öd", "ük",
+		"a", "d", "e", "m", "o", "á", "é",
+	}
+
+	huPlurOwnerSuffixes = []string{
+		"jaitok", "jeitek", "aitok", "eitek", "jaink", "jeink", "áitok", "éitek",
+		"aink", "eink", "itek", "jaid", "jaik", "jaim", "jeid", "jeik", "jeim",
+		"áink", "éink", "aid", "aik", "aim", "eid", "eik", "eim", "ink", "jai",
+		"jei", "áid", "áik", "áim", "éid", "éik", "éim", "ai", "ei", "id", "ik",
+		"im", "ái", "éi", "i",
+	}
+
+	huPluralSuffixes = []string{"ak", "ek", "ok", "ák", "ék", "ök", "k"}
+)
+
+type HungarianStemmer struct{}
+
+// NewHungarianStemmer creates a new HungarianStemmer.
+func NewHungarianStemmer() *HungarianStemmer {
+	return &HungarianStemmer{}
+}
+
+// Stem returns the stem of the given word.
+func (s HungarianStemmer) Stem(word string) string {
+	word = strings.ToLower(word)
+	if s.isStopWord(word) {
+		return word
+	}
+
+	r1 := s.regions(word)
+
+	word = s.instrumental(word, r1)
+	word = s.caseSuffix(word, r1)
+	word = s.trimInR1(word, []string{"ánként", "án", "én"}, r1)
+	word = s.trimInR1(word, []string{"astul", "estül", "ástul", "éstül", "stul", "stül"}, r1)
+	word = s.factive(word, r1)
+	word = s.owned(word, r1)
+	word = s.trimInR1(word, huSingOwnerSuffixes, r1)
+	word = s.trimInR1(word, huPlurOwnerSuffixes, r1)
+	return s.trimInR1(word, huPluralSuffixes, r1)
+}
+
+// instrumental removes the instrumental al or el after a doubled consonant,
+// which the ending assimilated, and then undoubles that consonant.
+func (s HungarianStemmer) instrumental(word string, r1 int) string {
+	return s.trimAfterDouble(word, []string{"al", "el"}, r1)
+}
+
+// factive removes the factive á or é after a doubled consonant and then
+// undoubles that consonant.
+func (s HungarianStemmer) factive(word string, r1 int) string {
+	return s.trimAfterDouble(word, []string{"á", "é"}, r1)
+}
+
+func (s HungarianStemmer) trimAfterDouble(word string, suffixes []string, r1 int) string {
+	suffix := longestSuffix(word, suffixes)
+	if suffix == "" || !inRegion(word, suffix, r1) {
+		return word
+	}
+	stem := word[:len(word)-len(suffix)]
+	if longestSuffix(stem, huDoubles) == "" {
+		return word
+	}
+	return s.undouble(stem)
+}
+
+// caseSuffix removes a case ending in R1, then turns a final á or é in R1
+// into a or e.
+func (s HungarianStemmer) caseSuffix(word string, r1 int) string {
+	suffix := longestSuffix(word, huCaseSuffixes)
+	if suffix == "" || !inRegion(word, suffix, r1) {
+		return word
+	}
+	word = word[:len(word)-len(suffix)]
+	return s.trimInR1(word, []string{"á", "é"}, r1)
+}
+
+// owned removes the possessive é and éi, keeping a long vowel before them
+// as a short one.
+func (s HungarianStemmer) owned(word string, r1 int) string {
+	suffix := longestSuffix(word, huOwnedSuffixes)
+	if suffix == "" || !inRegion(word, suffix, r1) {
+		return word
+	}
+	switch suffix {
+	case "é", "éi":
+		return word[:len(word)-len(suffix)]
+	}
+	return s.shorten(word, suffix)
+}
+
+// trimInR1 removes the longest of suffixes if it is in R1.
+func (s HungarianStemmer) trimInR1(word string, suffixes []string, r1 int) string {
+	suffix := longestSuffix(word, suffixes)
+	if suffix == "" || !inRegion(word, suffix, r1) {
+		return word
+	}
+	return s.shorten(word, suffix)
+}
+
+// shorten removes suffix from word. A suffix starting with á or é leaves
+// that vowel behind as a or e.
+func (s HungarianStemmer) shorten(word, suffix string) string {
+	stem := word[:len(word)-len(suffix)]
+	switch {
+	case strings.HasPrefix(suffix, "á"):
+		return stem + "a"
+	case strings.HasPrefix(suffix, "é"):
+		return stem + "e"
+	}
+	return stem
+}
+
+// undouble removes the letter before the last one, so that bb becomes b and
+// ccs becomes cs.
+func (s HungarianStemmer) undouble(word string) string {
+	_, last := utf8.DecodeLastRuneInString(word)
+	_, prev := utf8.DecodeLastRuneInString(word[:len(word)-last])
+	return word[:len(word)-last-prev] + word[len(word)-last:]
+}
+
+// isStopWord returns true if the given word is a stop word.
+func (s HungarianStemmer) isStopWord(word string) bool {
+	_, found := huStopWords[word]
+	return found
+}
+
+func (s HungarianStemmer) isVowel(r rune) bool {
+	switch r {
+	case 'a', 'e', 'i', 'o', 'u', 'á', 'é', 'í', 'ó', 'ö', 'ő', 'ú', 'ü', 'ű':
+		return true
+	default:
+		return false
+	}
+}
+
+// regions returns the byte offset of R1. If the word starts with a vowel, R1
+// is the region after the first consonant, reading a digraph as one letter;
+// otherwise it is the region after the first vowel.
+func (s HungarianStemmer) regions(word string) int {
+	first, _ := utf8.DecodeRuneInString(word)
+	if !s.isVowel(first) {
+		i := strings.IndexFunc(word, s.isVowel)
+		if i < 0 {
+			return len(word)
+		}
+		_, size := utf8.DecodeRuneInString(word[i:])
+		return i + size
+	}
+
+	i := strings.IndexFunc(word, func(r rune) bool { return !s.isVowel(r) })
+	if i < 0 {
+		return len(word)
+	}
+	for _, digraph := range huDigraphs {
+		if strings.HasPrefix(word[i:], digraph) {
+			return i + len(digraph)
+		}
+	}
+	_, size := utf8.DecodeRuneInString(word[i:])
+	return i + size
+}
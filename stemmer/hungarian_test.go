@@ -0,0 +1,283 @@
+package stemmer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHungarianStemmer(t *testing.T) {
+	s := NewHungarianStemmer()
+	require.NotNil(t, s)
+}
+
+func TestHungarianStemmer_isStopWord(t *testing.T) {
+	s := NewHungarianStemmer()
+	require.True(t, s.isStopWord("és"))
+	require.False(t, s.isStopWord("ház"))
+}
+
+func TestHungarianStemmer_Stem(t *testing.T) {
+	s := NewHungarianStemmer()
+	t.Run("stop word", func(t *testing.T) {
+		require.Equal(t, "és", s.Stem("és"))
+		require.Equal(t, "és", s.Stem("És"))
+	})
+
+	f := func(word, stem string) {
+		t.Helper()
+		require.Equal(t, stem, s.Stem(word))
+	}
+
+	f("ház", "ház")
+	f("háza", "ház")
+	f("házak", "ház")
+	f("házban", "ház")
+	f("házból", "ház")
+	f("házba", "ház")
+	f("házhoz", "ház")
+	f("háznál", "ház")
+	f("háztól", "ház")
+	f("házon", "ház")
+	f("házra", "ház")
+	f("házról", "ház")
+	f("házzal", "ház")
+	f("házat", "ház")
+	f("házért", "ház")
+	f("házig", "ház")
+	f("házként", "ház")
+	f("házakat", "ház")
+	f("házaknak", "ház")
+	f("házam", "ház")
+	f("házad", "ház")
+	f("házunk", "ház")
+	f("házatok", "házat")
+	f("házuk", "ház")
+	f("házaim", "ház")
+	f("házaid", "ház")
+	f("házai", "ház")
+	f("házaink", "ház")
+	f("házaitok", "ház")
+	f("házaik", "ház")
+	f("könyv", "könyv")
+	f("könyvek", "könyv")
+	f("könyvben", "könyv")
+	f("könyvből", "könyv")
+	f("könyvet", "könyv")
+	f("könyvvel", "könyv")
+	f("könyvnek", "könyv")
+	f("könyvem", "könyv")
+	f("könyvünk", "könyv")
+	f("könyveink", "könyv")
+	f("könyvei", "könyv")
+	f("város", "város")
+	f("városban", "város")
+	f("városok", "város")
+	f("városba", "város")
+	f("városból", "város")
+	f("várossal", "város")
+	f("városnak", "város")
+	f("városért", "város")
+	f("városi", "város")
+	f("ember", "ember")
+	f("emberek", "ember")
+	f("emberrel", "ember")
+	f("embernek", "ember")
+	f("embert", "ember")
+	f("emberekkel", "ember")
+	f("emberé", "ember")
+	f("emberéi", "ember")
+	f("kert", "ker")
+	f("kertben", "kert")
+	f("kertek", "kert")
+	f("kertet", "kert")
+	f("kerttel", "ker")
+	f("kertje", "kert")
+	f("kertjei", "kert")
+	f("kutya", "kuty")
+	f("kutyák", "kutya")
+	f("kutyával", "kuty")
+	f("kutyát", "kuty")
+	f("kutyája", "kutyá")
+	f("kutyáé", "kutya")
+	f("alma", "alm")
+	f("almák", "alma")
+	f("almát", "alm")
+	f("almával", "alm")
+	f("almája", "almá")
+	f("almáé", "alma")
+	f("fa", "fa")
+	f("fák", "fák")
+	f("fát", "fá")
+	f("fával", "fá")
+	f("fája", "fá")
+	f("víz", "víz")
+	f("vizet", "viz")
+	f("vízzel", "víz")
+	f("vízben", "víz")
+	f("kéz", "kéz")
+	f("kezek", "kez")
+	f("kézzel", "kéz")
+	f("kezem", "kez")
+	f("kezét", "kez")
+	f("asztal", "asztal")
+	f("asztalon", "asztal")
+	f("asztalok", "asztal")
+	f("asztalt", "asztal")
+	f("asztallal", "asztal")
+	f("barát", "bar")
+	f("barátok", "barát")
+	f("barátom", "barát")
+	f("barátaim", "barát")
+	f("barátjával", "barát")
+	f("barátság", "barátság")
+	f("barátságos", "barátságos")
+	f("barátságosan", "barátságos")
+	f("magyar", "magyar")
+	f("magyarok", "magyar")
+	f("magyarul", "magyar")
+	f("magyarország", "magyarország")
+	f("magyarországon", "magyarország")
+	f("magyarországi", "magyarország")
+	f("budapest", "budapes")
+	f("budapesten", "budapest")
+	f("budapestre", "budapest")
+	f("budapestről", "budapest")
+	f("iskola", "iskol")
+	f("iskolában", "iskol")
+	f("iskolából", "iskol")
+	f("iskolák", "iskola")
+	f("iskolát", "iskol")
+	f("iskolába", "iskol")
+	f("tanár", "tanár")
+	f("tanárok", "tanár")
+	f("tanárnak", "tanár")
+	f("tanárral", "tanár")
+	f("diák", "dia")
+	f("diákok", "diák")
+	f("diákoknak", "diák")
+	f("diákkal", "dia")
+	f("nap", "nap")
+	f("napok", "nap")
+	f("napon", "nap")
+	f("naponta", "napont")
+	f("napra", "nap")
+	f("év", "év")
+	f("évek", "év")
+	f("évben", "év")
+	f("évente", "évent")
+	f("évet", "év")
+	f("munka", "mun")
+	f("munkát", "mun")
+	f("munkával", "mun")
+	f("munkában", "mun")
+	f("munkások", "munkás")
+	f("dolgozik", "dolgoz")
+	f("dolgozom", "dolgoz")
+	f("dolgozol", "dolgozol")
+	f("dolgozunk", "dolgoz")
+	f("dolgoztok", "dolgozt")
+	f("dolgoznak", "dolgoz")
+	f("dolgozott", "dolgozot")
+	f("olvas", "olvas")
+	f("olvasok", "olvas")
+	f("olvasol", "olvasol")
+	f("olvasunk", "olvas")
+	f("olvasnak", "olvas")
+	f("olvasott", "olvasot")
+	f("olvasni", "olvasn")
+	f("olvasás", "olvasás")
+	f("ír", "ír")
+	f("írok", "ír")
+	f("írunk", "ír")
+	f("írt", "ír")
+	f("írni", "írn")
+	f("írás", "írás")
+	f("írásban", "írás")
+	f("szép", "szép")
+	f("szépen", "szép")
+	f("szépség", "szépség")
+	f("szépek", "szép")
+	f("nagy", "nagy")
+	f("nagyobb", "nagyobb")
+	f("legnagyobb", "legnagyobb")
+	f("kicsi", "kics")
+	f("kisebb", "kisebb")
+	f("legkisebb", "legkisebb")
+	f("gyors", "gyors")
+	f("gyorsan", "gyors")
+	f("gyorsabb", "gyorsabb")
+	f("egészség", "egészség")
+	f("egészségügyi", "egészségügy")
+	f("kormány", "kormány")
+	f("kormányt", "kormány")
+	f("kormánynak", "kormány")
+	f("kormányzat", "kormányz")
+	f("szabadság", "szabadság")
+	f("szabadságot", "szabadság")
+	f("szabadsággal", "szabadság")
+	f("szabadon", "szab")
+	f("ország", "ország")
+	f("országban", "ország")
+	f("országok", "ország")
+	f("országot", "ország")
+	f("országgal", "ország")
+	f("országszerte", "országszert")
+	f("család", "csala")
+	f("családban", "csala")
+	f("családok", "család")
+	f("családdal", "csala")
+	f("családjával", "család")
+	f("gyerek", "gyer")
+	f("gyerekek", "gyerek")
+	f("gyereknek", "gyer")
+	f("gyerekkel", "gyer")
+	f("gyermekei", "gyerm")
+	f("szó", "szó")
+	f("szavak", "szav")
+	f("szót", "szó")
+	f("szóval", "szó")
+	f("szavakat", "szav")
+	f("társaság", "társaság")
+	f("társaságban", "társaság")
+	f("társasággal", "társaság")
+	f("ablak", "abl")
+	f("ablakot", "abl")
+	f("ablakkal", "abl")
+	f("ablakban", "abl")
+	f("étterem", "étter")
+	f("étteremben", "étter")
+	f("éttermek", "étterm")
+	f("hatás", "hatás")
+	f("hatással", "hatás")
+	f("hatásos", "hatásos")
+	f("hatásosan", "hatásos")
+	f("hegy", "hegy")
+	f("hegyek", "hegy")
+	f("hegyen", "hegy")
+	f("hegyről", "hegy")
+	f("hegyekben", "hegy")
+	f("hatalmas", "hatalmas")
+	f("hatalmasan", "hatalmas")
+	f("egyetem", "egyet")
+	f("egyetemen", "egyet")
+	f("egyetemek", "egyetem")
+	f("egyetemista", "egyetemist")
+	f("egyetemisták", "egyetemista")
+}
+
+func TestHungarianStemmer_regions(t *testing.T) {
+	s := NewHungarianStemmer()
+
+	f := func(word, r1 string) {
+		t.Helper()
+		require.Equal(t, r1, word[s.regions(word):])
+	}
+
+	f("város", "ros")
+	f("ablak", "lak")
+	f("egyetem", "etem")
+	f("asszony", "szony")
+	f("édzsungel", "ungel")
+	f("ó", "")
+}
@@ -0,0 +1,249 @@
+package stemmer
+
+import "strings"
+
+// idPrefix records which prefix class was removed, since it decides which
+// suffixes may be removed with it.
+type idPrefix int
+
+const (
+	idPrefixNone idPrefix = iota
+	idPrefixDi            // di-, meng-, ter-
+	idPrefixPer           // per-, pe-
+	idPrefixKe            // ke-, peng-
+	idPrefixBer           // ber-, be-
+)
+
+var (
+	idStopWords = map[string]struct{}{
+		"ada":      {},
+		"adalah":   {},
+		"agar":     {},
+		"akan":     {},
+		"aku":      {},
+		"anda":     {},
+		"antara":   {},
+		"apa":      {},
+		"atau":     {},
+		"bagi":     {},
+		"bahwa":    {},
+		"belum":    {},
+		"bisa":     {},
+		"dalam":    {},
+		"dan":      {},
+		"dapat":    {},
+		"dari":     {},
+		"dengan":   {},
+		"di":       {},
+		"dia":      {},
+		"hanya":    {},
+		"ia":       {},
+		"ini":      {},
+		"itu":      {},
+		"jika":     {},
+		"juga":     {},
+		"kami":     {},
+		"kamu":     {},
+		"karena":   {},
+		"ke":       {},
+		"kepada":   {},
+		"kita":     {},
+		"lagi":     {},
+		"maka":     {},
+		"mereka":   {},
+		"namun":    {},
+		"oleh":     {},
+		"pada":     {},
+		"para":     {},
+		"saja":     {},
+		"sangat":   {},
+		"saya":     {},
+		"sebagai":  {},
+		"sedang":   {},
+		"sehingga": {},
+		"sejak":    {},
+		"seperti":  {},
+		"serta":    {},
+		"sudah":    {},
+		"tanpa":    {},
+		"telah":    {},
+		"tetapi":   {},
+		"tidak":    {},
+		"untuk":    {},
+		"yaitu":    {},
+		"yang":     {},
+	}
+
+	idParticles          = []string{"kah", "lah", "pun"}
+	idPossessivePronouns = []string{"nya", "ku", "mu"}
+	idDerivationSuffixes = []string{"kan", "an", "i"}
+
+	idFirstOrderPrefixes  = []string{"meng", "meny", "peng", "peny", "mem", "men", "pem", "pen", "ter", "di", "ke", "me"}
+	idSecondOrderPrefixes = []string{"belajar", "pelajar", "ber", "per", "be", "pe"}
+)
+
+type IndonesianStemmer struct{}
+
+// NewIndonesianStemmer creates a new IndonesianStemmer.
+func NewIndonesianStemmer() *IndonesianStemmer {
+	return &IndonesianStemmer{}
+}
+
+// Stem returns the stem of the given word. Every affix removed is taken to
+// remove one syllable, and no affix is removed from a word that has two
+// syllables or fewer left.
+func (s IndonesianStemmer) Stem(word string) string {
+	word = strings.ToLower(word)
+	if s.isStopWord(word) {
+		return word
+	}
+
+	measure := s.countVowels(word)
+	if measure <= 2 {
+		return word
+	}
+
+	for _, suffixes := range [][]string{idParticles, idPossessivePronouns} {
+		if measure <= 2 {
+			return word
+		}
+		if suffix := longestSuffix(word, suffixes); suffix != "" {
+			word = word[:len(word)-len(suffix)]
+			measure--
+		}
+	}
+	if measure <= 2 {
+		return word
+	}
+
+	if stem, prefix, ok := s.firstOrderPrefix(word); ok {
+		word, measure = stem, measure-1
+		if measure > 2 {
+			if stem, ok := s.derivationSuffix(word, prefix); ok {
+				word, measure = stem, measure-1
+			}
+		}
+		if measure > 2 {
+			word, _, _ = s.secondOrderPrefix(word)
+		}
+		return word
+	}
+
+	prefix := idPrefixNone
+	if stem, p, ok := s.secondOrderPrefix(word); ok {
+		word, prefix, measure = stem, p, measure-1
+	}
+	if measure > 2 {
+		word, _ = s.derivationSuffix(word, prefix)
+	}
+	return word
+}
+
+// firstOrderPrefix removes di-, ke-, ter- or one of the nasal forms of meng-
+// and peng-. A nasal that replaced the first letter of the root is turned
+// back into that letter.
+func (s IndonesianStemmer) firstOrderPrefix(word string) (string, idPrefix, bool) {
+	prefix := longestPrefix(word, idFirstOrderPrefixes)
+	if prefix == "" {
+		return word, idPrefixNone, false
+	}
+	stem := word[len(prefix):]
+
+	class := idPrefixDi
+	switch prefix {
+	case "ke", "peng", "peny", "pem", "pen":
+		class = idPrefixKe
+	}
+
+	switch prefix {
+	case "meny", "peny":
+		if stem == "" || !s.isVowel(rune(stem[0])) {
+			return word, idPrefixNone, false
+		}
+		stem = "s" + stem
+	case "mem", "pem":
+		if stem != "" && s.isVowel(rune(stem[0])) {
+			stem = "p" + stem
+		}
+	}
+	return stem, class, true
+}
+
+// secondOrderPrefix removes ber-, per- and their shortened forms. The be-
+// form is only removed before a consonant followed by er.
+func (s IndonesianStemmer) secondOrderPrefix(word string) (string, idPrefix, bool) {
+	prefix := longestPrefix(word, idSecondOrderPrefixes)
+	stem := word[len(prefix):]
+
+	switch prefix {
+	case "belajar":
+		return "ajar", idPrefixBer, true
+	case "pelajar":
+		return "ajar", idPrefixPer, true
+	case "ber":
+		return stem, idPrefixBer, true
+	case "be":
+		if len(stem) < 3 || s.isVowel(rune(stem[0])) || stem[1:3] != "er" {
+			return word, idPrefixNone, false
+		}
+		return stem, idPrefixBer, true
+	case "per", "pe":
+		return stem, idPrefixPer, true
+	}
+	return word, idPrefixNone, false
+}
+
+// derivationSuffix removes -kan, -an or -i unless the prefix already removed
+// never combines with it.
+func (s IndonesianStemmer) derivationSuffix(word string, prefix idPrefix) (string, bool) {
+	for _, suffix := range idDerivationSuffixes {
+		if !strings.HasSuffix(word, suffix) {
+			continue
+		}
+		stem := word[:len(word)-len(suffix)]
+
+		switch suffix {
+		case "kan":
+			if prefix == idPrefixKe || prefix == idPrefixPer {
+				continue
+			}
+		case "an":
+			if prefix == idPrefixDi {
+				continue
+			}
+		case "i":
+			if prefix > idPrefixPer || strings.HasSuffix(stem, "s") {
+				continue
+			}
+		}
+		return stem, true
+	}
+	return word, false
+}
+
+// countVowels returns the number of vowels in word, which stands in for its
+// number of syllables.
+func (s IndonesianStemmer) countVowels(word string) int {
+	n := 0
+	for _, r := range word {
+		if s.isVowel(r) {
+			n++
+		}
+	}
+	return n
+}
+
+// isStopWord returns true if the given word is a stop word.
+func (s IndonesianStemmer) isStopWord(word string) bool {
+	_, found := idStopWords[word]
+	return found
+}
+
+func (s IndonesianStemmer) isVowel(r rune) bool {
+	switch r {
+	case 'a', 'e', 'i', 'o', 'u':
+		return true
+	default:
+		return false
+	}
+}
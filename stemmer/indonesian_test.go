@@ -0,0 +1,125 @@
+package stemmer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewIndonesianStemmer(t *testing.T) {
+	s := NewIndonesianStemmer()
+	require.NotNil(t, s)
+}
+
+func TestIndonesianStemmer_isStopWord(t *testing.T) {
+	s := NewIndonesianStemmer()
+	require.True(t, s.isStopWord("yang"))
+	require.False(t, s.isStopWord("rumah"))
+}
+
+func TestIndonesianStemmer_Stem(t *testing.T) {
+	s := NewIndonesianStemmer()
+	t.Run("stop word", func(t *testing.T) {
+		require.Equal(t, "yang", s.Stem("yang"))
+		require.Equal(t, "dan", s.Stem("DAN"))
+	})
+
+	t.Run("syllable limit", func(t *testing.T) {
+		require.Equal(t, "dilan", s.Stem("dilan"))
+		require.Equal(t, "ban", s.Stem("ban"))
+		require.Equal(t, "kuku", s.Stem("kuku"))
+	})
+
+	f := func(word, stem string) {
+		t.Helper()
+		require.Equal(t, stem, s.Stem(word))
+	}
+
+	f("bukunya", "buku")
+	f("rumahku", "rumah")
+	f("bukumu", "buku")
+	f("sayalah", "saya")
+	f("apakah", "apa")
+	f("bagaimanakah", "bagaimana")
+	f("kamipun", "kami")
+	f("makanan", "makan")
+	f("makanannya", "makan")
+	f("dimakan", "makan")
+	f("memakan", "pakan")
+	f("membaca", "baca")
+	f("bacaan", "baca")
+	f("membacakan", "baca")
+	f("pembaca", "baca")
+	f("memakai", "paka")
+	f("pemakai", "pakai")
+	f("menyapu", "sapu")
+	f("menyanyikan", "sanyi")
+	f("penyanyi", "sanyi")
+	f("mengambil", "ambil")
+	f("pengambilan", "ambil")
+	f("mendengar", "dengar")
+	f("pendengaran", "dengar")
+	f("melihat", "lihat")
+	f("terlihat", "lihat")
+	f("terbaik", "baik")
+	f("kedudukan", "duduk")
+	f("ketahui", "tahui")
+	f("keadilan", "adil")
+	f("memperbaiki", "baik")
+	f("perbaikan", "baik")
+	f("diperbesar", "besar")
+	f("memperbesar", "besar")
+	f("terpercaya", "caya")
+	f("percaya", "caya")
+	f("bermain", "main")
+	f("permainan", "main")
+	f("pemain", "pain")
+	f("berlari", "lari")
+	f("bekerja", "kerja")
+	f("pekerja", "kerja")
+	f("pekerjaan", "kerja")
+	f("belajar", "ajar")
+	f("pelajaran", "ajar")
+	f("mempelajari", "ajar")
+	f("pelajar", "ajar")
+	f("mengajarkan", "ajar")
+	f("menuliskan", "ulis")
+	f("tulisan", "tulis")
+	f("ditulis", "tulis")
+	f("kebersamaan", "sama")
+	f("bersama", "sama")
+	f("berkata", "kata")
+	f("perkataan", "kata")
+	f("mendapatkan", "dapat")
+	f("pendapat", "dapat")
+	f("pendapatnya", "dapat")
+	f("kemerdekaan", "merdeka")
+	f("Indonesia", "indonesia")
+	f("pemerintah", "intah")
+	f("pemerintahan", "intah")
+	f("memerintahkan", "intah")
+	f("perumahan", "umah")
+	f("kesehatan", "sehat")
+	f("sehatlah", "sehat")
+	f("kecil", "kecil")
+}
+
+func TestIndonesianStemmer_derivationSuffix(t *testing.T) {
+	s := NewIndonesianStemmer()
+
+	f := func(word string, prefix idPrefix, expected string, removed bool) {
+		t.Helper()
+		stem, ok := s.derivationSuffix(word, prefix)
+		require.Equal(t, expected, stem)
+		require.Equal(t, removed, ok)
+	}
+
+	f("bacakan", idPrefixNone, "baca", true)
+	f("dudukan", idPrefixKe, "duduk", true)
+	f("makanan", idPrefixNone, "makan", true)
+	f("makanan", idPrefixDi, "makanan", false)
+	f("baiki", idPrefixDi, "baik", true)
+	f("tahui", idPrefixKe, "tahui", false)
+	f("lari", idPrefixBer, "lari", false)
+	f("tulisi", idPrefixNone, "tulisi", false)
+}
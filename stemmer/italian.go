@@ -0,0 +1,517 @@
+package stemmer
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+)
+
+var (
+	itStopWords = map[string]struct{}{
+		"ad":      {},
+		"al":      {},
+		"allo":    {},
+		"ai":      {},
+		"agli":    {},
+		"all":     {},
+		"agl":     {},
+		"alla":    {},
+		"alle":    {},
+		"con":     {},
+		"col":     {},
+		"coi":     {},
+		"da":      {},
+		"dal":     {},
+		"dallo":   {},
+		"dai":     {},
+		"dagli":   {},
+		"dall":    {},
+		"dalla":   {},
+		"dalle":   {},
+		"di":      {},
+		"del":     {},
+		"dello":   {},
+		"dei":     {},
+		"degli":   {},
+		"dell":    {},
+		"della":   {},
+		"delle":   {},
+		"in":      {},
+		"nel":     {},
+		"nello":   {},
+		"nei":     {},
+		"negli":   {},
+		"nell":    {},
+		"nella":   {},
+		"nelle":   {},
+		"su":      {},
+		"sul":     {},
+		"sullo":   {},
+		"sui":     {},
+		"sugli":   {},
+		"sull":    {},
+		"sulla":   {},
+		"sulle":   {},
+		"per":     {},
+		"tra":     {},
+		"contro":  {},
+		"io":      {},
+		"tu":      {},
+		"lui":     {},
+		"lei":     {},
+		"noi":     {},
+		"voi":     {},
+		"loro":    {},
+		"mio":     {},
+		"mia":     {},
+		"miei":    {},
+		"mie":     {},
+		"tuo":     {},
+		"tua":     {},
+		"tuoi":    {},
+		"tue":     {},
+		"suo":     {},
+		"sua":     {},
+		"suoi":    {},
+		"sue":     {},
+		"nostro":  {},
+		"nostra":  {},
+		"nostri":  {},
+		"nostre":  {},
+		"vostro":  {},
+		"vostra":  {},
+		"vostri":  {},
+		"vostre":  {},
+		"mi":      {},
+		"ti":      {},
+		"ci":      {},
+		"vi":      {},
+		"lo":      {},
+		"la":      {},
+		"li":      {},
+		"le":      {},
+		"gli":     {},
+		"ne":      {},
+		"il":      {},
+		"un":      {},
+		"uno":     {},
+		"una":     {},
+		"ma":      {},
+		"ed":      {},
+		"se":      {},
+		"perché":  {},
+		"anche":   {},
+		"come":    {},
+		"dov":     {},
+		"dove":    {},
+		"che":     {},
+		"chi":     {},
+		"cui":     {},
+		"non":     {},
+		"più":     {},
+		"quale":   {},
+		"quanto":  {},
+		"quanti":  {},
+		"quanta":  {},
+		"quante":  {},
+		"quello":  {},
+		"quelli":  {},
+		"quella":  {},
+		"quelle":  {},
+		"questo":  {},
+		"questi":  {},
+		"questa":  {},
+		"queste":  {},
+		"si":      {},
+		"tutto":   {},
+		"tutti":   {},
+		"a":       {},
+		"c":       {},
+		"e":       {},
+		"i":       {},
+		"l":       {},
+		"o":       {},
+		"ho":      {},
+		"hai":     {},
+		"ha":      {},
+		"abbiamo": {},
+		"avete":   {},
+		"hanno":   {},
+		"sono":    {},
+		"sei":     {},
+		"è":       {},
+		"siamo":   {},
+		"siete":   {},
+		"era":     {},
+		"erano":   {},
+		"fu":      {},
+		"stato":   {},
+		"stata":   {},
+	}
+
+	itPronounSuffixes = []string{
+		"gliela",
+		"gliele",
+		"glieli",
+		"glielo",
+		"gliene",
+		"cela",
+		"cele",
+		"celi",
+		"celo",
+		"cene",
+		"mela",
+		"mele",
+		"meli",
+		"melo",
+		"mene",
+		"sene",
+		"tela",
+		"tele",
+		"teli",
+		"telo",
+		"tene",
+		"vela",
+		"vele",
+		"veli",
+		"velo",
+		"vene",
+		"gli",
+		"ci",
+		"la",
+		"le",
+		"li",
+		"lo",
+		"mi",
+		"ne",
+		"si",
+		"ti",
+		"vi",
+	}
+
+	itPronounVerbEndings = []string{"ando", "endo", "ar", "er", "ir"}
+
+	itStandardSuffixes = []string{
+		"amente",
+		"atrice",
+		"atrici",
+		"azione",
+		"azioni",
+		"uzione",
+		"uzioni",
+		"usione",
+		"usioni",
+		"amento",
+		"amenti",
+		"imento",
+		"imenti",
+		"abile",
+		"abili",
+		"ibile",
+		"ibili",
+		"logia",
+		"logie",
+		"mente",
+		"atore",
+		"atori",
+		"anza",
+		"anze",
+		"iche",
+		"ichi",
+		"ismo",
+		"ismi",
+		"ista",
+		"iste",
+		"isti",
+		"istà",
+		"istè",
+		"istì",
+		"enza",
+		"enze",
+		"ante",
+		"anti",
+		"ico",
+		"ici",
+		"ica",
+		"ice",
+		"oso",
+		"osi",
+		"osa",
+		"ose",
+		"ità",
+		"ivo",
+		"ivi",
+		"iva",
+		"ive",
+	}
+
+	// itR2Suffixes are the standard suffixes that are simply deleted when
+	// they are in R2. The slice is sorted for binary search.
+	itR2Suffixes = []string{
+		"abile", "abili", "ante", "anti", "anza", "anze", "atrice", "atrici",
+		"ibile", "ibili", "ica", "ice", "iche", "ichi", "ici", "ico",
+		"ismi", "ismo", "ista", "iste", "isti", "istà", "istè", "istì",
+		"mente", "osa", "ose", "osi", "oso",
+	}
+
+	itVerbSuffixes = []string{
+		"erebbero",
+		"irebbero",
+		"assero",
+		"assimo",
+		"eranno",
+		"erebbe",
+		"eremmo",
+		"ereste",
+		"eresti",
+		"essero",
+		"iranno",
+		"irebbe",
+		"iremmo",
+		"ireste",
+		"iresti",
+		"iscano",
+		"iscono",
+		"issero",
+		"arono",
+		"avamo",
+		"avano",
+		"avate",
+		"eremo",
+		"erete",
+		"erono",
+		"evamo",
+		"evano",
+		"evate",
+		"iremo",
+		"irete",
+		"irono",
+		"ivamo",
+		"ivano",
+		"ivate",
+		"ammo",
+		"ando",
+		"asse",
+		"assi",
+		"emmo",
+		"enda",
+		"ende",
+		"endi",
+		"endo",
+		"erai",
+		"erei",
+		"iamo",
+		"immo",
+		"irai",
+		"irei",
+		"isca",
+		"isce",
+		"isci",
+		"isco",
+		"ano",
+		"are",
+		"ata",
+		"ate",
+		"ati",
+		"ato",
+		"ava",
+		"avi",
+		"avo",
+		"erà",
+		"ere",
+		"erò",
+		"ete",
+		"eva",
+		"evi",
+		"evo",
+		"irà",
+		"ire",
+		"irò",
+		"ita",
+		"ite",
+		"iti",
+		"ito",
+		"iva",
+		"ivi",
+		"ivo",
+		"ono",
+		"uta",
+		"ute",
+		"uti",
+		"uto",
+		"ar",
+		"ir",
+	}
+
+	itAccentReplacer = strings.NewReplacer("á", "à", "é", "è", "í", "ì", "ó", "ò", "ú", "ù", "qu", "qU")
+	itMarkerReplacer = strings.NewReplacer("I", "i", "U", "u")
+)
+
+type ItalianStemmer struct{}
+
+// NewItalianStemmer creates a new ItalianStemmer.
+func NewItalianStemmer() *ItalianStemmer {
+	return &ItalianStemmer{}
+}
+
+// Stem returns the stem of the given word.
+func (s ItalianStemmer) Stem(word string) string {
+	word = strings.ToLower(word)
+	if s.isStopWord(word) {
+		return word
+	}
+
+	word = itAccentReplacer.Replace(word)
+	word = s.markVowels(word)
+	rv, r1, r2 := s.regions(word)
+
+	word = s.attachedPronoun(word, rv)
+
+	var removed bool
+	if word, removed = s.standardSuffix(word, rv, r1, r2); !removed {
+		word = s.verbSuffix(word, rv)
+	}
+
+	word = s.vowelSuffix(word, rv)
+
+	return itMarkerReplacer.Replace(word)
+}
+
+// attachedPronoun removes a pronoun attached to a gerund, or turns one
+// attached to an infinitive into the infinitive's final e.
+func (s ItalianStemmer) attachedPronoun(word string, rv int) string {
+	pronoun := longestSuffix(word, itPronounSuffixes)
+	if pronoun == "" {
+		return word
+	}
+
+	stem := word[:len(word)-len(pronoun)]
+	ending := longestSuffix(stem, itPronounVerbEndings)
+	if ending == "" || !inRegion(stem, ending, rv) {
+		return word
+	}
+
+	switch ending {
+	case "ar", "er", "ir":
+		return stem + "e"
+	}
+	return stem
+}
+
+func (s ItalianStemmer) standardSuffix(word string, rv, r1, r2 int) (string, bool) {
+	suffix := longestSuffix(word, itStandardSuffixes)
+	if suffix == "" {
+		return word, false
+	}
+	stem := word[:len(word)-len(suffix)]
+
+	switch suffix {
+	case "amento", "amenti", "imento", "imenti":
+		if !inRegion(word, suffix, rv) {
+			return word, false
+		}
+		return stem, true
+
+	case "amente":
+		if !inRegion(word, suffix, r1) {
+			return word, false
+		}
+		if next := longestSuffix(stem, []string{"abil", "ic", "iv", "os"}); next != "" && inRegion(stem, next, r2) {
+			stem = stem[:len(stem)-len(next)]
+			if next == "iv" {
+				stem = s.trimInR2(stem, "at", r2)
+			}
+		}
+		return stem, true
+	}
+
+	if !inRegion(word, suffix, r2) {
+		return word, false
+	}
+
+	if _, found := slices.BinarySearch(itR2Suffixes, suffix); found {
+		return stem, true
+	}
+
+	switch suffix {
+	case "azione", "azioni", "atore", "atori":
+		stem = s.trimInR2(stem, "ic", r2)
+	case "logia", "logie":
+		stem += "log"
+	case "uzione", "uzioni", "usione", "usioni":
+		stem += "u"
+	case "enza", "enze":
+		stem += "ente"
+	case "ità":
+		if next := longestSuffix(stem, []string{"abil", "ic", "iv"}); next != "" {
+			stem = s.trimInR2(stem, next, r2)
+		}
+	case "ivo", "ivi", "iva", "ive":
+		if strings.HasSuffix(stem, "at") && inRegion(stem, "at", r2) {
+			stem = s.trimInR2(stem[:len(stem)-2], "ic", r2)
+		}
+	}
+	return stem, true
+}
+
+func (s ItalianStemmer) verbSuffix(word string, rv int) string {
+	suffix := longestSuffix(region(word, rv), itVerbSuffixes)
+	return word[:len(word)-len(suffix)]
+}
+
+// vowelSuffix removes a final vowel and a preceding i, then the h of a
+// final ch or gh, all inside RV.
+func (s ItalianStemmer) vowelSuffix(word string, rv int) string {
+	if last := lastRune(word); strings.ContainsRune("aeioàèìò", last) && len(word)-len(string(last)) >= rv {
+		word = strings.TrimSuffix(word, string(last))
+		if strings.HasSuffix(word, "i") && len(word)-1 >= rv {
+			word = word[:len(word)-1]
+		}
+	}
+
+	if (strings.HasSuffix(word, "ch") || strings.HasSuffix(word, "gh")) && len(word)-2 >= rv {
+		word = word[:len(word)-1]
+	}
+	return word
+}
+
+// trimInR2 removes suffix from word if word ends with it inside R2.
+func (s ItalianStemmer) trimInR2(word, suffix string, r2 int) string {
+	if strings.HasSuffix(word, suffix) && inRegion(word, suffix, r2) {
+		return word[:len(word)-len(suffix)]
+	}
+	return word
+}
+
+// markVowels upper-cases u and i between vowels, so that they are treated
+// as consonants.
+func (s ItalianStemmer) markVowels(word string) string {
+	runes := []rune(word)
+	for i := 1; i < len(runes)-1; i++ {
+		if (runes[i] == 'u' || runes[i] == 'i') && s.isVowel(runes[i-1]) && s.isVowel(runes[i+1]) {
+			runes[i] = unicode.ToUpper(runes[i])
+		}
+	}
+	return string(runes)
+}
+
+// isStopWord returns true if the given word is a stop word.
+func (s ItalianStemmer) isStopWord(word string) bool {
+	_, found := itStopWords[word]
+	return found
+}
+
+func (s ItalianStemmer) isVowel(r rune) bool {
+	switch r {
+	case 'a', 'e', 'i', 'o', 'u', 'à', 'è', 'ì', 'ò', 'ù':
+		return true
+	default:
+		return false
+	}
+}
+
+// regions returns the byte offsets of RV, R1 and R2.
+func (s ItalianStemmer) regions(word string) (int, int, int) {
+	r1, r2 := standardRegions(word, s.isVowel)
+	return romanceRV(word, s.isVowel), r1, r2
+}
@@ -0,0 +1,393 @@
+package stemmer
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewItalianStemmer(t *testing.T) {
+	s := NewItalianStemmer()
+	require.NotNil(t, s)
+}
+
+func TestItalianStemmer_suffixTables(t *testing.T) {
+	require.True(t, slices.IsSorted(itR2Suffixes))
+}
+
+func TestItalianStemmer_isStopWord(t *testing.T) {
+	s := NewItalianStemmer()
+	require.True(t, s.isStopWord("della"))
+	require.False(t, s.isStopWord("mela"))
+}
+
+func TestItalianStemmer_Stem(t *testing.T) {
+	s := NewItalianStemmer()
+	t.Run("stop word", func(t *testing.T) {
+		require.Equal(t, "della", s.Stem("della"))
+		require.Equal(t, "della", s.Stem("Della"))
+	})
+
+	t.Run("acute accents", func(t *testing.T) {
+		require.Equal(t, s.Stem("città"), s.Stem("cittá"))
+		require.Equal(t, s.Stem("caffè"), s.Stem("caffé"))
+	})
+
+	f := func(word, stem string) {
+		t.Helper()
+		require.Equal(t, stem, s.Stem(word))
+	}
+
+	f("abbandonare", "abbandon")
+	f("abbandonato", "abbandon")
+	f("abbastanza", "abbast")
+	f("abitanti", "abit")
+	f("abitazione", "abit")
+	f("abitudini", "abitudin")
+	f("accademia", "accadem")
+	f("accanto", "accant")
+	f("accettare", "accett")
+	f("accompagnato", "accompagn")
+	f("accordo", "accord")
+	f("accorgersi", "accorg")
+	f("acqua", "acqua")
+	f("addormentato", "addorment")
+	f("adesso", "adess")
+	f("affari", "affar")
+	f("affermazione", "afferm")
+	f("affettuosamente", "affettu")
+	f("aggiungere", "aggiung")
+	f("aiutarlo", "aiut")
+	f("allegramente", "allegr")
+	f("allegria", "allegr")
+	f("allontanarsi", "allontan")
+	f("alternative", "altern")
+	f("ambasciatore", "ambasc")
+	f("americani", "american")
+	f("amicizia", "amiciz")
+	f("ammirazione", "ammir")
+	f("amorevolmente", "amorevol")
+	f("andarsene", "andarsen")
+	f("andavano", "andav")
+	f("animali", "animal")
+	f("annunciarono", "annunc")
+	f("antichità", "antic")
+	f("apertamente", "apert")
+	f("apparecchi", "apparecc")
+	f("appartamento", "appart")
+	f("applausi", "applaus")
+	f("apprezzamento", "apprezz")
+	f("architettura", "architettur")
+	f("argomentazioni", "argoment")
+	f("arrivederci", "arrived")
+	f("assolutamente", "assolut")
+	f("attentamente", "attent")
+	f("attenzione", "attenzion")
+	f("attività", "attiv")
+	f("avanzamento", "avanz")
+	f("avvenimenti", "avven")
+	f("avventure", "avventur")
+	f("avvicinandosi", "avvicin")
+	f("bambini", "bambin")
+	f("bellezza", "bellezz")
+	f("benedizione", "benedizion")
+	f("bicchiere", "bicc")
+	f("biblioteche", "bibliotec")
+	f("bisognava", "bisogn")
+	f("bottiglia", "bottigl")
+	f("bruciato", "bruc")
+	f("buonissimo", "buonissim")
+	f("cambiamento", "camb")
+	f("camminando", "cammin")
+	f("campagna", "campagn")
+	f("cancellazione", "cancell")
+	f("cantavano", "cant")
+	f("capacità", "capac")
+	f("capitalismo", "capital")
+	f("caratteristiche", "caratterist")
+	f("carità", "carit")
+	f("cattolici", "cattol")
+	f("cavalieri", "cavalier")
+	f("celebrazione", "celebr")
+	f("certamente", "cert")
+	f("chiamarono", "chiam")
+	f("chiaramente", "chiar")
+	f("chiesero", "chieser")
+	f("cittadini", "cittadin")
+	f("civiltà", "civilt")
+	f("collaborazione", "collabor")
+	f("collezione", "collezion")
+	f("colpevole", "colpevol")
+	f("comandante", "comand")
+	f("combattimento", "combatt")
+	f("cominciavano", "cominc")
+	f("commerciali", "commercial")
+	f("completamente", "complet")
+	f("comportamento", "comport")
+	f("comprensione", "comprension")
+	f("comunicazione", "comun")
+	f("comunità", "comun")
+	f("concentrazione", "concentr")
+	f("condizioni", "condizion")
+	f("conoscenza", "conoscent")
+	f("consapevolezza", "consapevolezz")
+	f("conseguenze", "conseguent")
+	f("considerazione", "consider")
+	f("continuamente", "continu")
+	f("contraddizione", "contraddizion")
+	f("conversazione", "convers")
+	f("convinzione", "convinzion")
+	f("coraggiosamente", "coragg")
+	f("correttamente", "corrett")
+	f("costituzione", "costitu")
+	f("costruzioni", "costruzion")
+	f("creatività", "creativ")
+	f("crescente", "crescent")
+	f("criticamente", "critic")
+	f("cuciniamo", "cucin")
+	f("cultura", "cultur")
+	f("curiosità", "curios")
+	f("decisamente", "decis")
+	f("definitivamente", "definit")
+	f("delicatezza", "delicatezz")
+	f("democrazia", "democraz")
+	f("desiderio", "desider")
+	f("difficilmente", "difficil")
+	f("dimenticare", "dimentic")
+	f("dimostrazione", "dimostr")
+	f("direttamente", "dirett")
+	f("discussione", "discussion")
+	f("disperazione", "disper")
+	f("distruzione", "distruzion")
+	f("divertimento", "divert")
+	f("dolcemente", "dolcement")
+	f("domandarono", "domand")
+	f("economicamente", "econom")
+	f("educazione", "educ")
+	f("effettivamente", "effett")
+	f("elezioni", "elezion")
+	f("energicamente", "energ")
+	f("entusiasmo", "entusiasm")
+	f("esattamente", "esatt")
+	f("esperienze", "esperient")
+	f("esplicitamente", "esplicit")
+	f("evidentemente", "evident")
+	f("facilmente", "facil")
+	f("falsità", "falsit")
+	f("famiglie", "famigl")
+	f("fantastico", "fantast")
+	f("felicità", "felic")
+	f("fermamente", "ferm")
+	f("finalmente", "final")
+	f("fondamentale", "fondamental")
+	f("formazione", "formazion")
+	f("fortunatamente", "fortunat")
+	f("fratelli", "fratell")
+	f("frequentemente", "frequent")
+	f("generosità", "generos")
+	f("gentilezza", "gentilezz")
+	f("giornalisti", "giornal")
+	f("giovinezza", "giovinezz")
+	f("giustizia", "giustiz")
+	f("gloriosamente", "glorios")
+	f("governatore", "govern")
+	f("gradualmente", "gradual")
+	f("grandezza", "grandezz")
+	f("guardandolo", "guard")
+	f("identità", "ident")
+	f("illuminazione", "illumin")
+	f("immaginazione", "immagin")
+	f("immediatamente", "immediat")
+	f("importanza", "import")
+	f("impossibilità", "impossibil")
+	f("improvvisamente", "improvvis")
+	f("incredibilmente", "incredibil")
+	f("indipendenza", "indipendent")
+	f("indubbiamente", "indubb")
+	f("industriali", "industrial")
+	f("infelicità", "infel")
+	f("informazioni", "inform")
+	f("ingenuità", "ingenu")
+	f("inizialmente", "inizial")
+	f("inquinamento", "inquin")
+	f("insegnanti", "insegn")
+	f("intelligenza", "intelligent")
+	f("interamente", "inter")
+	f("internazionale", "internazional")
+	f("interpretazione", "interpret")
+	f("investimenti", "invest")
+	f("lavoratori", "lavor")
+	f("leggermente", "legger")
+	f("legislazione", "legisl")
+	f("lentamente", "lent")
+	f("letteratura", "letteratur")
+	f("libertà", "libert")
+	f("liberazione", "liber")
+	f("lontananza", "lontan")
+	f("luminosità", "luminos")
+	f("maggioranza", "maggior")
+	f("magnificamente", "magnif")
+	f("malattie", "malatt")
+	f("manifestazioni", "manifest")
+	f("maravigliosa", "maravigl")
+	f("matematica", "matemat")
+	f("mentalità", "mental")
+	f("meravigliosamente", "meravigl")
+	f("minacciando", "minacc")
+	f("ministero", "minister")
+	f("modernizzazione", "modernizz")
+	f("montagne", "montagn")
+	f("movimenti", "mov")
+	f("naturalmente", "natural")
+	f("necessariamente", "necessar")
+	f("negoziazioni", "negoz")
+	f("nervosamente", "nervos")
+	f("nobiltà", "nobilt")
+	f("normalmente", "normal")
+	f("nuovamente", "nuov")
+	f("obbligatoriamente", "obbligator")
+	f("occupazione", "occup")
+	f("onestamente", "onest")
+	f("operazioni", "oper")
+	f("opportunità", "opportun")
+	f("organizzazione", "organizz")
+	f("originalità", "original")
+	f("ospedali", "ospedal")
+	f("pacificamente", "pacif")
+	f("parlandogli", "parl")
+	f("particolarmente", "particolar")
+	f("partecipazione", "partecip")
+	f("pazienza", "pazienz")
+	f("pensieri", "pensier")
+	f("perfettamente", "perfett")
+	f("personalità", "personal")
+	f("pescatori", "pescator")
+	f("piacevolmente", "piacevol")
+	f("popolazione", "popol")
+	f("possibilità", "possibil")
+	f("preoccupazione", "preoccup")
+	f("presidente", "president")
+	f("probabilmente", "probabil")
+	f("produzione", "produzion")
+	f("professori", "professor")
+	f("profondamente", "profond")
+	f("programmazione", "programm")
+	f("proprietà", "propriet")
+	f("protezione", "protezion")
+	f("provincia", "provinc")
+	f("pubblicazioni", "pubblic")
+	f("qualità", "qualit")
+	f("quotidianamente", "quotidian")
+	f("raccomandazione", "raccomand")
+	f("rapidamente", "rapid")
+	f("realtà", "realt")
+	f("regolarmente", "regolar")
+	f("relazioni", "relazion")
+	f("religiosità", "religios")
+	f("responsabilità", "respons")
+	f("ricchezza", "ricchezz")
+	f("ricordandosi", "ricord")
+	f("riconoscimento", "riconosc")
+	f("rivoluzione", "rivolu")
+	f("rumorosamente", "rumor")
+	f("sapienza", "sapienz")
+	f("scientifiche", "scientif")
+	f("scrittori", "scrittor")
+	f("semplicemente", "semplic")
+	f("sensibilità", "sensibil")
+	f("sentimenti", "sent")
+	f("seriamente", "ser")
+	f("sicurezza", "sicurezz")
+	f("silenziosamente", "silenz")
+	f("sinceramente", "sincer")
+	f("società", "societ")
+	f("solitudine", "solitudin")
+	f("sorprendentemente", "sorprendent")
+	f("speranze", "speranz")
+	f("spiritualità", "spiritual")
+	f("stabilità", "stabil")
+	f("straordinariamente", "straordinar")
+	f("studenti", "student")
+	f("successivamente", "success")
+	f("tecnologie", "tecnolog")
+	f("televisione", "television")
+	f("tradizioni", "tradizion")
+	f("tranquillità", "tranquill")
+	f("trasformazione", "trasform")
+	f("ufficialmente", "ufficial")
+	f("umanità", "uman")
+	f("università", "univers")
+	f("velocità", "veloc")
+	f("veramente", "ver")
+	f("vittorie", "vittor")
+	f("volontà", "volont")
+	f("mangiarla", "mang")
+	f("mangiandolo", "mang")
+	f("dicendoglielo", "dic")
+	f("parlarne", "parl")
+	f("vederti", "ved")
+	f("portarcela", "port")
+	f("prenderselo", "prendersel")
+	f("farmi", "farm")
+	f("comprarle", "compr")
+	f("abbracciandoci", "abbracc")
+	f("parlerebbero", "parl")
+	f("mangeremmo", "mang")
+	f("finiscono", "fin")
+	f("capiscano", "cap")
+	f("credevano", "cred")
+	f("dormirono", "dorm")
+	f("cantassero", "cant")
+	f("leggevamo", "legg")
+	f("scrivereste", "scriv")
+	f("arriverà", "arriv")
+	f("partirò", "part")
+	f("amavate", "amav")
+	f("vendevate", "vend")
+	f("sentivamo", "sent")
+	f("cerchi", "cerc")
+	f("cerca", "cerc")
+	f("luoghi", "luog")
+	f("lunghe", "lung")
+	f("bianchi", "bianc")
+	f("guaio", "guai")
+	f("quaderno", "quadern")
+	f("quindici", "quindic")
+	f("acquistare", "acquist")
+	f("aiuola", "aiuol")
+	f("gioiello", "gioiell")
+}
+
+func TestItalianStemmer_markVowels(t *testing.T) {
+	s := NewItalianStemmer()
+
+	f := func(input, expected string) {
+		t.Helper()
+		require.Equal(t, expected, s.markVowels(input))
+	}
+
+	f("gioiello", "gioIello")
+	f("aiuola", "aIuola")
+	f("guaio", "guaIo")
+	f("libro", "libro")
+	f("", "")
+}
+
+func TestItalianStemmer_regions(t *testing.T) {
+	s := NewItalianStemmer()
+
+	f := func(word, rv, r1, r2 string) {
+		t.Helper()
+		rvStart, r1Start, r2Start := s.regions(word)
+		require.Equal(t, rv, word[rvStart:])
+		require.Equal(t, r1, word[r1Start:])
+		require.Equal(t, r2, word[r2Start:])
+	}
+
+	f("divano", "ano", "ano", "o")
+	f("amico", "co", "ico", "o")
+	f("aereo", "eo", "eo", "")
+	f("strada", "da", "a", "")
+	f("tu", "", "", "")
+}
@@ -0,0 +1,463 @@
+package stemmer
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+var (
+	kpStep1Suffixes = []string{"ies", "aus", "nde", "'s", "es", "en", "s"}
+	kpStep2Suffixes = []string{"lijke", "ische", "ieve", "ene", "je", "ge", "de", "te", "se", "re", "le"}
+	kpStep3Suffixes = []string{
+		"iteit", "rster", "atie", "heid", "ster", "isme", "erij", "arij",
+		"fie", "gie", "sel", "tst", "dst",
+	}
+	kpStep4Suffixes   = []string{"ioneel", "atief", "tueel", "baar", "naal", "ieel", "isch", "lijk"}
+	kpStep4IgSuffixes = []string{"iger", "igst", "ig"}
+	kpStep7Suffixes   = []string{"kt", "ft", "pt"}
+
+	kpConsonants = "bcdfghjklmnpqrstvwxz"
+)
+
+// KraaijPohlmannStemmer implements the Kraaij-Pohlmann algorithm for Dutch.
+// It stems more aggressively than DutchStemmer: it also removes diminutives
+// and the ge- prefix and infix of past participles, and restores long vowels
+// so that forms such as lopen and loop share a stem.
+type KraaijPohlmannStemmer struct{}
+
+// NewKraaijPohlmannStemmer creates a new KraaijPohlmannStemmer.
+func NewKraaijPohlmannStemmer() *KraaijPohlmannStemmer {
+	return &KraaijPohlmannStemmer{}
+}
+
+// Stem returns the stem of the given word.
+func (s KraaijPohlmannStemmer) Stem(word string) string {
+	word = strings.ToLower(word)
+	if s.isStopWord(word) {
+		return word
+	}
+
+	word = s.markY(word)
+	p1, p2 := s.regions(word)
+
+	word, ok1 := s.step1(word, p1)
+	word, ok2 := s.step2(word, p1)
+	word, ok3 := s.step3(word, p1, p2)
+	word, ok4 := s.step4(word, p1)
+
+	if stem, ok := s.losePrefix(word); ok {
+		p1, _ = s.regions(stem)
+		word = s.step1c(stem, p1)
+	}
+
+	var geRemoved bool
+	if stem, ok := s.loseInfix(word); ok {
+		p1, _ = s.regions(stem)
+		word = s.step1c(stem, p1)
+		geRemoved = true
+	}
+
+	word, ok7 := s.step7(word)
+	if ok1 || ok2 || ok3 || ok4 || ok7 || geRemoved {
+		word = s.step6(word)
+	}
+
+	return strings.ReplaceAll(word, "Y", "y")
+}
+
+// step1 removes plural and inflectional endings.
+func (s KraaijPohlmannStemmer) step1(word string, p1 int) (string, bool) {
+	suffix := longestSuffix(word, kpStep1Suffixes)
+	if suffix == "" {
+		return word, false
+	}
+	stem := word[:len(word)-len(suffix)]
+	inR1 := inRegion(word, suffix, p1)
+
+	switch suffix {
+	case "'s":
+		return stem, true
+
+	case "s":
+		if inR1 && !(strings.HasSuffix(stem, "t") && inRegion(stem, "t", p1)) && s.endsWithConsonant(stem) {
+			return stem, true
+		}
+
+	case "ies":
+		if inR1 {
+			return stem + "ie", true
+		}
+
+	case "es":
+		switch {
+		case strings.HasSuffix(stem, "ar") && inRegion(stem, "ar", p1) && s.endsWithConsonant(stem[:len(stem)-2]):
+			return s.lengthenVowel(stem[:len(stem)-2]), true
+		case strings.HasSuffix(stem, "er") && inRegion(stem, "er", p1) && s.endsWithConsonant(stem[:len(stem)-2]):
+			return stem[:len(stem)-2], true
+		case inR1 && s.endsWithConsonant(stem):
+			return stem + "e", true
+		}
+
+	case "aus":
+		if inR1 && s.endsWithVowel(stem) {
+			return stem + "au", true
+		}
+
+	case "en":
+		switch {
+		case strings.HasSuffix(stem, "hed") && inRegion(stem, "hed", p1):
+			return stem[:len(stem)-3] + "heid", true
+		case strings.HasSuffix(stem, "nd"):
+			return stem, true
+		case strings.HasSuffix(stem, "d") && inRegion(stem, "d", p1) && s.endsWithConsonant(stem[:len(stem)-1]):
+			return stem[:len(stem)-1], true
+		case (strings.HasSuffix(stem, "i") || strings.HasSuffix(stem, "j")) && s.endsWithVowel(stem[:len(stem)-1]):
+			return stem, true
+		case inR1 && s.endsWithConsonant(stem):
+			return s.lengthenVowel(stem), true
+		}
+
+	case "nde":
+		return stem + "nd", true
+	}
+	return word, false
+}
+
+// step2 removes diminutives and the e of inflected adjectives.
+func (s KraaijPohlmannStemmer) step2(word string, p1 int) (string, bool) {
+	suffix := longestSuffix(word, kpStep2Suffixes)
+	if suffix == "" {
+		return word, false
+	}
+	stem := word[:len(word)-len(suffix)]
+	inR1 := inRegion(word, suffix, p1)
+
+	switch suffix {
+	case "je":
+		switch {
+		case strings.HasSuffix(stem, "'t"):
+			return stem[:len(stem)-2], true
+		case strings.HasSuffix(stem, "et") && inRegion(stem, "et", p1) && s.endsWithConsonant(stem[:len(stem)-2]):
+			return stem[:len(stem)-2], true
+		case strings.HasSuffix(stem, "rnt"):
+			return stem[:len(stem)-1], true
+		case strings.HasSuffix(stem, "t") && inRegion(stem, "t", p1) && s.endsWithVowelBeforeLast(stem[:len(stem)-1]):
+			return stem[:len(stem)-1], true
+		case strings.HasSuffix(stem, "ink"):
+			return stem[:len(stem)-1] + "g", true
+		case strings.HasSuffix(stem, "mp"):
+			return stem[:len(stem)-1], true
+		case strings.HasSuffix(stem, "'") && inRegion(stem, "'", p1):
+			return stem[:len(stem)-1], true
+		case inR1 && s.endsWithConsonant(stem):
+			return stem, true
+		}
+		return word, false
+	}
+
+	if !inR1 {
+		return word, false
+	}
+
+	switch suffix {
+	case "ge", "te", "se", "re":
+		return stem + suffix[:1], true
+	case "lijke", "ische":
+		return stem + suffix[:4], true
+	case "le":
+		return s.lengthenVowel(stem + "l"), true
+	}
+
+	if !s.endsWithConsonant(stem) {
+		return word, false
+	}
+	switch suffix {
+	case "ene":
+		return s.lengthenVowel(stem + "en"), true
+	case "ieve":
+		return stem + "ief", true
+	}
+	return stem, true
+}
+
+// step3 removes derivational noun endings.
+func (s KraaijPohlmannStemmer) step3(word string, p1, p2 int) (string, bool) {
+	suffix := longestSuffix(word, kpStep3Suffixes)
+	if suffix == "" {
+		return word, false
+	}
+	stem := word[:len(word)-len(suffix)]
+
+	switch suffix {
+	case "fie", "gie":
+		if !inRegion(word, suffix, p2) {
+			return word, false
+		}
+		return s.lengthenVowel(stem + suffix[:1]), true
+	}
+
+	if !inRegion(word, suffix, p1) {
+		return word, false
+	}
+
+	switch suffix {
+	case "atie":
+		return stem + "eer", true
+	case "iteit", "isme", "erij":
+		return s.lengthenVowel(stem), true
+	case "rster":
+		return stem + "r", true
+	case "arij":
+		if !s.endsWithConsonant(stem) {
+			return word, false
+		}
+		return stem + "aar", true
+	case "tst", "dst":
+		if !s.endsWithConsonant(stem) {
+			return word, false
+		}
+		return stem + suffix[:1], true
+	}
+	return stem, true
+}
+
+// step4 removes derivational adjective endings.
+func (s KraaijPohlmannStemmer) step4(word string, p1 int) (string, bool) {
+	if suffix := longestSuffix(word, kpStep4Suffixes); suffix != "" && inRegion(word, suffix, p1) {
+		stem := word[:len(word)-len(suffix)]
+
+		switch suffix {
+		case "ioneel", "ieel":
+			return stem + "ie", true
+		case "atief":
+			return stem + "eer", true
+		case "tueel":
+			return stem + "tu", true
+		case "baar":
+			return stem, true
+		case "isch", "lijk":
+			return s.lengthenVowel(stem), true
+		case "naal":
+			if s.endsWithVowel(stem) {
+				return stem + "n", true
+			}
+		}
+	}
+
+	suffix := longestSuffix(word, kpStep4IgSuffixes)
+	if suffix == "" || !inRegion(word, suffix, p1) {
+		return word, false
+	}
+	stem := word[:len(word)-len(suffix)]
+	if !s.endsWithConsonant(stem) {
+		return word, false
+	}
+	return s.lengthenVowel(stem), true
+}
+
+// step1c removes the d or t that ends a past participle whose ge- has been
+// removed.
+func (s KraaijPohlmannStemmer) step1c(word string, p1 int) string {
+	if !strings.HasSuffix(word, "d") && !strings.HasSuffix(word, "t") {
+		return word
+	}
+	stem := word[:len(word)-1]
+	if len(stem) < p1 || !s.endsWithConsonant(stem) {
+		return word
+	}
+	return stem
+}
+
+// step6 undoubles a final consonant, or devoices a final v or z.
+func (s KraaijPohlmannStemmer) step6(word string) string {
+	last := lastRune(word)
+	if strings.ContainsRune(kpConsonants, last) && strings.HasSuffix(word, string([]rune{last, last})) {
+		return word[:len(word)-1]
+	}
+
+	switch last {
+	case 'v':
+		return word[:len(word)-1] + "f"
+	case 'z':
+		return word[:len(word)-1] + "s"
+	}
+	return word
+}
+
+func (s KraaijPohlmannStemmer) step7(word string) (string, bool) {
+	suffix := longestSuffix(word, kpStep7Suffixes)
+	if suffix == "" {
+		return word, false
+	}
+	return word[:len(word)-1], true
+}
+
+// losePrefix removes a leading ge that is followed by at least three
+// letters containing a vowel and a later non-vowel.
+func (s KraaijPohlmannStemmer) losePrefix(word string) (string, bool) {
+	if !strings.HasPrefix(word, "ge") || !s.isGeRemovable(word[2:]) {
+		return word, false
+	}
+	return word[2:], true
+}
+
+// loseInfix removes the first ge after the first letter, on the same
+// condition as losePrefix.
+func (s KraaijPohlmannStemmer) loseInfix(word string) (string, bool) {
+	if word == "" {
+		return word, false
+	}
+	_, size := utf8.DecodeRuneInString(word)
+	i := strings.Index(word[size:], "ge")
+	if i < 0 {
+		return word, false
+	}
+	i += size
+
+	if !s.isGeRemovable(word[i+2:]) {
+		return word, false
+	}
+	return word[:i] + word[i+2:], true
+}
+
+func (s KraaijPohlmannStemmer) isGeRemovable(rest string) bool {
+	if utf8.RuneCountInString(rest) < 3 {
+		return false
+	}
+	vowel := strings.IndexFunc(rest, s.isVowel)
+	return vowel >= 0 && strings.IndexFunc(rest[vowel:], func(r rune) bool { return !s.isVowel(r) }) >= 0
+}
+
+// lengthenVowel doubles the vowel of a final vowel-consonant pair when the
+// vowel is short but the syllable is open once the ending has gone, as in
+// lop from lopen, which becomes loop.
+func (s KraaijPohlmannStemmer) lengthenVowel(word string) string {
+	last, size := utf8.DecodeLastRuneInString(word)
+	if word == "" || s.isVowel(last) || last == 'w' || last == 'x' {
+		return word
+	}
+	rest := word[:len(word)-size]
+	if rest == "" {
+		return word
+	}
+	vowel := rest[len(rest)-1]
+	before := rest[:len(rest)-1]
+
+	switch vowel {
+	case 'a', 'o', 'u':
+		if before != "" && s.isVowel(lastRune(before)) {
+			return word
+		}
+	case 'e':
+		if before == "" {
+			break
+		}
+		r, size := utf8.DecodeLastRuneInString(before)
+		if s.isVowel(r) {
+			return word
+		}
+		// The e is kept short when an a, i, o or u comes right before its
+		// preceding consonant, or one letter further back.
+		before = before[:len(before)-size]
+		if strings.ContainsRune("aiou", lastRune(before)) {
+			return word
+		}
+		if before != "" {
+			_, size = utf8.DecodeLastRuneInString(before)
+			prev := before[:len(before)-size]
+			if strings.ContainsRune("aiou", lastRune(prev)) && len(prev) > 1 && !s.isVowel(lastRune(prev[:len(prev)-1])) {
+				return word
+			}
+		}
+	default:
+		return word
+	}
+	return rest + string(vowel) + word[len(rest):]
+}
+
+// markY upper-cases an initial y and a y after a vowel, so that they are
+// treated as consonants.
+func (s KraaijPohlmannStemmer) markY(word string) string {
+	runes := []rune(word)
+	for i, r := range runes {
+		if r == 'y' && (i == 0 || s.isVowel(runes[i-1])) {
+			runes[i] = 'Y'
+		}
+	}
+	return string(runes)
+}
+
+// endsWithVowel reports whether word ends with a vowel or ij.
+func (s KraaijPohlmannStemmer) endsWithVowel(word string) bool {
+	return strings.HasSuffix(word, "ij") || (word != "" && s.isVowel(lastRune(word)))
+}
+
+// endsWithVowelBeforeLast reports whether word without its last letter
+// ends with a vowel, or word ends with ij.
+func (s KraaijPohlmannStemmer) endsWithVowelBeforeLast(word string) bool {
+	if strings.HasSuffix(word, "ij") {
+		return true
+	}
+	if word == "" {
+		return false
+	}
+	_, size := utf8.DecodeLastRuneInString(word)
+	return s.endsWithVowel(word[:len(word)-size])
+}
+
+// endsWithConsonant reports whether word ends with a non-vowel that is not
+// the j of ij.
+func (s KraaijPohlmannStemmer) endsWithConsonant(word string) bool {
+	return word != "" && !strings.HasSuffix(word, "ij") && !s.isVowel(lastRune(word))
+}
+
+// isStopWord returns true if the given word is a stop word.
+func (s KraaijPohlmannStemmer) isStopWord(word string) bool {
+	_, found := nlStopWords[word]
+	return found
+}
+
+func (s KraaijPohlmannStemmer) isVowel(r rune) bool {
+	switch r {
+	case 'a', 'e', 'i', 'o', 'u', 'y':
+		return true
+	default:
+		return false
+	}
+}
+
+// regions returns the byte offsets of R1 and R2. Each region starts after
+// the first non-vowel that follows a run of vowels, ij counting as a vowel.
+func (s KraaijPohlmannStemmer) regions(word string) (int, int) {
+	r1 := s.regionAfterSyllable(word, 0)
+	return r1, s.regionAfterSyllable(word, r1)
+}
+
+func (s KraaijPohlmannStemmer) regionAfterSyllable(word string, start int) int {
+	i := start
+	for i < len(word) {
+		r, size := utf8.DecodeRuneInString(word[i:])
+		if s.isVowel(r) {
+			break
+		}
+		i += size
+	}
+
+	vowels := 0
+	for i < len(word) {
+		if strings.HasPrefix(word[i:], "ij") {
+			i += 2
+		} else if r, size := utf8.DecodeRuneInString(word[i:]); s.isVowel(r) {
+			i += size
+		} else {
+			break
+		}
+		vowels++
+	}
+	if vowels == 0 || i >= len(word) {
+		return len(word)
+	}
+
+	_, size := utf8.DecodeRuneInString(word[i:])
+	return i + size
+}
@@ -0,0 +1,171 @@
+package stemmer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewKraaijPohlmannStemmer(t *testing.T) {
+	s := NewKraaijPohlmannStemmer()
+	require.NotNil(t, s)
+}
+
+func TestKraaijPohlmannStemmer_isStopWord(t *testing.T) {
+	s := NewKraaijPohlmannStemmer()
+	require.True(t, s.isStopWord("hebben"))
+	require.False(t, s.isStopWord("huis"))
+}
+
+func TestKraaijPohlmannStemmer_Stem(t *testing.T) {
+	s := NewKraaijPohlmannStemmer()
+	t.Run("stop word", func(t *testing.T) {
+		require.Equal(t, "hebben", s.Stem("hebben"))
+		require.Equal(t, "hebben", s.Stem("Hebben"))
+	})
+
+	f := func(word, stem string) {
+		t.Helper()
+		require.Equal(t, stem, s.Stem(word))
+	}
+
+	f("lopen", "loop")
+	f("loop", "loop")
+	f("gelopen", "loop")
+	f("boeken", "boek")
+	f("huisjes", "huis")
+	f("huisje", "huis")
+	f("huizen", "huis")
+	f("bedden", "bed")
+	f("gegeven", "geef")
+	f("geven", "geef")
+	f("gewerkt", "werk")
+	f("werken", "werk")
+	f("oplossen", "oplos")
+	f("opgelost", "oplos")
+	f("boompje", "boom")
+	f("kettinkje", "ketting")
+	f("balletje", "bal")
+	f("kopje", "kop")
+	f("jongetje", "jong")
+	f("straatjes", "straat")
+	f("meisjes", "meis")
+	f("nationaal", "nation")
+	f("traditioneel", "traditie")
+	f("informatief", "informeer")
+	f("draagbaar", "draag")
+	f("logisch", "loog")
+	f("vriendelijk", "vriende")
+	f("mogelijkheden", "moge")
+	f("mogelijkheid", "moge")
+	f("leven", "leef")
+	f("levens", "leven")
+	f("gereden", "reed")
+	f("kinderen", "kinder")
+	f("koninginnen", "koningin")
+	f("auto's", "auto")
+	f("actueel", "actu")
+	f("materieel", "materie")
+	f("schoonheid", "schoon")
+	f("bakkerij", "bak")
+	f("fotografie", "fotograaf")
+	f("zangeres", "zang")
+	f("heerlijke", "heer")
+	f("telefoons", "telefoon")
+	f("appels", "appel")
+	f("ernstige", "ernst")
+	f("prachtig", "pracht")
+	f("lezen", "lees")
+	f("gelezen", "lees")
+	f("wonen", "woon")
+	f("gewoond", "woon")
+	f("maken", "maak")
+	f("gemaakt", "maak")
+	f("maakt", "maak")
+	f("producten", "product")
+	f("fiets", "fiet")
+	f("fietsen", "fiets")
+	f("lachende", "lachend")
+	f("bewegingen", "beweging")
+	f("regering", "rering")
+	f("achtig", "acht")
+	f("nodig", "nood")
+	f("nodige", "nood")
+	f("ijverig", "ijveer")
+	f("eenheid", "een")
+	f("waarheden", "waar")
+	f("zekerheid", "zeker")
+	f("broederlijk", "broeder")
+	f("eetbaar", "eet")
+	f("dankbaarheid", "dank")
+	f("aaien", "aai")
+	f("kraaiende", "kraaiend")
+	f("mooiste", "mooist")
+	f("brullen", "brul")
+	f("bakkers", "bakker")
+	f("stoppen", "stop")
+	f("zetten", "zet")
+	f("kussen", "kus")
+	f("kussens", "kussen")
+	f("reizen", "reis")
+	f("reizigers", "reis")
+	f("groeien", "groei")
+	f("vrouwen", "vrouw")
+	f("mannen", "man")
+	f("lange", "lang")
+	f("schrijven", "schrijf")
+	f("geschreven", "schreef")
+	f("verliezen", "verlies")
+	f("verloren", "verloor")
+	f("gemeenschappen", "meenschap")
+	f("wetenschappers", "wetenschapper")
+	f("landschappen", "landschap")
+	f("ziekenhuizen", "ziekenhuis")
+	f("gezien", "zien")
+	f("gebeurd", "beur")
+	f("steden", "steed")
+	f("gemeente", "meen")
+	f("provincies", "provincie")
+	f("universiteiten", "univers")
+	f("activiteiten", "actif")
+	f("kwaliteit", "kwaal")
+	f("realiteit", "real")
+	f("loyaal", "loyaal")
+	f("loyale", "loyaal")
+	f("yoga", "yoga")
+}
+
+func TestKraaijPohlmannStemmer_lengthenVowel(t *testing.T) {
+	s := NewKraaijPohlmannStemmer()
+
+	f := func(word, lengthened string) {
+		t.Helper()
+		require.Equal(t, lengthened, s.lengthenVowel(word))
+	}
+
+	f("lop", "loop")
+	f("mak", "maak")
+	f("lez", "leez")
+	f("gegev", "gegeev")
+	f("boek", "boek")
+	f("bakk", "bakk")
+	f("kous", "kous")
+	f("law", "law")
+	f("", "")
+}
+
+func TestKraaijPohlmannStemmer_regions(t *testing.T) {
+	s := NewKraaijPohlmannStemmer()
+
+	f := func(word, r1, r2 string) {
+		t.Helper()
+		r1Start, r2Start := s.regions(word)
+		require.Equal(t, r1, word[r1Start:])
+		require.Equal(t, r2, word[r2Start:])
+	}
+
+	f("lopen", "en", "")
+	f("rijden", "en", "")
+	f("opgelost", "gelost", "ost")
+	f("straat", "", "")
+}
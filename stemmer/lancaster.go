@@ -0,0 +1,210 @@
+package stemmer
+
+import "strings"
+
+// lancasterRule is a rule of the Paice/Husk rule table. The published table
+// writes endings reversed; here they are stored as they appear in words.
+type lancasterRule struct {
+	ending string
+	// intact restricts the rule to words no other rule has changed yet.
+	intact bool
+	// remove is the number of letters to remove before appending.
+	remove int
+	append string
+	// stop ends stemming once the rule is applied.
+	stop bool
+}
+
+// lancasterRules is the standard Paice/Husk rule table. Rules for the same
+// final letter are tried in order.
+var lancasterRules = []lancasterRule{
+	{"ia", true, 2, "", true},
+	{"a", true, 1, "", true},
+	{"bb", false, 1, "", true},
+	{"ytic", false, 3, "s", true},
+	{"ic", false, 2, "", false},
+	{"nc", false, 1, "t", false},
+	{"dd", false, 1, "", true},
+	{"ied", false, 3, "y", false},
+	{"ceed", false, 2, "ss", true},
+	{"eed", false, 1, "", true},
+	{"ed", false, 2, "", false},
+	{"hood", false, 4, "", false},
+	{"e", false, 1, "", false},
+	{"lief", false, 1, "v", true},
+	{"if", false, 2, "", false},
+	{"ing", false, 3, "", false},
+	{"iag", false, 3, "y", true},
+	{"ag", false, 2, "", false},
+	{"gg", false, 1, "", true},
+	{"th", true, 2, "", true},
+	{"guish", false, 5, "ct", true},
+	{"ish", false, 3, "", false},
+	{"i", true, 1, "", true},
+	{"i", false, 1, "y", false},
+	{"ij", false, 1, "d", true},
+	{"fuj", false, 1, "s", true},
+	{"uj", false, 1, "d", true},
+	{"oj", false, 1, "d", true},
+	{"hej", false, 1, "r", true},
+	{"verj", false, 1, "t", true},
+	{"misj", false, 2, "t", true},
+	{"nj", false, 1, "d", true},
+	{"j", false, 1, "s", true},
+	{"ifiabl", false, 6, "", true},
+	{"iabl", false, 4, "y", true},
+	{"abl", false, 3, "", false},
+	{"ibl", false, 3, "", true},
+	{"bil", false, 2, "l", false},
+	{"cl", false, 1, "", true},
+	{"iful", false, 4, "y", true},
+	{"ful", false, 3, "", false},
+	{"ul", false, 2, "", true},
+	{"ial", false, 3, "", false},
+	{"ual", false, 3, "", false},
+	{"al", false, 2, "", false},
+	{"ll", false, 1, "", true},
+	{"ium", false, 3, "", true},
+	{"um", true, 2, "", true},
+	{"ism", false, 3, "", false},
+	{"mm", false, 1, "", true},
+	{"sion", false, 4, "j", false},
+	{"xion", false, 4, "ct", true},
+	{"ion", false, 3, "", false},
+	{"ian", false, 3, "", false},
+	{"an", false, 2, "", false},
+	{"een", false, 0, "", true},
+	{"en", false, 2, "", false},
+	{"nn", false, 1, "", true},
+	{"ship", false, 4, "", false},
+	{"pp", false, 1, "", true},
+	{"er", false, 2, "", false},
+	{"ear", false, 0, "", true},
+	{"ar", false, 2, "", true},
+	{"or", false, 2, "", false},
+	{"ur", false, 2, "", false},
+	{"rr", false, 1, "", true},
+	{"tr", false, 1, "", false},
+	{"ier", false, 3, "y", false},
+	{"ies", false, 3, "y", false},
+	{"sis", false, 2, "", true},
+	{"is", false, 2, "", false},
+	{"ness", false, 4, "", false},
+	{"ss", false, 0, "", true},
+	{"ous", false, 3, "", false},
+	{"us", true, 2, "", true},
+	{"s", true, 1, "", false},
+	{"s", false, 0, "", true},
+	{"plicat", false, 4, "y", true},
+	{"at", false, 2, "", false},
+	{"ment", false, 4, "", false},
+	{"ent", false, 3, "", false},
+	{"ant", false, 3, "", false},
+	{"ript", false, 2, "b", true},
+	{"orpt", false, 2, "b", true},
+	{"duct", false, 1, "", true},
+	{"sumpt", false, 2, "", true},
+	{"cept", false, 2, "iv", true},
+	{"olut", false, 2, "v", true},
+	{"sist", false, 0, "", true},
+	{"ist", false, 3, "", false},
+	{"tt", false, 1, "", true},
+	{"iqu", false, 3, "", true},
+	{"ogu", false, 1, "", true},
+	{"siv", false, 3, "j", false},
+	{"eiv", false, 0, "", true},
+	{"iv", false, 2, "", false},
+	{"bly", false, 1, "", false},
+	{"ily", false, 3, "y", false},
+	{"ply", false, 0, "", true},
+	{"ly", false, 2, "", false},
+	{"ogy", false, 1, "", true},
+	{"phy", false, 1, "", true},
+	{"omy", false, 1, "", true},
+	{"opy", false, 1, "", true},
+	{"ity", false, 3, "", false},
+	{"ety", false, 3, "", false},
+	{"lty", false, 2, "", true},
+	{"istry", false, 5, "", true},
+	{"ary", false, 3, "", false},
+	{"ory", false, 3, "", false},
+	{"ify", false, 3, "", true},
+	{"ncy", false, 2, "t", false},
+	{"acy", false, 3, "", false},
+	{"iz", false, 2, "", false},
+	{"yz", false, 1, "s", true},
+}
+
+// LancasterStemmer implements the Lancaster (Paice/Husk) stemmer for English.
+// It applies the rules of a table repeatedly and is the most aggressive of
+// the English stemmers.
+type LancasterStemmer struct{}
+
+// NewLancasterStemmer creates a new LancasterStemmer.
+func NewLancasterStemmer() *LancasterStemmer {
+	return &LancasterStemmer{}
+}
+
+// Stem returns the stem of the given word.
+func (s LancasterStemmer) Stem(word string) string {
+	word = strings.ToLower(word)
+	if s.isStopWord(word) {
+		return word
+	}
+
+	intact := word
+	for {
+		rule, found := s.findRule(word, word == intact)
+		if !found {
+			return word
+		}
+		word = word[:len(word)-rule.remove] + rule.append
+		if rule.stop {
+			return word
+		}
+	}
+}
+
+// findRule returns the first rule that matches the end of word and leaves an
+// acceptable stem.
+func (s LancasterStemmer) findRule(word string, intact bool) (lancasterRule, bool) {
+	for _, rule := range lancasterRules {
+		if rule.intact && !intact {
+			continue
+		}
+		if strings.HasSuffix(word, rule.ending) && s.isAcceptable(word, rule.remove) {
+			return rule, true
+		}
+	}
+	return lancasterRule{}, false
+}
+
+// isAcceptable reports whether removing remove letters from word leaves an
+// acceptable stem: at least two letters if word starts with a vowel,
+// otherwise at least three letters with a vowel among the second and third.
+func (s LancasterStemmer) isAcceptable(word string, remove int) bool {
+	runes := []rune(word)
+	if len(runes) == 0 {
+		return false
+	}
+	length := len(runes) - remove
+	if s.isVowel(runes[0]) {
+		return length >= 2
+	}
+	return length >= 3 && (s.isVowel(runes[1]) || s.isVowel(runes[2]))
+}
+
+// isStopWord returns true if the given word is a stop word.
+func (s LancasterStemmer) isStopWord(word string) bool {
+	_, found := enStopWords[word]
+	return found
+}
+
+func (s LancasterStemmer) isVowel(r rune) bool {
+	switch r {
+	case 'a', 'e', 'i', 'o', 'u', 'y':
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,71 @@
+package stemmer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewLancasterStemmer(t *testing.T) {
+	s := NewLancasterStemmer()
+	require.NotNil(t, s)
+}
+
+func TestLancasterStemmer_isStopWord(t *testing.T) {
+	s := NewLancasterStemmer()
+	require.True(t, s.isStopWord("the"))
+	require.False(t, s.isStopWord("maximum"))
+}
+
+func TestLancasterStemmer_isAcceptable(t *testing.T) {
+	s := NewLancasterStemmer()
+
+	f := func(word string, remove int, expected bool) {
+		t.Helper()
+		require.Equal(t, expected, s.isAcceptable(word, remove), "%s %d", word, remove)
+	}
+
+	f("owed", 2, true)
+	f("owed", 3, false)
+	f("cement", 3, true)
+	f("cement", 4, false)
+	f("string", 3, false)
+	f("spray", 2, false)
+	f("prayed", 2, true)
+	f("", 0, false)
+}
+
+func TestLancasterStemmer_Stem(t *testing.T) {
+	s := NewLancasterStemmer()
+	t.Run("stop word", func(t *testing.T) {
+		require.Equal(t, "the", s.Stem("the"))
+	})
+
+	f := func(word, stem string) {
+		t.Helper()
+		require.Equal(t, stem, s.Stem(word))
+	}
+
+	f("maximum", "maxim")
+	f("Maximum", "maxim")
+	f("presumably", "presum")
+	f("multiply", "multiply")
+	f("provision", "provid")
+	f("owed", "ow")
+	f("ear", "ear")
+	f("saying", "say")
+	f("crying", "cry")
+	f("string", "string")
+	f("meant", "meant")
+	f("cement", "cem")
+	f("nationally", "nat")
+	f("organization", "org")
+	f("happiness", "happy")
+	f("dependent", "depend")
+	f("absorption", "absorb")
+	f("triplicate", "triply")
+	f("controlling", "control")
+	f("readily", "ready")
+	f("pension", "pend")
+	f("kindness", "kind")
+}
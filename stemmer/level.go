@@ -0,0 +1,13 @@
+package stemmer
+
+// Level selects how aggressively a stemmer that supports it removes endings.
+type Level int
+
+const (
+	// LevelFull runs the complete algorithm, including derivational steps.
+	LevelFull Level = iota
+	// LevelLight only removes inflectional endings, such as plurals,
+	// possessives and case endings, so that derivationally related words
+	// like "general" and "generate" stay apart.
+	LevelLight
+)
@@ -0,0 +1,214 @@
+package stemmer
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+var (
+	ltStopWords = map[string]struct{}{
+		"ant":    {},
+		"apie":   {},
+		"ar":     {},
+		"arba":   {},
+		"aš":     {},
+		"be":     {},
+		"bet":    {},
+		"bus":    {},
+		"buvo":   {},
+		"būti":   {},
+		"dar":    {},
+		"dėl":    {},
+		"iki":    {},
+		"ir":     {},
+		"iš":     {},
+		"jau":    {},
+		"ji":     {},
+		"jie":    {},
+		"jis":    {},
+		"jo":     {},
+		"jos":    {},
+		"jūs":    {},
+		"jūsų":   {},
+		"jų":     {},
+		"kad":    {},
+		"kai":    {},
+		"kaip":   {},
+		"kas":    {},
+		"kiek":   {},
+		"kokia":  {},
+		"koks":   {},
+		"kur":    {},
+		"kuri":   {},
+		"kurie":  {},
+		"kurios": {},
+		"kuris":  {},
+		"labai":  {},
+		"mano":   {},
+		"mes":    {},
+		"mūsų":   {},
+		"ne":     {},
+		"nei":    {},
+		"nes":    {},
+		"net":    {},
+		"nuo":    {},
+		"o":      {},
+		"pas":    {},
+		"per":    {},
+		"po":     {},
+		"prie":   {},
+		"savo":   {},
+		"su":     {},
+		"ta":     {},
+		"tai":    {},
+		"taip":   {},
+		"tarp":   {},
+		"tas":    {},
+		"tavo":   {},
+		"ten":    {},
+		"tie":    {},
+		"tik":    {},
+		"tos":    {},
+		"tu":     {},
+		"už":     {},
+		"vis":    {},
+		"yra":    {},
+		"čia":    {},
+		"į":      {},
+		"ši":     {},
+		"šie":    {},
+		"šios":   {},
+		"šis":    {},
+	}
+
+	// ltEndings are the noun, adjective and verb endings removed in step 1.
+	// The diphthong endings ai, ei and uo are listed whole, so that they are
+	// removed together instead of leaving half a diphthong on the stem.
+	ltEndings = []string{
+		"iausiomis", "iausiuose", "iuosiuose", "esniuose", "iaisiais", "iausiais",
+		"iausiame", "iausiems", "iausioje", "iausioms", "iausiose", "iosiomis",
+		"uosiuose", "aisiais", "esniais", "esniame", "esniems", "esnėmis",
+		"iausiai", "iausiam", "iausias", "iausios", "iausius", "iesiems",
+		"iosiose", "iuosius", "osiomis", "davome", "davote", "enimis", "erimis",
+		"esniam", "esnius", "esnėje", "esnėms", "esnėse", "iajame", "iausia",
+		"iausio", "iausiu", "iausią", "iausių", "iojoje", "iosios", "iąsias",
+		"osioms", "osiose", "siuosi", "tumėme", "tumėte", "uosius", "ajame",
+		"antis", "damas", "davai", "davau", "enims", "eniui", "enyje", "enyse",
+		"eriai", "erims", "eryje", "eryse", "esnei", "esnes", "esnio", "esnis",
+		"esniu", "esnių", "esnės", "iajai", "iajam", "iasis", "iausi", "intis",
+		"iomis", "iumis", "iuoju", "iuose", "ojoje", "osios", "siesi", "simės",
+		"sitės", "tumei", "tumėm", "usios", "ąsias", "aisi", "ajai", "ajam",
+		"amės", "asis", "atės", "ausi", "dama", "dami", "davo", "enie", "enis",
+		"eniu", "enys", "eria", "erie", "eris", "erys", "esne", "esni", "esnė",
+		"esnę", "esnį", "iais", "iame", "iams", "iate", "iaus", "ieji", "iesi",
+		"imis", "ioje", "ioji", "iojo", "ioms", "iose", "iuje", "iumi", "iums",
+		"iąja", "iąją", "iąjį", "iųjų", "kime", "kite", "omis", "omės", "otės",
+		"sime", "site", "tume", "tute", "tųsi", "umis", "uoju", "uose", "uosi",
+		"usis", "ysis", "ąsis", "čiau", "ėmis", "ėmės", "ėtės", "ais", "ame",
+		"ams", "ant", "asi", "ate", "aus", "ens", "enį", "enų", "erį", "erų",
+		"iai", "ias", "iau", "ies", "imi", "ims", "int", "ios", "iui", "ius",
+		"iūs", "oje", "oji", "ojo", "ome", "oms", "ose", "osi", "ote", "oti",
+		"sis", "siu", "tis", "tum", "uje", "umi", "ums", "usi", "yje", "yse",
+		"yti", "ąja", "ąją", "ąjį", "ėje", "ėme", "ėms", "ėse", "ėsi", "ėte",
+		"ėti", "ųjų", "ai", "am", "as", "au", "ei", "es", "ia", "ie", "io", "is",
+		"iu", "ią", "ių", "os", "si", "ti", "tų", "ui", "uo", "us", "ys", "ąs",
+		"ės", "ęs", "ūs", "a", "e", "i", "k", "o", "s", "u", "y", "ą", "ė", "ę",
+		"į", "ų",
+	}
+
+	// ltStemSuffixes are the verb stem formants removed in step 2.
+	ltStemSuffixes = []string{"uoj", "ij", "oj", "ėj", "uo", "av"}
+)
+
+type LithuanianStemmer struct{}
+
+// NewLithuanianStemmer creates a new LithuanianStemmer.
+func NewLithuanianStemmer() *LithuanianStemmer {
+	return &LithuanianStemmer{}
+}
+
+// Stem returns the stem of the given word.
+func (s LithuanianStemmer) Stem(word string) string {
+	word = strings.ToLower(word)
+	if s.isStopWord(word) {
+		return word
+	}
+
+	r1 := s.region(word)
+
+	word = s.fixConflicts(word)
+	word = s.trimEnding(word, r1)
+	word = s.fixChDz(word)
+	for {
+		stem := s.trimStemSuffix(word, r1)
+		if stem == word {
+			break
+		}
+		word = stem
+	}
+	word = s.fixChDz(word)
+
+	if strings.HasSuffix(word, "gd") {
+		word = word[:len(word)-1]
+	}
+	return word
+}
+
+// fixConflicts restores the ė of the vocative endings -aite and -uote, so
+// that they are removed like the rest of the -aitė and -uotė forms instead of
+// being taken for the verb ending -ote.
+func (s LithuanianStemmer) fixConflicts(word string) string {
+	if strings.HasSuffix(word, "aite") || strings.HasSuffix(word, "uote") {
+		return word[:len(word)-1] + "ė"
+	}
+	return word
+}
+
+// trimEnding removes the longest ending inside R1.
+func (s LithuanianStemmer) trimEnding(word string, r1 int) string {
+	ending := longestSuffix(region(word, r1), ltEndings)
+	return word[:len(word)-len(ending)]
+}
+
+// trimStemSuffix removes a verb stem formant inside R1.
+func (s LithuanianStemmer) trimStemSuffix(word string, r1 int) string {
+	suffix := longestSuffix(region(word, r1), ltStemSuffixes)
+	return word[:len(word)-len(suffix)]
+}
+
+// fixChDz undoes the palatalisation of t and d before an ending, which turns
+// them into č and dž.
+func (s LithuanianStemmer) fixChDz(word string) string {
+	switch {
+	case strings.HasSuffix(word, "č"):
+		return strings.TrimSuffix(word, "č") + "t"
+	case strings.HasSuffix(word, "dž"):
+		return strings.TrimSuffix(word, "dž") + "d"
+	}
+	return word
+}
+
+// isStopWord returns true if the given word is a stop word.
+func (s LithuanianStemmer) isStopWord(word string) bool {
+	_, found := ltStopWords[word]
+	return found
+}
+
+func (s LithuanianStemmer) isVowel(r rune) bool {
+	switch r {
+	case 'a', 'e', 'i', 'y', 'o', 'u', 'ą', 'ę', 'į', 'ų', 'ė', 'ū':
+		return true
+	default:
+		return false
+	}
+}
+
+// region returns the byte offset of R1. In words longer than six letters
+// that start with a, the a is skipped first, since it is usually a prefix.
+func (s LithuanianStemmer) region(word string) int {
+	start := 0
+	if strings.HasPrefix(word, "a") && utf8.RuneCountInString(word) > 6 {
+		start = 1
+	}
+	return regionAfterVowelConsonant(word, start, s.isVowel)
+}
@@ -0,0 +1,118 @@
+package stemmer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewLithuanianStemmer(t *testing.T) {
+	s := NewLithuanianStemmer()
+	require.NotNil(t, s)
+}
+
+func TestLithuanianStemmer_isStopWord(t *testing.T) {
+	s := NewLithuanianStemmer()
+	require.True(t, s.isStopWord("ir"))
+	require.False(t, s.isStopWord("namas"))
+}
+
+func TestLithuanianStemmer_Stem(t *testing.T) {
+	s := NewLithuanianStemmer()
+	t.Run("stop word", func(t *testing.T) {
+		require.Equal(t, "ir", s.Stem("ir"))
+		require.Equal(t, "jūs", s.Stem("Jūs"))
+	})
+
+	f := func(word, stem string) {
+		t.Helper()
+		require.Equal(t, stem, s.Stem(word))
+	}
+
+	f("vyras", "vyr")
+	f("vyro", "vyr")
+	f("vyrui", "vyr")
+	f("vyrą", "vyr")
+	f("vyru", "vyr")
+	f("vyre", "vyr")
+	f("vyrai", "vyr")
+	f("vyrų", "vyr")
+	f("vyrams", "vyr")
+	f("vyrus", "vyr")
+	f("vyruose", "vyr")
+	f("namas", "nam")
+	f("namai", "nam")
+	f("namuose", "nam")
+	f("mergaitė", "mergait")
+	f("mergaitės", "mergait")
+	f("mergaite", "mergait")
+	f("mergaitei", "mergait")
+	f("galva", "galv")
+	f("galvos", "galv")
+	f("galvai", "galv")
+	f("galvą", "galv")
+	f("galvoje", "galv")
+	f("galvomis", "galv")
+	f("medis", "med")
+	f("medžio", "med")
+	f("medžiai", "med")
+	f("medžių", "med")
+	f("svečias", "svet")
+	f("svečio", "svet")
+	f("svetys", "svet")
+	f("akmuo", "akm")
+	f("akmens", "akm")
+	f("akmenys", "akmen")
+	f("vanduo", "vand")
+	f("vandens", "vand")
+	f("vandenyje", "vand")
+	f("sūnus", "sūn")
+	f("sūnaus", "sūn")
+	f("sūnūs", "sūn")
+	f("gražus", "graž")
+	f("gražesnis", "graž")
+	f("gražiausias", "graž")
+	f("didysis", "did")
+	f("didžiojo", "did")
+	f("dainuoti", "dainu")
+	f("dainuoja", "dain")
+	f("dainavo", "dain")
+	f("dainuosime", "dain")
+	f("dirbti", "dirb")
+	f("dirba", "dirb")
+	f("dirbo", "dirb")
+	f("dirbsiu", "dirb")
+	f("dirbtų", "dirb")
+	f("kalbėti", "kalb")
+	f("kalba", "kalb")
+	f("kalbėjo", "kalb")
+	f("kalbėjau", "kalb")
+	f("mokykla", "mokykl")
+	f("mokyklose", "mokykl")
+	f("universitetas", "universitet")
+	f("universitete", "universitet")
+	f("Lietuva", "lietuv")
+	f("Lietuvos", "lietuv")
+	f("lietuvių", "lietuv")
+	f("knyga", "knyg")
+	f("knygos", "knyg")
+	f("knygose", "knyg")
+	f("aplinka", "aplink")
+	f("aplinkos", "aplink")
+	f("apsaugoti", "apsaug")
+}
+
+func TestLithuanianStemmer_region(t *testing.T) {
+	s := NewLithuanianStemmer()
+
+	f := func(word, r1 string) {
+		t.Helper()
+		require.Equal(t, r1, word[s.region(word):])
+	}
+
+	f("namas", "as")
+	f("galvoje", "voje")
+	f("aplinkos", "kos")
+	f("akmuo", "muo")
+	f("ne", "")
+}
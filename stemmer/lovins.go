@@ -0,0 +1,282 @@
+package stemmer
+
+import "strings"
+
+// lovinsEnding is an entry of the Lovins ending table: the ending and the
+// letter of the condition the stem left after removing it must satisfy.
+type lovinsEnding struct {
+	ending    string
+	condition string
+}
+
+var (
+	lovinsEndings = []lovinsEnding{
+		{"alistically", "B"}, {"arizability", "A"}, {"izationally", "B"},
+
+		{"antialness", "A"}, {"arisations", "A"}, {"arizations", "A"}, {"entialness", "A"},
+
+		{"allically", "C"}, {"antaneous", "A"}, {"antiality", "A"}, {"arisation", "A"},
+		{"arization", "A"}, {"ationally", "B"}, {"ativeness", "A"}, {"eableness", "E"},
+		{"entations", "A"}, {"entiality", "A"}, {"entialize", "A"}, {"entiation", "A"},
+		{"ionalness", "A"}, {"istically", "A"}, {"itousness", "A"}, {"izability", "A"},
+		{"izational", "A"},
+
+		{"ableness", "A"}, {"arizable", "A"}, {"entation", "A"}, {"entially", "A"},
+		{"eousness", "A"}, {"ibleness", "A"}, {"icalness", "A"}, {"ionalism", "A"},
+		{"ionality", "A"}, {"ionalize", "A"}, {"iousness", "A"}, {"izations", "A"},
+		{"lessness", "A"},
+
+		{"ability", "A"}, {"aically", "A"}, {"alistic", "B"}, {"alities", "A"},
+		{"ariness", "E"}, {"aristic", "A"}, {"arizing", "A"}, {"ateness", "A"},
+		{"atingly", "A"}, {"ational", "B"}, {"atively", "A"}, {"ativism", "A"},
+		{"elihood", "E"}, {"encible", "A"}, {"entally", "A"}, {"entials", "A"},
+		{"entiate", "A"}, {"entness", "A"}, {"fulness", "A"}, {"ibility", "A"},
+		{"icalism", "A"}, {"icalist", "A"}, {"icality", "A"}, {"icalize", "A"},
+		{"ication", "G"}, {"icianry", "A"}, {"ination", "A"}, {"ingness", "A"},
+		{"ionally", "A"}, {"isation", "A"}, {"ishness", "A"}, {"istical", "A"},
+		{"iteness", "A"}, {"iveness", "A"}, {"ivistic", "A"}, {"ivities", "A"},
+		{"ization", "F"}, {"izement", "A"}, {"oidally", "A"}, {"ousness", "A"},
+
+		{"aceous", "A"}, {"acious", "B"}, {"action", "G"}, {"alness", "A"},
+		{"ancial", "A"}, {"ancies", "A"}, {"ancing", "B"}, {"ariser", "A"},
+		{"arized", "A"}, {"arizer", "A"}, {"atable", "A"}, {"ations", "B"},
+		{"atives", "A"}, {"eature", "Z"}, {"efully", "A"}, {"encies", "A"},
+		{"encing", "A"}, {"ential", "A"}, {"enting", "C"}, {"entist", "A"},
+		{"eously", "A"}, {"ialist", "A"}, {"iality", "A"}, {"ialize", "A"},
+		{"ically", "A"}, {"icance", "A"}, {"icians", "A"}, {"icists", "A"},
+		{"ifully", "A"}, {"ionals", "A"}, {"ionate", "D"}, {"ioning", "A"},
+		{"ionist", "A"}, {"iously", "A"}, {"istics", "A"}, {"izable", "E"},
+		{"lessly", "A"}, {"nesses", "A"}, {"oidism", "A"},
+
+		{"acies", "A"}, {"acity", "A"}, {"aging", "B"}, {"aical", "A"},
+		{"alist", "A"}, {"alism", "B"}, {"ality", "A"}, {"alize", "A"},
+		{"allic", "BB"}, {"anced", "B"}, {"ances", "B"}, {"antic", "C"},
+		{"arial", "A"}, {"aries", "A"}, {"arily", "A"}, {"arity", "B"},
+		{"arize", "A"}, {"aroid", "A"}, {"ately", "A"}, {"ating", "I"},
+		{"ation", "B"}, {"ative", "A"}, {"ators", "A"}, {"atory", "A"},
+		{"ature", "E"}, {"early", "Y"}, {"ehood", "A"}, {"eless", "A"},
+		{"elity", "A"}, {"ement", "A"}, {"enced", "A"}, {"ences", "A"},
+		{"eness", "E"}, {"ening", "E"}, {"ental", "A"}, {"ented", "C"},
+		{"ently", "A"}, {"fully", "A"}, {"ially", "A"}, {"icant", "A"},
+		{"ician", "A"}, {"icide", "A"}, {"icism", "A"}, {"icist", "A"},
+		{"icity", "A"}, {"idine", "I"}, {"iedly", "A"}, {"ihood", "A"},
+		{"inate", "A"}, {"iness", "A"}, {"ingly", "B"}, {"inism", "J"},
+		{"inity", "CC"}, {"ional", "A"}, {"ioned", "A"}, {"ished", "A"},
+		{"istic", "A"}, {"ities", "A"}, {"itous", "A"}, {"ively", "A"},
+		{"ivity", "A"}, {"izers", "F"}, {"izing", "F"}, {"oidal", "A"},
+		{"oides", "A"}, {"otide", "A"}, {"ously", "A"},
+
+		{"able", "A"}, {"ably", "A"}, {"ages", "B"}, {"ally", "B"},
+		{"ance", "B"}, {"ancy", "B"}, {"ants", "B"}, {"aric", "A"},
+		{"arly", "K"}, {"ated", "I"}, {"ates", "A"}, {"atic", "B"},
+		{"ator", "A"}, {"ealy", "Y"}, {"edly", "E"}, {"eful", "A"},
+		{"eity", "A"}, {"ence", "A"}, {"ency", "A"}, {"ened", "E"},
+		{"enly", "E"}, {"eous", "A"}, {"hood", "A"}, {"ials", "A"},
+		{"ians", "A"}, {"ible", "A"}, {"ibly", "A"}, {"ical", "A"},
+		{"ides", "L"}, {"iers", "A"}, {"iful", "A"}, {"ines", "M"},
+		{"ings", "N"}, {"ions", "B"}, {"ious", "A"}, {"isms", "B"},
+		{"ists", "A"}, {"itic", "H"}, {"ized", "F"}, {"izer", "F"},
+		{"less", "A"}, {"lily", "A"}, {"ness", "A"}, {"ogen", "A"},
+		{"ward", "A"}, {"wise", "A"}, {"ying", "B"}, {"yish", "A"},
+
+		{"acy", "A"}, {"age", "B"}, {"aic", "A"}, {"als", "BB"},
+		{"ant", "B"}, {"ars", "O"}, {"ary", "F"}, {"ata", "A"},
+		{"ate", "A"}, {"eal", "Y"}, {"ear", "Y"}, {"ely", "E"},
+		{"ene", "E"}, {"ent", "C"}, {"ery", "E"}, {"ese", "A"},
+		{"ful", "A"}, {"ial", "A"}, {"ian", "A"}, {"ics", "A"},
+		{"ide", "L"}, {"ied", "A"}, {"ier", "A"}, {"ies", "P"},
+		{"ily", "A"}, {"ine", "M"}, {"ing", "N"}, {"ion", "Q"},
+		{"ish", "C"}, {"ism", "B"}, {"ist", "A"}, {"ite", "AA"},
+		{"ity", "A"}, {"ium", "A"}, {"ive", "A"}, {"ize", "F"},
+		{"oid", "A"}, {"one", "R"}, {"ous", "A"},
+
+		{"ae", "A"}, {"al", "BB"}, {"ar", "X"}, {"as", "B"},
+		{"ed", "E"}, {"en", "F"}, {"es", "E"}, {"ia", "A"},
+		{"ic", "A"}, {"is", "A"}, {"ly", "B"}, {"on", "S"},
+		{"or", "T"}, {"um", "U"}, {"us", "V"}, {"yl", "R"},
+		{"'s", "A"}, {"s'", "A"},
+
+		{"a", "A"}, {"e", "A"}, {"i", "A"}, {"o", "A"}, {"s", "W"}, {"y", "B"},
+	}
+
+	lovinsUndoubles = []string{"bb", "dd", "gg", "ll", "mm", "nn", "pp", "rr", "ss", "tt"}
+
+	lovinsRespellings = []string{
+		"istr", "metr", "umpt", "erid", "pand",
+		"iev", "uct", "rpt", "urs", "olv", "bex", "dex", "pex", "tex", "lux",
+		"uad", "vad", "cid", "lid", "end", "ond", "lud", "rud", "her", "mit",
+		"ent", "ert",
+		"ul", "ax", "ex", "ix", "et", "yt", "yz",
+	}
+	lovinsRespellingReplacements = map[string]string{
+		"istr": "ister", "metr": "meter", "umpt": "um", "erid": "eris", "pand": "pans",
+		"iev": "ief", "uct": "uc", "rpt": "rb", "urs": "ur", "olv": "olut",
+		"bex": "bic", "dex": "dic", "pex": "pic", "tex": "tic", "lux": "luc",
+		"uad": "uas", "vad": "vas", "cid": "cis", "lid": "lis", "end": "ens",
+		"ond": "ons", "lud": "lus", "rud": "rus", "her": "hes", "mit": "mis",
+		"ent": "ens", "ert": "ers",
+		"ul": "l", "ax": "ac", "ex": "ec", "ix": "ic", "et": "es", "yt": "ys", "yz": "ys",
+	}
+)
+
+// LovinsStemmer implements the Lovins (1968) stemmer for English. It removes
+// the longest of 294 endings whose condition holds and then respells the
+// stem, which makes it considerably more aggressive than Porter.
+type LovinsStemmer struct{}
+
+// NewLovinsStemmer creates a new LovinsStemmer.
+func NewLovinsStemmer() *LovinsStemmer {
+	return &LovinsStemmer{}
+}
+
+// Stem returns the stem of the given word.
+func (s LovinsStemmer) Stem(word string) string {
+	word = strings.ToLower(word)
+	if s.isStopWord(word) {
+		return word
+	}
+
+	word = s.removeEnding(word)
+	word = s.undouble(word)
+	return s.respell(word)
+}
+
+// removeEnding removes the longest ending whose condition holds for the stem
+// left behind. If the condition of a matching ending fails, shorter endings
+// are tried.
+func (s LovinsStemmer) removeEnding(word string) string {
+	for _, e := range lovinsEndings {
+		if stem, found := strings.CutSuffix(word, e.ending); found && s.condition(stem, e.condition) {
+			return stem
+		}
+	}
+	return word
+}
+
+// condition reports whether stem satisfies the Lovins condition with the
+// given letter. Every condition requires a stem of at least two letters.
+func (s LovinsStemmer) condition(stem, letter string) bool {
+	if len(stem) < 2 {
+		return false
+	}
+
+	ends := func(suffixes ...string) bool {
+		return longestSuffix(stem, suffixes) != ""
+	}
+	// afterUE reports whether the stem ends with u, any letter and e.
+	afterUE := len(stem) >= 3 && stem[len(stem)-3] == 'u' && stem[len(stem)-1] == 'e'
+
+	switch letter {
+	case "A":
+		return true
+	case "B":
+		return len(stem) >= 3
+	case "C":
+		return len(stem) >= 4
+	case "D":
+		return len(stem) >= 5
+	case "E":
+		return !ends("e")
+	case "F":
+		return len(stem) >= 3 && !ends("e")
+	case "G":
+		return len(stem) >= 3 && ends("f")
+	case "H":
+		return ends("t", "ll")
+	case "I":
+		return !ends("o", "e")
+	case "J":
+		return !ends("a", "e")
+	case "K":
+		return len(stem) >= 3 && (ends("l", "i") || afterUE)
+	case "L":
+		return !ends("u", "x") && (!ends("s") || ends("os"))
+	case "M":
+		return !ends("a", "c", "e", "m")
+	case "N":
+		if len(stem) >= 3 && stem[len(stem)-3] == 's' {
+			return len(stem) >= 4
+		}
+		return len(stem) >= 3
+	case "O":
+		return ends("l", "i")
+	case "P":
+		return !ends("c")
+	case "Q":
+		return len(stem) >= 3 && !ends("l", "n")
+	case "R":
+		return ends("n", "r")
+	case "S":
+		return ends("dr") || (ends("t") && !ends("tt"))
+	case "T":
+		return ends("s") || (ends("t") && !ends("ot"))
+	case "U":
+		return ends("l", "m", "n", "r")
+	case "V":
+		return ends("c")
+	case "W":
+		return !ends("s", "u")
+	case "X":
+		return ends("l", "i") || afterUE
+	case "Y":
+		return ends("in")
+	case "Z":
+		return !ends("f")
+	case "AA":
+		return ends("d", "f", "ph", "th", "l", "er", "or", "es", "t")
+	case "BB":
+		return len(stem) >= 3 && !ends("met", "ryst")
+	case "CC":
+		return ends("l")
+	default:
+		return false
+	}
+}
+
+// undouble removes one letter of a final doubled consonant.
+func (s LovinsStemmer) undouble(word string) string {
+	if longestSuffix(word, lovinsUndoubles) != "" {
+		return word[:len(word)-1]
+	}
+	return word
+}
+
+// respell replaces the longest matching stem ending with its recoded form.
+// Some endings are left alone after particular letters.
+func (s LovinsStemmer) respell(word string) string {
+	suffix := longestSuffix(word, lovinsRespellings)
+	if suffix == "" {
+		return word
+	}
+	stem := word[:len(word)-len(suffix)]
+
+	switch suffix {
+	case "ul":
+		if longestSuffix(stem, []string{"a", "i", "o"}) != "" {
+			return word
+		}
+	case "end":
+		if strings.HasSuffix(stem, "s") {
+			return word
+		}
+	case "her":
+		if longestSuffix(stem, []string{"p", "t"}) != "" {
+			return word
+		}
+	case "ent":
+		if strings.HasSuffix(stem, "m") {
+			return word
+		}
+	case "et":
+		if strings.HasSuffix(stem, "n") {
+			return word
+		}
+	}
+	return stem + lovinsRespellingReplacements[suffix]
+}
+
+// isStopWord returns true if the given word is a stop word.
+func (s LovinsStemmer) isStopWord(word string) bool {
+	_, found := enStopWords[word]
+	return found
+}
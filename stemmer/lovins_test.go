@@ -0,0 +1,105 @@
+package stemmer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewLovinsStemmer(t *testing.T) {
+	s := NewLovinsStemmer()
+	require.NotNil(t, s)
+}
+
+func TestLovinsStemmer_isStopWord(t *testing.T) {
+	s := NewLovinsStemmer()
+	require.True(t, s.isStopWord("the"))
+	require.False(t, s.isStopWord("nationally"))
+}
+
+func TestLovinsStemmer_condition(t *testing.T) {
+	s := NewLovinsStemmer()
+
+	f := func(stem, letter string, expected bool) {
+		t.Helper()
+		require.Equal(t, expected, s.condition(stem, letter), "%s %s", stem, letter)
+	}
+
+	f("n", "A", false)
+	f("na", "A", true)
+	f("na", "B", false)
+	f("nat", "B", true)
+	f("fre", "E", false)
+	f("mag", "G", false)
+	f("magnif", "G", true)
+	f("seas", "N", true)
+	f("say", "N", false)
+	f("sing", "N", true)
+	f("dr", "S", true)
+	f("pot", "S", true)
+	f("putt", "S", false)
+	f("lot", "T", false)
+	f("pit", "T", true)
+	f("produce", "X", true)
+	f("continue", "X", false)
+	f("met", "BB", false)
+	f("crystal", "BB", true)
+	f("graph", "AA", true)
+	f("gram", "AA", false)
+}
+
+func TestLovinsStemmer_respell(t *testing.T) {
+	s := NewLovinsStemmer()
+
+	f := func(word, expected string) {
+		t.Helper()
+		require.Equal(t, expected, s.respell(word))
+	}
+
+	f("believ", "belief")
+	f("matrix", "matric")
+	f("index", "indic")
+	f("extend", "extens")
+	f("send", "send")
+	f("feet", "fees")
+	f("magnet", "magnet")
+	f("giant", "giant")
+	f("conful", "confl")
+	f("cool", "cool")
+}
+
+func TestLovinsStemmer_Stem(t *testing.T) {
+	s := NewLovinsStemmer()
+	t.Run("stop word", func(t *testing.T) {
+		require.Equal(t, "the", s.Stem("the"))
+	})
+
+	f := func(word, stem string) {
+		t.Helper()
+		require.Equal(t, stem, s.Stem(word))
+	}
+
+	f("nationally", "nat")
+	f("Nationally", "nat")
+	f("sensational", "sens")
+	f("relational", "rel")
+	f("organization", "organ")
+	f("happiness", "hap")
+	f("kindness", "kind")
+	f("fairly", "fair")
+	f("sitting", "sit")
+	f("running", "run")
+	f("controlling", "control")
+	f("absorption", "absorb")
+	f("consumption", "consum")
+	f("dissolved", "dissolut")
+	f("matrix", "matric")
+	f("suspended", "suspens")
+	f("admitted", "admis")
+	f("believe", "belief")
+	f("persuade", "persuas")
+	f("decided", "decis")
+	f("mathematics", "mathemat")
+	f("abilities", "abil")
+	f("saying", "saying")
+}
@@ -0,0 +1,134 @@
+package stemmer
+
+import "strings"
+
+var (
+	neStopWords = map[string]struct{}{
+		"अझै":    {},
+		"अब":     {},
+		"उनी":    {},
+		"ऊ":      {},
+		"कि":     {},
+		"कुनै":   {},
+		"के":     {},
+		"छ":      {},
+		"छन्":    {},
+		"जुन":    {},
+		"जो":     {},
+		"त":      {},
+		"तपाईं":  {},
+		"तर":     {},
+		"तिमी":   {},
+		"ती":     {},
+		"त्यस":   {},
+		"त्यहाँ": {},
+		"त्यो":   {},
+		"थियो":   {},
+		"नै":     {},
+		"पनि":    {},
+		"भने":    {},
+		"म":      {},
+		"यस":     {},
+		"यहाँ":   {},
+		"यी":     {},
+		"यो":     {},
+		"र":      {},
+		"वा":     {},
+		"सबै":    {},
+		"हामी":   {},
+		"हो":     {},
+		"होइन":   {},
+	}
+
+	// nePostpositions are the case markers and postpositions that the first
+	// step removes.
+	nePostpositions = []string{
+		"द्वारा", "मार्फत", "सँगै", "पछि", "लाइ", "लाई", "सँग", "संग", "मा", "मै",
+		"रत", "ले",
+	}
+
+	// neGenitives are the genitive markers. They stay after ए and े, where
+	// they belong to a verb ending removed later.
+	neGenitives = []string{"का", "कि", "की", "कै", "को"}
+
+	// neSuffixes are the verb endings and plural markers removed
+	// repeatedly by the last step.
+	neSuffixes = []string{
+		"नेछन्", "नेछस्", "हुनेछ", "हुन्छ", "इएका", "इएकी", "इएको", "इछन्", "इछस्",
+		"इन्छ", "एछन्", "एछस्", "छिन्", "छेस्", "छ्यौ", "थिन्", "थियो", "थियौ",
+		"थिस्", "थ्यो", "थ्यौ", "दियो", "देखि", "देखी", "नेकै", "नेछु", "नेछौ",
+		"माथि", "लान्", "िएका", "िएकी", "िएको", "िछन्", "िछस्", "िन्छ", "ेछन्",
+		"ेछस्", "इछौ", "इदा", "इदै", "इदो", "इन्", "इयो", "एका", "एकी", "एकै",
+		"एको", "एछु", "एछौ", "छन्", "छस्", "थिए", "नेछ", "भयो", "हरु", "हरू",
+		"िछौ", "िदा", "िदै", "िदो", "िन्", "ियो", "िस्", "ेका", "ेकी", "ेकै",
+		"ेको", "ेछु", "ेछौ", "इछ", "एछ", "छु", "छे", "छौ", "थी", "थे", "दा", "दी",
+		"दै", "दो", "नु", "ने", "यो", "यौ", "िछ", "ेछ", "छ",
+	}
+
+	// neNasalized are the endings that lose a final candrabindu or
+	// anusvara.
+	neNasalized = []string{"यौ", "छौ", "नौ", "थे"}
+)
+
+type NepaliStemmer struct{}
+
+// NewNepaliStemmer creates a new NepaliStemmer.
+func NewNepaliStemmer() *NepaliStemmer {
+	return &NepaliStemmer{}
+}
+
+// Stem returns the stem of the given word, following the Snowball Nepali
+// algorithm: one postposition is removed, then verb endings and plural
+// markers are removed for as long as one is left.
+func (s NepaliStemmer) Stem(word string) string {
+	word = strings.ToLower(word)
+	if s.isStopWord(word) {
+		return word
+	}
+
+	word = s.removePostposition(word)
+	for {
+		word = s.removeNasalization(word)
+		suffix := longestSuffix(word, neSuffixes)
+		if suffix == "" {
+			return word
+		}
+		word = word[:len(word)-len(suffix)]
+	}
+}
+
+// removePostposition removes a postposition or a genitive marker that does
+// not follow ए or े.
+func (s NepaliStemmer) removePostposition(word string) string {
+	if suffix := longestSuffix(word, nePostpositions); suffix != "" {
+		return word[:len(word)-len(suffix)]
+	}
+	if suffix := longestSuffix(word, neGenitives); suffix != "" {
+		stem := word[:len(word)-len(suffix)]
+		if strings.HasSuffix(stem, "ए") || strings.HasSuffix(stem, "े") {
+			return word
+		}
+		return stem
+	}
+	return word
+}
+
+// removeNasalization removes a candrabindu or anusvara after the endings
+// in neNasalized, and the ै of त्रै.
+func (s NepaliStemmer) removeNasalization(word string) string {
+	for _, sign := range []string{"ँ", "ं"} {
+		if stem, ok := strings.CutSuffix(word, sign); ok && longestSuffix(stem, neNasalized) != "" {
+			return stem
+		}
+	}
+	if stem, ok := strings.CutSuffix(word, "ै"); ok && strings.HasSuffix(stem, "त्र") {
+		return stem
+	}
+	return word
+}
+
+// isStopWord returns true if the given word is a stop word.
+func (s NepaliStemmer) isStopWord(word string) bool {
+	_, found := neStopWords[word]
+	return found
+}
@@ -0,0 +1,55 @@
+package stemmer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewNepaliStemmer(t *testing.T) {
+	s := NewNepaliStemmer()
+	require.NotNil(t, s)
+}
+
+func TestNepaliStemmer_isStopWord(t *testing.T) {
+	s := NewNepaliStemmer()
+	require.True(t, s.isStopWord("पनि"))
+	require.False(t, s.isStopWord("घर"))
+}
+
+func TestNepaliStemmer_Stem(t *testing.T) {
+	s := NewNepaliStemmer()
+	t.Run("stop word", func(t *testing.T) {
+		require.Equal(t, "पनि", s.Stem("पनि"))
+	})
+
+	f := func(word, stem string) {
+		t.Helper()
+		require.Equal(t, stem, s.Stem(word))
+	}
+
+	f("केटा", "केटा")
+	f("केटाहरू", "केटा")
+	f("केटाहरूलाई", "केटा")
+	f("केटाको", "केटा")
+	f("केटाले", "केटा")
+	f("घरमा", "घर")
+	f("घरहरूमा", "घर")
+	f("किताबहरू", "किताब")
+	f("नेपालको", "नेपाल")
+	f("विद्यालयमा", "विद्यालय")
+	f("सरकारले", "सरकार")
+	f("आमाले", "आमा")
+	f("गरेको", "गर")
+	f("गरेकोले", "गर")
+	f("गरेकाहरूलाई", "गर")
+	f("गएको", "ग")
+	f("गर्छ", "गर्")
+	f("गर्छन्", "गर्")
+	f("गर्यो", "गर्")
+	f("गर्यौं", "गर्")
+	f("गर्दै", "गर्")
+	f("गर्ने", "गर्")
+	f("खान्छु", "खान्")
+	f("त्यत्रै", "त्यत्र")
+}
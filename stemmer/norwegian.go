@@ -0,0 +1,273 @@
+package stemmer
+
+import "strings"
+
+var (
+	noStopWords = map[string]struct{}{
+		"og":        {},
+		"i":         {},
+		"jeg":       {},
+		"det":       {},
+		"at":        {},
+		"en":        {},
+		"et":        {},
+		"den":       {},
+		"til":       {},
+		"er":        {},
+		"som":       {},
+		"på":        {},
+		"de":        {},
+		"med":       {},
+		"han":       {},
+		"av":        {},
+		"ikke":      {},
+		"ikkje":     {},
+		"der":       {},
+		"så":        {},
+		"var":       {},
+		"meg":       {},
+		"seg":       {},
+		"men":       {},
+		"ett":       {},
+		"har":       {},
+		"om":        {},
+		"vi":        {},
+		"min":       {},
+		"mitt":      {},
+		"ha":        {},
+		"hadde":     {},
+		"hun":       {},
+		"nå":        {},
+		"over":      {},
+		"da":        {},
+		"ved":       {},
+		"fra":       {},
+		"du":        {},
+		"ut":        {},
+		"sin":       {},
+		"dem":       {},
+		"oss":       {},
+		"opp":       {},
+		"man":       {},
+		"kan":       {},
+		"hans":      {},
+		"hvor":      {},
+		"eller":     {},
+		"hva":       {},
+		"skal":      {},
+		"selv":      {},
+		"sjøl":      {},
+		"her":       {},
+		"alle":      {},
+		"vil":       {},
+		"bli":       {},
+		"ble":       {},
+		"blei":      {},
+		"blitt":     {},
+		"kunne":     {},
+		"inn":       {},
+		"når":       {},
+		"være":      {},
+		"kom":       {},
+		"noen":      {},
+		"noe":       {},
+		"ville":     {},
+		"dere":      {},
+		"deres":     {},
+		"kun":       {},
+		"ja":        {},
+		"etter":     {},
+		"ned":       {},
+		"skulle":    {},
+		"denne":     {},
+		"for":       {},
+		"deg":       {},
+		"si":        {},
+		"sine":      {},
+		"sitt":      {},
+		"mot":       {},
+		"å":         {},
+		"meget":     {},
+		"hvorfor":   {},
+		"dette":     {},
+		"disse":     {},
+		"uten":      {},
+		"hvordan":   {},
+		"ingen":     {},
+		"din":       {},
+		"ditt":      {},
+		"blir":      {},
+		"samme":     {},
+		"hvilken":   {},
+		"hvilke":    {},
+		"sånn":      {},
+		"inni":      {},
+		"mellom":    {},
+		"vår":       {},
+		"hver":      {},
+		"hvem":      {},
+		"vors":      {},
+		"hvis":      {},
+		"både":      {},
+		"bare":      {},
+		"enn":       {},
+		"fordi":     {},
+		"før":       {},
+		"mange":     {},
+		"også":      {},
+		"slik":      {},
+		"vært":      {},
+		"begge":     {},
+		"siden":     {},
+		"dykk":      {},
+		"dykkar":    {},
+		"dei":       {},
+		"deira":     {},
+		"deires":    {},
+		"deim":      {},
+		"di":        {},
+		"då":        {},
+		"eg":        {},
+		"ein":       {},
+		"eit":       {},
+		"eitt":      {},
+		"elles":     {},
+		"honom":     {},
+		"hjå":       {},
+		"ho":        {},
+		"hoe":       {},
+		"henne":     {},
+		"hennar":    {},
+		"hennes":    {},
+		"hoss":      {},
+		"hossen":    {},
+		"ingi":      {},
+		"inkje":     {},
+		"korleis":   {},
+		"korso":     {},
+		"kva":       {},
+		"kvar":      {},
+		"kvarhelst": {},
+		"kven":      {},
+		"kvi":       {},
+		"kvifor":    {},
+		"me":        {},
+		"medan":     {},
+		"mi":        {},
+		"mine":      {},
+		"mykje":     {},
+		"no":        {},
+		"nokon":     {},
+		"noka":      {},
+		"nokor":     {},
+		"noko":      {},
+		"nokre":     {},
+		"sia":       {},
+		"sidan":     {},
+		"so":        {},
+		"somt":      {},
+		"somme":     {},
+		"um":        {},
+		"upp":       {},
+		"vere":      {},
+		"vore":      {},
+		"verte":     {},
+		"vort":      {},
+		"varte":     {},
+		"vart":      {},
+	}
+
+	noMainSuffixes = []string{
+		"hetenes", "hetene", "hetens", "endes", "heten", "heter", "ande", "edes",
+		"ende", "enes", "erte", "ane", "ast", "ede", "ene", "ens", "ers", "ert",
+		"ets", "het", "ar", "as", "en", "er", "es", "et", "a", "e", "s",
+	}
+
+	noConsonantPairs = []string{"dt", "vt"}
+	noOtherSuffixes  = []string{
+		"hetslov", "eleg", "elig", "elov", "slov", "eig", "els", "leg", "lig", "lov", "ig",
+	}
+	noValidSEndings = "bcdfghjlmnoprtvyz"
+)
+
+type NorwegianStemmer struct{}
+
+// NewNorwegianStemmer creates a new NorwegianStemmer.
+func NewNorwegianStemmer() *NorwegianStemmer {
+	return &NorwegianStemmer{}
+}
+
+// Stem returns the stem of the given word.
+func (s NorwegianStemmer) Stem(word string) string {
+	word = strings.ToLower(word)
+	if s.isStopWord(word) {
+		return word
+	}
+
+	r1 := s.regions(word)
+
+	word = s.mainSuffix(word, r1)
+	word = s.consonantPair(word, r1)
+	word = s.otherSuffix(word, r1)
+
+	return word
+}
+
+func (s NorwegianStemmer) mainSuffix(word string, r1 int) string {
+	suffix := longestSuffix(region(word, r1), noMainSuffixes)
+	if suffix == "" {
+		return word
+	}
+	stem := word[:len(word)-len(suffix)]
+
+	switch suffix {
+	case "erte", "ert":
+		return stem + "er"
+	case "s":
+		// s is removed after a valid s-ending, or after a k that follows a
+		// non-vowel.
+		last := lastRune(stem)
+		if strings.ContainsRune(noValidSEndings, last) {
+			return stem
+		}
+		if last != 'k' || len(stem) < 2 || s.isVowel(lastRune(stem[:len(stem)-1])) {
+			return word
+		}
+	}
+	return stem
+}
+
+// consonantPair removes the t of a final dt or vt in R1.
+func (s NorwegianStemmer) consonantPair(word string, r1 int) string {
+	if longestSuffix(region(word, r1), noConsonantPairs) == "" {
+		return word
+	}
+	return word[:len(word)-1]
+}
+
+func (s NorwegianStemmer) otherSuffix(word string, r1 int) string {
+	suffix := longestSuffix(region(word, r1), noOtherSuffixes)
+	return word[:len(word)-len(suffix)]
+}
+
+// isStopWord returns true if the given word is a stop word.
+func (s NorwegianStemmer) isStopWord(word string) bool {
+	_, found := noStopWords[word]
+	return found
+}
+
+func (s NorwegianStemmer) isVowel(r rune) bool {
+	switch r {
+	case 'a', 'e', 'i', 'o', 'u', 'y', 'æ', 'å', 'ø':
+		return true
+	default:
+		return false
+	}
+}
+
+// regions returns the byte offset of R1, adjusted so that at least 3
+// letters precede it.
+func (s NorwegianStemmer) regions(word string) int {
+	r1, _ := standardRegions(word, s.isVowel)
+	return atLeastLetters(word, r1, 3)
+}
@@ -0,0 +1,233 @@
+package stemmer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewNorwegianStemmer(t *testing.T) {
+	s := NewNorwegianStemmer()
+	require.NotNil(t, s)
+}
+
+func TestNorwegianStemmer_isStopWord(t *testing.T) {
+	s := NewNorwegianStemmer()
+	require.True(t, s.isStopWord("ikke"))
+	require.False(t, s.isStopWord("huset"))
+}
+
+func TestNorwegianStemmer_Stem(t *testing.T) {
+	s := NewNorwegianStemmer()
+	t.Run("stop word", func(t *testing.T) {
+		require.Equal(t, "ikke", s.Stem("ikke"))
+		require.Equal(t, "ikke", s.Stem("Ikke"))
+	})
+
+	f := func(word, stem string) {
+		t.Helper()
+		require.Equal(t, stem, s.Stem(word))
+	}
+
+	f("havnedistrikter", "havnedistrikt")
+	f("havnedistriktene", "havnedistrikt")
+	f("hus", "hus")
+	f("huset", "hus")
+	f("husene", "hus")
+	f("husets", "hus")
+	f("bil", "bil")
+	f("bilen", "bil")
+	f("bilene", "bil")
+	f("biler", "bil")
+	f("bilenes", "bil")
+	f("gutt", "gutt")
+	f("gutten", "gutt")
+	f("guttene", "gutt")
+	f("gutter", "gutt")
+	f("jente", "jent")
+	f("jenta", "jent")
+	f("jenter", "jent")
+	f("jentene", "jent")
+	f("barn", "barn")
+	f("barnet", "barn")
+	f("barna", "barn")
+	f("barnas", "barn")
+	f("land", "land")
+	f("landet", "land")
+	f("landene", "land")
+	f("landets", "land")
+	f("by", "by")
+	f("byen", "byen")
+	f("byene", "byen")
+	f("byer", "byer")
+	f("bok", "bok")
+	f("boken", "bok")
+	f("boka", "bok")
+	f("bøker", "bøk")
+	f("bøkene", "bøk")
+	f("skole", "skol")
+	f("skolen", "skol")
+	f("skoler", "skol")
+	f("skolene", "skol")
+	f("lærer", "lær")
+	f("læreren", "lærer")
+	f("lærere", "lærer")
+	f("lærerne", "lærern")
+	f("student", "student")
+	f("studenten", "student")
+	f("studenter", "student")
+	f("studentene", "student")
+	f("universitet", "universit")
+	f("universitetet", "universitet")
+	f("universiteter", "universitet")
+	f("arbeid", "arbeid")
+	f("arbeide", "arbeid")
+	f("arbeider", "arbeid")
+	f("arbeidet", "arbeid")
+	f("arbeidende", "arbeid")
+	f("arbeidere", "arbeider")
+	f("arbeiderne", "arbeidern")
+	f("skrive", "skriv")
+	f("skriver", "skriv")
+	f("skrev", "skrev")
+	f("skrevet", "skrev")
+	f("skrivende", "skriv")
+	f("lese", "les")
+	f("leser", "les")
+	f("leste", "lest")
+	f("lest", "lest")
+	f("lesende", "les")
+	f("lesere", "leser")
+	f("snakke", "snakk")
+	f("snakker", "snakk")
+	f("snakket", "snakk")
+	f("snakkende", "snakk")
+	f("komme", "komm")
+	f("kommer", "komm")
+	f("kom", "kom")
+	f("kommet", "komm")
+	f("kommende", "komm")
+	f("reise", "reis")
+	f("reiser", "reis")
+	f("reiste", "reist")
+	f("reisende", "reis")
+	f("reisen", "reis")
+	f("kjærlighet", "kjær")
+	f("kjærligheten", "kjær")
+	f("kjærlig", "kjær")
+	f("vennlig", "venn")
+	f("vennlige", "venn")
+	f("vennlighet", "venn")
+	f("vennligheten", "venn")
+	f("mulig", "mul")
+	f("mulige", "mul")
+	f("muligheten", "mul")
+	f("mulighetene", "mul")
+	f("muligheter", "mul")
+	f("lovlig", "lov")
+	f("lovlige", "lov")
+	f("lykkelig", "lykk")
+	f("lykkelige", "lykk")
+	f("ærlig", "ærl")
+	f("ærlighet", "ærl")
+	f("farlig", "far")
+	f("farlige", "far")
+	f("viktig", "vikt")
+	f("viktige", "vikt")
+	f("viktigste", "viktigst")
+	f("hyggelig", "hygg")
+	f("hyggelige", "hygg")
+	f("spesielt", "spesielt")
+	f("spesiell", "spesiell")
+	f("spesielle", "spesiell")
+	f("regjering", "regjering")
+	f("regjeringen", "regjering")
+	f("regjeringens", "regjering")
+	f("samfunn", "samfunn")
+	f("samfunnet", "samfunn")
+	f("samfunnets", "samfunn")
+	f("politikk", "politikk")
+	f("politisk", "politisk")
+	f("politiske", "politisk")
+	f("økonomi", "økonomi")
+	f("økonomisk", "økonomisk")
+	f("økonomiske", "økonomisk")
+	f("historie", "histori")
+	f("historien", "histori")
+	f("historiske", "historisk")
+	f("kvinne", "kvinn")
+	f("kvinnen", "kvinn")
+	f("kvinner", "kvinn")
+	f("kvinnene", "kvinn")
+	f("mann", "mann")
+	f("mannen", "mann")
+	f("menn", "menn")
+	f("mennene", "menn")
+	f("verden", "verd")
+	f("verdens", "verd")
+	f("vei", "vei")
+	f("veien", "veien")
+	f("veier", "veier")
+	f("veiene", "veien")
+	f("hund", "hund")
+	f("hunden", "hund")
+	f("hunder", "hund")
+	f("hundene", "hund")
+	f("katt", "katt")
+	f("katten", "katt")
+	f("katter", "katt")
+	f("kattene", "katt")
+	f("stor", "stor")
+	f("store", "stor")
+	f("større", "størr")
+	f("størst", "størst")
+	f("største", "størst")
+	f("liten", "lit")
+	f("lite", "lit")
+	f("små", "små")
+	f("mindre", "mindr")
+	f("minst", "minst")
+	f("ny", "ny")
+	f("nytt", "nytt")
+	f("nye", "nye")
+	f("nyere", "nyer")
+	f("nyeste", "nyest")
+	f("gammel", "gammel")
+	f("gammelt", "gammelt")
+	f("gamle", "gaml")
+	f("eldre", "eldr")
+	f("eldst", "eldst")
+	f("erte", "ert")
+	f("kjørt", "kjørt")
+	f("hørt", "hørt")
+	f("tenkt", "tenkt")
+	f("havet", "hav")
+	f("sjøen", "sjøen")
+	f("sjøene", "sjøen")
+	f("fjell", "fjell")
+	f("fjellet", "fjell")
+	f("fjellene", "fjell")
+	f("dalen", "dal")
+	f("dalene", "dal")
+	f("rettslov", "rett")
+	f("rettslovene", "rett")
+	f("sannhetslov", "sann")
+	f("kveld", "kveld")
+	f("kvelden", "kveld")
+	f("kveldene", "kveld")
+}
+
+func TestNorwegianStemmer_regions(t *testing.T) {
+	s := NewNorwegianStemmer()
+
+	f := func(word, r1 string) {
+		t.Helper()
+		require.Equal(t, r1, word[s.regions(word):])
+	}
+
+	f("havnedistrikter", "nedistrikter")
+	f("bilene", "ene")
+	f("lærer", "er")
+	f("universitet", "versitet")
+	f("by", "")
+}
@@ -0,0 +1,200 @@
+package stemmer
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+var (
+	porterStep2Suffixes = []string{
+		"ational", "fulness", "iveness", "ization", "ousness", "biliti", "tional",
+		"alism", "aliti", "ation", "entli", "iviti", "ousli", "abli", "alli",
+		"anci", "ator", "enci", "izer", "eli",
+	}
+	porterStep2Replacements = map[string]string{
+		"ational": "ate", "fulness": "ful", "iveness": "ive", "ization": "ize",
+		"ousness": "ous", "biliti": "ble", "tional": "tion", "alism": "al",
+		"aliti": "al", "ation": "ate", "entli": "ent", "iviti": "ive",
+		"ousli": "ous", "abli": "able", "alli": "al", "anci": "ance",
+		"ator": "ate", "enci": "ence", "izer": "ize", "eli": "e",
+	}
+
+	porterStep3Suffixes     = []string{"alize", "icate", "iciti", "ative", "ical", "ness", "ful"}
+	porterStep3Replacements = map[string]string{
+		"alize": "al", "icate": "ic", "iciti": "ic", "ative": "", "ical": "ic",
+		"ness": "", "ful": "",
+	}
+
+	porterStep4Suffixes = []string{
+		"ement", "ance", "ence", "able", "ible", "ment", "ant", "ate", "ent",
+		"ism", "iti", "ion", "ive", "ize", "ous", "al", "er", "ic", "ou",
+	}
+)
+
+// PorterStemmer implements the original 1980 Porter algorithm for English.
+// It is kept for indexes built with it; EnglishStemmer implements the
+// revised Porter2 algorithm.
+type PorterStemmer struct{}
+
+// NewPorterStemmer creates a new PorterStemmer.
+func NewPorterStemmer() *PorterStemmer {
+	return &PorterStemmer{}
+}
+
+// Stem returns the stem of the given word.
+func (s PorterStemmer) Stem(word string) string {
+	word = strings.ToLower(word)
+	if s.isStopWord(word) {
+		return word
+	}
+
+	word = s.markY(word)
+	r1, r2 := standardRegions(word, s.isVowel)
+
+	word = s.step1a(word)
+	word = s.step1b(word, r1)
+	word = s.step1c(word)
+	word = s.replaceInRegion(word, porterStep2Suffixes, porterStep2Replacements, r1)
+	word = s.replaceInRegion(word, porterStep3Suffixes, porterStep3Replacements, r1)
+	word = s.step4(word, r2)
+	word = s.step5(word, r1, r2)
+
+	return strings.ReplaceAll(word, "Y", "y")
+}
+
+// step1a removes plural endings.
+func (s PorterStemmer) step1a(word string) string {
+	switch suffix := longestSuffix(word, []string{"sses", "ies", "ss", "s"}); suffix {
+	case "sses", "ies":
+		return word[:len(word)-2]
+	case "s":
+		return word[:len(word)-1]
+	}
+	return word
+}
+
+// step1b removes -eed, -ed and -ing, and tidies up the stem left by the
+// last two.
+func (s PorterStemmer) step1b(word string, r1 int) string {
+	suffix := longestSuffix(word, []string{"eed", "ing", "ed"})
+	if suffix == "" {
+		return word
+	}
+	stem := word[:len(word)-len(suffix)]
+
+	if suffix == "eed" {
+		if inRegion(word, suffix, r1) {
+			return stem + "ee"
+		}
+		return word
+	}
+
+	if strings.IndexFunc(stem, s.isVowel) < 0 {
+		return word
+	}
+
+	switch ending := longestSuffix(stem, []string{"at", "bl", "iz", "bb", "dd", "ff", "gg", "mm", "nn", "pp", "rr", "tt"}); ending {
+	case "at", "bl", "iz":
+		return stem + "e"
+	case "":
+		if len(stem) == r1 && s.endsShortSyllable(stem) {
+			return stem + "e"
+		}
+		return stem
+	default:
+		return stem[:len(stem)-1]
+	}
+}
+
+// step1c turns a final y into i if the stem before it has a vowel.
+func (s PorterStemmer) step1c(word string) string {
+	last := lastRune(word)
+	if last != 'y' && last != 'Y' {
+		return word
+	}
+	stem := word[:len(word)-1]
+	if strings.IndexFunc(stem, s.isVowel) < 0 {
+		return word
+	}
+	return stem + "i"
+}
+
+// replaceInRegion replaces the longest of suffixes with its replacement if
+// the suffix lies inside the region starting at start.
+func (s PorterStemmer) replaceInRegion(word string, suffixes []string, replacements map[string]string, start int) string {
+	suffix := longestSuffix(word, suffixes)
+	if suffix == "" || !inRegion(word, suffix, start) {
+		return word
+	}
+	return word[:len(word)-len(suffix)] + replacements[suffix]
+}
+
+// step4 removes a suffix in R2; -ion only after s or t.
+func (s PorterStemmer) step4(word string, r2 int) string {
+	suffix := longestSuffix(word, porterStep4Suffixes)
+	if suffix == "" || !inRegion(word, suffix, r2) {
+		return word
+	}
+	stem := word[:len(word)-len(suffix)]
+	if suffix == "ion" && !strings.HasSuffix(stem, "s") && !strings.HasSuffix(stem, "t") {
+		return word
+	}
+	return stem
+}
+
+// step5 removes a final e in R2, or in R1 unless it follows a short
+// syllable, and undoubles a final ll in R2.
+func (s PorterStemmer) step5(word string, r1, r2 int) string {
+	if stem, found := strings.CutSuffix(word, "e"); found {
+		if inRegion(word, "e", r2) || (inRegion(word, "e", r1) && !s.endsShortSyllable(stem)) {
+			word = stem
+		}
+	}
+
+	if strings.HasSuffix(word, "ll") && inRegion(word, "l", r2) {
+		word = word[:len(word)-1]
+	}
+	return word
+}
+
+// endsShortSyllable reports whether word ends with a consonant other than
+// w, x or Y, preceded by a vowel, preceded by a consonant.
+func (s PorterStemmer) endsShortSyllable(word string) bool {
+	var last [3]rune
+	for i := range last {
+		r, size := utf8.DecodeLastRuneInString(word)
+		if size == 0 {
+			return false
+		}
+		last[i], word = r, word[:len(word)-size]
+	}
+	return !s.isVowel(last[0]) && !strings.ContainsRune("wxY", last[0]) &&
+		s.isVowel(last[1]) && !s.isVowel(last[2])
+}
+
+// markY upper-cases an initial y and a y after a vowel, so that they are
+// treated as consonants.
+func (s PorterStemmer) markY(word string) string {
+	runes := []rune(word)
+	for i, r := range runes {
+		if r == 'y' && (i == 0 || s.isVowel(runes[i-1])) {
+			runes[i] = 'Y'
+		}
+	}
+	return string(runes)
+}
+
+// isStopWord returns true if the given word is a stop word.
+func (s PorterStemmer) isStopWord(word string) bool {
+	_, found := enStopWords[word]
+	return found
+}
+
+func (s PorterStemmer) isVowel(r rune) bool {
+	switch r {
+	case 'a', 'e', 'i', 'o', 'u', 'y':
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,125 @@
+package stemmer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPorterStemmer(t *testing.T) {
+	s := NewPorterStemmer()
+	require.NotNil(t, s)
+}
+
+func TestPorterStemmer_isStopWord(t *testing.T) {
+	s := NewPorterStemmer()
+	require.True(t, s.isStopWord("the"))
+	require.False(t, s.isStopWord("agreement"))
+}
+
+func TestPorterStemmer_endsShortSyllable(t *testing.T) {
+	s := NewPorterStemmer()
+
+	f := func(word string, expected bool) {
+		t.Helper()
+		require.Equal(t, expected, s.endsShortSyllable(word))
+	}
+
+	f("hop", true)
+	f("fil", true)
+	f("bow", false)
+	f("box", false)
+	f("plaY", false)
+	f("hoop", false)
+	f("op", false)
+	f("", false)
+}
+
+func TestPorterStemmer_Stem(t *testing.T) {
+	s := NewPorterStemmer()
+	t.Run("stop word", func(t *testing.T) {
+		require.Equal(t, "the", s.Stem("the"))
+	})
+
+	f := func(word, stem string) {
+		t.Helper()
+		require.Equal(t, stem, s.Stem(word))
+	}
+
+	f("caresses", "caress")
+	f("ponies", "poni")
+	f("ties", "ti")
+	f("caress", "caress")
+	f("cats", "cat")
+	f("feed", "feed")
+	f("agreed", "agre")
+	f("plastered", "plaster")
+	f("bled", "bled")
+	f("motoring", "motor")
+	f("sing", "sing")
+	f("conflated", "conflat")
+	f("troubled", "troubl")
+	f("sized", "size")
+	f("hopping", "hop")
+	f("tanned", "tan")
+	f("falling", "fall")
+	f("hissing", "hiss")
+	f("fizzed", "fizz")
+	f("failing", "fail")
+	f("filing", "file")
+	f("happy", "happi")
+	f("sky", "sky")
+	f("relational", "relat")
+	f("conditional", "condit")
+	f("rational", "ration")
+	f("valenci", "valenc")
+	f("hesitanci", "hesit")
+	f("digitizer", "digit")
+	f("conformabli", "conform")
+	f("radicalli", "radic")
+	f("differentli", "differ")
+	f("vileli", "vile")
+	f("analogousli", "analog")
+	f("vietnamization", "vietnam")
+	f("predication", "predic")
+	f("operator", "oper")
+	f("feudalism", "feudal")
+	f("decisiveness", "decis")
+	f("hopefulness", "hope")
+	f("callousness", "callous")
+	f("formaliti", "formal")
+	f("sensitiviti", "sensit")
+	f("sensibiliti", "sensibl")
+	f("triplicate", "triplic")
+	f("formative", "form")
+	f("formalize", "formal")
+	f("electriciti", "electr")
+	f("electrical", "electr")
+	f("hopeful", "hope")
+	f("goodness", "good")
+	f("revival", "reviv")
+	f("allowance", "allow")
+	f("inference", "infer")
+	f("airliner", "airlin")
+	f("gyroscopic", "gyroscop")
+	f("adjustable", "adjust")
+	f("defensible", "defens")
+	f("irritant", "irrit")
+	f("replacement", "replac")
+	f("adjustment", "adjust")
+	f("dependent", "depend")
+	f("adoption", "adopt")
+	f("homologou", "homolog")
+	f("communism", "commun")
+	f("activate", "activ")
+	f("angulariti", "angular")
+	f("homologous", "homolog")
+	f("effective", "effect")
+	f("bowdlerize", "bowdler")
+	f("probate", "probat")
+	f("rate", "rate")
+	f("cease", "ceas")
+	f("controll", "control")
+	f("roll", "roll")
+	f("Caresses", "caress")
+}
@@ -0,0 +1,489 @@
+package stemmer
+
+import (
+	"slices"
+	"strings"
+)
+
+var (
+	ptStopWords = map[string]struct{}{
+		"de":        {},
+		"a":         {},
+		"o":         {},
+		"que":       {},
+		"e":         {},
+		"do":        {},
+		"da":        {},
+		"em":        {},
+		"um":        {},
+		"para":      {},
+		"com":       {},
+		"não":       {},
+		"uma":       {},
+		"os":        {},
+		"no":        {},
+		"se":        {},
+		"na":        {},
+		"por":       {},
+		"mais":      {},
+		"as":        {},
+		"dos":       {},
+		"como":      {},
+		"mas":       {},
+		"ao":        {},
+		"ele":       {},
+		"das":       {},
+		"à":         {},
+		"seu":       {},
+		"sua":       {},
+		"ou":        {},
+		"quando":    {},
+		"muito":     {},
+		"nos":       {},
+		"já":        {},
+		"eu":        {},
+		"também":    {},
+		"só":        {},
+		"pelo":      {},
+		"pela":      {},
+		"até":       {},
+		"isso":      {},
+		"ela":       {},
+		"entre":     {},
+		"depois":    {},
+		"sem":       {},
+		"mesmo":     {},
+		"aos":       {},
+		"seus":      {},
+		"quem":      {},
+		"nas":       {},
+		"me":        {},
+		"esse":      {},
+		"eles":      {},
+		"você":      {},
+		"essa":      {},
+		"num":       {},
+		"nem":       {},
+		"suas":      {},
+		"meu":       {},
+		"às":        {},
+		"minha":     {},
+		"numa":      {},
+		"pelos":     {},
+		"elas":      {},
+		"qual":      {},
+		"nós":       {},
+		"lhe":       {},
+		"deles":     {},
+		"essas":     {},
+		"esses":     {},
+		"pelas":     {},
+		"este":      {},
+		"dele":      {},
+		"tu":        {},
+		"te":        {},
+		"vocês":     {},
+		"vos":       {},
+		"lhes":      {},
+		"meus":      {},
+		"minhas":    {},
+		"teu":       {},
+		"tua":       {},
+		"teus":      {},
+		"tuas":      {},
+		"nosso":     {},
+		"nossa":     {},
+		"nossos":    {},
+		"nossas":    {},
+		"dela":      {},
+		"delas":     {},
+		"esta":      {},
+		"estes":     {},
+		"estas":     {},
+		"aquele":    {},
+		"aquela":    {},
+		"aqueles":   {},
+		"aquelas":   {},
+		"isto":      {},
+		"aquilo":    {},
+		"estou":     {},
+		"está":      {},
+		"estamos":   {},
+		"estão":     {},
+		"estive":    {},
+		"esteve":    {},
+		"estivemos": {},
+		"estiveram": {},
+		"era":       {},
+		"éramos":    {},
+		"eram":      {},
+		"fui":       {},
+		"foi":       {},
+		"fomos":     {},
+		"foram":     {},
+		"sou":       {},
+		"somos":     {},
+		"são":       {},
+		"tenho":     {},
+		"tem":       {},
+		"temos":     {},
+		"têm":       {},
+		"tinha":     {},
+		"tive":      {},
+		"teve":      {},
+		"tivemos":   {},
+		"tiveram":   {},
+		"hei":       {},
+		"há":        {},
+		"havemos":   {},
+		"hão":       {},
+		"houve":     {},
+	}
+
+	ptStandardSuffixes = []string{
+		"amentos",
+		"imentos",
+		"adoras",
+		"adores",
+		"amente",
+		"amento",
+		"aço~es",
+		"idades",
+		"imento",
+		"logias",
+		"uço~es",
+		"ências",
+		"adora",
+		"antes",
+		"aça~o",
+		"idade",
+		"ismos",
+		"istas",
+		"logia",
+		"mente",
+		"uça~o",
+		"ância",
+		"ência",
+		"ador",
+		"ante",
+		"ezas",
+		"icas",
+		"icos",
+		"iras",
+		"ismo",
+		"ista",
+		"ivas",
+		"ivos",
+		"osas",
+		"osos",
+		"ável",
+		"ível",
+		"eza",
+		"ica",
+		"ico",
+		"ira",
+		"iva",
+		"ivo",
+		"osa",
+		"oso",
+	}
+
+	// ptR2Suffixes are the standard suffixes that are simply deleted when
+	// they are in R2. The slice is sorted for binary search.
+	ptR2Suffixes = []string{
+		"ador", "adora", "adoras", "adores", "amento", "amentos", "ante", "antes",
+		"aça~o", "aço~es", "eza", "ezas", "ica", "icas", "ico", "icos",
+		"imento", "imentos", "ismo", "ismos", "ista", "istas", "osa", "osas",
+		"oso", "osos", "ável", "ância", "ível",
+	}
+
+	ptVerbSuffixes = []string{
+		"aríamos",
+		"eríamos",
+		"iríamos",
+		"ássemos",
+		"êssemos",
+		"íssemos",
+		"aremos",
+		"aríeis",
+		"eremos",
+		"eríeis",
+		"iremos",
+		"iríeis",
+		"áramos",
+		"ásseis",
+		"ávamos",
+		"éramos",
+		"ésseis",
+		"íramos",
+		"ísseis",
+		"ara~o",
+		"ardes",
+		"areis",
+		"ariam",
+		"arias",
+		"armos",
+		"assem",
+		"asses",
+		"astes",
+		"era~o",
+		"erdes",
+		"ereis",
+		"eriam",
+		"erias",
+		"ermos",
+		"essem",
+		"esses",
+		"estes",
+		"ira~o",
+		"irdes",
+		"ireis",
+		"iriam",
+		"irias",
+		"irmos",
+		"issem",
+		"isses",
+		"istes",
+		"áreis",
+		"áveis",
+		"éreis",
+		"íamos",
+		"íreis",
+		"adas",
+		"ados",
+		"amos",
+		"ando",
+		"aram",
+		"aras",
+		"arei",
+		"arem",
+		"ares",
+		"aria",
+		"arás",
+		"asse",
+		"aste",
+		"avam",
+		"avas",
+		"emos",
+		"endo",
+		"eram",
+		"eras",
+		"erei",
+		"erem",
+		"eres",
+		"eria",
+		"erás",
+		"esse",
+		"este",
+		"idas",
+		"idos",
+		"imos",
+		"indo",
+		"iram",
+		"iras",
+		"irei",
+		"irem",
+		"ires",
+		"iria",
+		"irás",
+		"isse",
+		"iste",
+		"ámos",
+		"íeis",
+		"ada",
+		"ado",
+		"ais",
+		"ara",
+		"ará",
+		"ava",
+		"eis",
+		"era",
+		"erá",
+		"iam",
+		"ias",
+		"ida",
+		"ido",
+		"ira",
+		"irá",
+		"am",
+		"ar",
+		"as",
+		"ei",
+		"em",
+		"er",
+		"es",
+		"eu",
+		"ia",
+		"ir",
+		"is",
+		"iu",
+		"ou",
+	}
+
+	ptResidualSuffixes = []string{"os", "a", "i", "o", "á", "í", "ó"}
+
+	// ptNasalReplacer writes ã and õ as a~ and o~, so that the tilde is
+	// treated as a consonant; ptNasalRestorer undoes it.
+	ptNasalReplacer = strings.NewReplacer("ã", "a~", "õ", "o~")
+	ptNasalRestorer = strings.NewReplacer("a~", "ã", "o~", "õ")
+
+	// ptAccentFolder writes the Brazilian ê and ô of a stem as the European
+	// é and ó, so that econômico and económico share a stem. It runs after
+	// the suffix steps, whose tables spell -ência and -êssemos with ê.
+	ptAccentFolder = strings.NewReplacer("ê", "é", "ô", "ó")
+)
+
+type PortugueseStemmer struct{}
+
+// NewPortugueseStemmer creates a new PortugueseStemmer.
+func NewPortugueseStemmer() *PortugueseStemmer {
+	return &PortugueseStemmer{}
+}
+
+// Stem returns the stem of the given word.
+func (s PortugueseStemmer) Stem(word string) string {
+	word = strings.ToLower(word)
+	if s.isStopWord(word) {
+		return word
+	}
+
+	word = ptNasalReplacer.Replace(word)
+	rv, r1, r2 := s.regions(word)
+
+	var removed bool
+	if word, removed = s.standardSuffix(word, rv, r1, r2); !removed {
+		word, removed = s.verbSuffix(word, rv)
+	}
+
+	if removed {
+		if strings.HasSuffix(word, "ci") && inRegion(word, "i", rv) {
+			word = word[:len(word)-1]
+		}
+	} else {
+		word = s.residualSuffix(word, rv)
+	}
+
+	word = s.residualForm(word, rv)
+
+	return ptAccentFolder.Replace(ptNasalRestorer.Replace(word))
+}
+
+func (s PortugueseStemmer) standardSuffix(word string, rv, r1, r2 int) (string, bool) {
+	suffix := longestSuffix(word, ptStandardSuffixes)
+	if suffix == "" {
+		return word, false
+	}
+	stem := word[:len(word)-len(suffix)]
+
+	switch suffix {
+	case "amente":
+		if !inRegion(word, suffix, r1) {
+			return word, false
+		}
+		if next := longestSuffix(stem, []string{"ic", "ad", "os", "iv"}); next != "" && inRegion(stem, next, r2) {
+			stem = stem[:len(stem)-len(next)]
+			if next == "iv" {
+				stem = s.trimInR2(stem, "at", r2)
+			}
+		}
+		return stem, true
+
+	case "ira", "iras":
+		if !inRegion(word, suffix, rv) || !strings.HasSuffix(stem, "e") {
+			return word, false
+		}
+		return stem + "ir", true
+	}
+
+	if !inRegion(word, suffix, r2) {
+		return word, false
+	}
+
+	if _, found := slices.BinarySearch(ptR2Suffixes, suffix); found {
+		return stem, true
+	}
+
+	switch suffix {
+	case "logia", "logias":
+		stem += "log"
+	case "uça~o", "uço~es":
+		stem += "u"
+	case "ência", "ências":
+		stem += "ente"
+	case "mente":
+		if next := longestSuffix(stem, []string{"ante", "avel", "ível"}); next != "" {
+			stem = s.trimInR2(stem, next, r2)
+		}
+	case "idade", "idades":
+		if next := longestSuffix(stem, []string{"abil", "ic", "iv"}); next != "" {
+			stem = s.trimInR2(stem, next, r2)
+		}
+	case "iva", "ivo", "ivas", "ivos":
+		stem = s.trimInR2(stem, "at", r2)
+	}
+	return stem, true
+}
+
+func (s PortugueseStemmer) verbSuffix(word string, rv int) (string, bool) {
+	suffix := longestSuffix(region(word, rv), ptVerbSuffixes)
+	if suffix == "" {
+		return word, false
+	}
+	return word[:len(word)-len(suffix)], true
+}
+
+func (s PortugueseStemmer) residualSuffix(word string, rv int) string {
+	suffix := longestSuffix(word, ptResidualSuffixes)
+	if suffix == "" || !inRegion(word, suffix, rv) {
+		return word
+	}
+	return word[:len(word)-len(suffix)]
+}
+
+// residualForm removes a final e in RV, together with the u of gu or the i
+// of ci when that is in RV too, and turns a final ç into c.
+func (s PortugueseStemmer) residualForm(word string, rv int) string {
+	if strings.HasSuffix(word, "ç") {
+		return strings.TrimSuffix(word, "ç") + "c"
+	}
+
+	suffix := longestSuffix(word, []string{"e", "é", "ê"})
+	if suffix == "" || !inRegion(word, suffix, rv) {
+		return word
+	}
+	word = word[:len(word)-len(suffix)]
+
+	if (strings.HasSuffix(word, "gu") || strings.HasSuffix(word, "ci")) && len(word)-1 >= rv {
+		word = word[:len(word)-1]
+	}
+	return word
+}
+
+// trimInR2 removes suffix from word if word ends with it inside R2.
+func (s PortugueseStemmer) trimInR2(word, suffix string, r2 int) string {
+	if strings.HasSuffix(word, suffix) && inRegion(word, suffix, r2) {
+		return word[:len(word)-len(suffix)]
+	}
+	return word
+}
+
+// isStopWord returns true if the given word is a stop word.
+func (s PortugueseStemmer) isStopWord(word string) bool {
+	_, found := ptStopWords[word]
+	return found
+}
+
+func (s PortugueseStemmer) isVowel(r rune) bool {
+	switch r {
+	case 'a', 'e', 'i', 'o', 'u', 'á', 'â', 'é', 'ê', 'í', 'ó', 'ô', 'ú':
+		return true
+	default:
+		return false
+	}
+}
+
+// regions returns the byte offsets of RV, R1 and R2.
+func (s PortugueseStemmer) regions(word string) (int, int, int) {
+	r1, r2 := standardRegions(word, s.isVowel)
+	return romanceRV(word, s.isVowel), r1, r2
+}
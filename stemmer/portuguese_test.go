@@ -0,0 +1,614 @@
+package stemmer
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPortugueseStemmer(t *testing.T) {
+	s := NewPortugueseStemmer()
+	require.NotNil(t, s)
+}
+
+func TestPortugueseStemmer_suffixTables(t *testing.T) {
+	require.True(t, slices.IsSorted(ptR2Suffixes))
+}
+
+func TestPortugueseStemmer_isStopWord(t *testing.T) {
+	s := NewPortugueseStemmer()
+	require.True(t, s.isStopWord("não"))
+	require.False(t, s.isStopWord("pão"))
+}
+
+func TestPortugueseStemmer_Stem(t *testing.T) {
+	s := NewPortugueseStemmer()
+	t.Run("stop word", func(t *testing.T) {
+		require.Equal(t, "não", s.Stem("não"))
+		require.Equal(t, "não", s.Stem("Não"))
+	})
+
+	t.Run("brazilian and european spellings", func(t *testing.T) {
+		for _, pair := range [][2]string{
+			{"equipe", "equipa"},
+			{"econômico", "económico"},
+			{"econômica", "económica"},
+			{"gênero", "género"},
+			{"fenômeno", "fenómeno"},
+			{"fenômenos", "fenómenos"},
+			{"prêmio", "prémio"},
+			{"anônimo", "anónimo"},
+			{"tênis", "ténis"},
+			{"atômico", "atómico"},
+			{"bebê", "bebé"},
+			{"bebês", "bebés"},
+		} {
+			require.Equal(t, s.Stem(pair[0]), s.Stem(pair[1]), pair[0])
+		}
+	})
+
+	f := func(word, stem string) {
+		t.Helper()
+		require.Equal(t, stem, s.Stem(word))
+	}
+
+	f("ação", "açã")
+	f("acção", "acçã")
+	f("ações", "açõ")
+	f("acções", "acçõ")
+	f("fato", "fat")
+	f("facto", "fact")
+	f("ótimo", "ótim")
+	f("óptimo", "óptim")
+	f("econômico", "económ")
+	f("económico", "económ")
+	f("econômica", "económ")
+	f("económica", "económ")
+	f("registro", "registr")
+	f("registo", "regist")
+	f("registros", "registr")
+	f("registos", "regist")
+	f("equipe", "equip")
+	f("equipa", "equip")
+	f("equipes", "equip")
+	f("equipas", "equip")
+	f("ônibus", "ónibus")
+	f("autocarro", "autocarr")
+	f("autocarros", "autocarr")
+	f("anônimo", "anónim")
+	f("anónimo", "anónim")
+	f("fenômeno", "fenómen")
+	f("fenómeno", "fenómen")
+	f("fenômenos", "fenómen")
+	f("fenómenos", "fenómen")
+	f("gênero", "géner")
+	f("género", "géner")
+	f("bebê", "beb")
+	f("bebé", "beb")
+	f("bebês", "bebés")
+	f("bebés", "bebés")
+	f("tênis", "tén")
+	f("ténis", "tén")
+	f("atômico", "atóm")
+	f("atómico", "atóm")
+	f("projeto", "projet")
+	f("projecto", "project")
+	f("projetos", "projet")
+	f("projectos", "project")
+	f("direção", "direçã")
+	f("direcção", "direcçã")
+	f("diretor", "diretor")
+	f("director", "director")
+	f("diretores", "diretor")
+	f("directores", "director")
+	f("eleição", "eleiçã")
+	f("eleições", "eleiçõ")
+	f("contato", "contat")
+	f("contacto", "contact")
+	f("contatos", "contat")
+	f("contactos", "contact")
+	f("ator", "ator")
+	f("actor", "actor")
+	f("atores", "ator")
+	f("actores", "actor")
+	f("ótica", "ótic")
+	f("óptica", "óptic")
+	f("aspecto", "aspect")
+	f("aspeto", "aspet")
+	f("exceção", "exceçã")
+	f("excepção", "excepçã")
+	f("recepção", "recepçã")
+	f("receção", "receçã")
+	f("objetivo", "objet")
+	f("objectivo", "object")
+	f("objetivos", "objet")
+	f("objectivos", "object")
+	f("atual", "atual")
+	f("actual", "actual")
+	f("atualmente", "atual")
+	f("actualmente", "actual")
+	f("seleção", "seleçã")
+	f("selecção", "selecçã")
+	f("caráter", "carát")
+	f("carácter", "caráct")
+	f("time", "tim")
+	f("trem", "trem")
+	f("comboio", "comboi")
+	f("comboios", "comboi")
+	f("celular", "celul")
+	f("telemóvel", "telemóvel")
+	f("telemóveis", "telemóv")
+	f("geladeira", "geladeir")
+	f("frigorífico", "frigoríf")
+	f("sorvete", "sorvet")
+	f("gelado", "gel")
+	f("banheiro", "banheir")
+	f("casa-de-banho", "casa-de-banh")
+	f("café", "caf")
+	f("cafezinho", "cafezinh")
+	f("pequeno-almoço", "pequeno-almoc")
+	f("cidadão", "cidadã")
+	f("cidadãos", "cidadã")
+	f("cidadã", "cidadã")
+	f("pão", "pã")
+	f("pães", "pã")
+	f("mão", "mã")
+	f("mãos", "mã")
+	f("irmão", "irmã")
+	f("irmãos", "irmã")
+	f("irmã", "irmã")
+	f("coração", "coraçã")
+	f("corações", "coraçõ")
+	f("razão", "razã")
+	f("razões", "razõ")
+	f("nação", "naçã")
+	f("nações", "naçõ")
+	f("nacional", "nacional")
+	f("nacionalidade", "nacional")
+	f("nacionalidades", "nacional")
+	f("nacionalmente", "nacional")
+	f("felicidade", "felic")
+	f("felicidades", "felic")
+	f("possibilidade", "possibil")
+	f("possibilidades", "possibil")
+	f("capacidade", "capac")
+	f("atividade", "ativ")
+	f("actividade", "activ")
+	f("atividades", "ativ")
+	f("actividades", "activ")
+	f("responsabilidade", "respons")
+	f("estabilidade", "estabil")
+	f("realidade", "realidad")
+	f("cidade", "cidad")
+	f("cidades", "cidad")
+	f("rapidamente", "rapid")
+	f("lentamente", "lent")
+	f("facilmente", "facil")
+	f("felizmente", "feliz")
+	f("provavelmente", "provavel")
+	f("naturalmente", "natural")
+	f("certamente", "cert")
+	f("completamente", "complet")
+	f("absolutamente", "absolut")
+	f("extremamente", "extrem")
+	f("finalmente", "final")
+	f("claramente", "clar")
+	f("intensivamente", "intens")
+	f("ativamente", "ativ")
+	f("activamente", "activ")
+	f("consideravelmente", "consider")
+	f("possivelmente", "possivel")
+	f("abundante", "abund")
+	f("abundantes", "abund")
+	f("abundância", "abund")
+	f("importância", "import")
+	f("importante", "import")
+	f("importantes", "import")
+	f("elegância", "eleg")
+	f("elegante", "eleg")
+	f("ciência", "ciénc")
+	f("ciências", "ciénc")
+	f("consciência", "consciénc")
+	f("experiência", "experient")
+	f("experiências", "experient")
+	f("frequência", "frequénc")
+	f("frequente", "frequent")
+	f("inteligência", "inteligent")
+	f("inteligente", "inteligent")
+	f("paciência", "paciénc")
+	f("existência", "existent")
+	f("biologia", "biolog")
+	f("biologias", "biolog")
+	f("tecnologia", "tecnolog")
+	f("tecnologias", "tecnolog")
+	f("psicologia", "psicolog")
+	f("ideologia", "ideolog")
+	f("evolução", "evolu")
+	f("evoluções", "evolu")
+	f("solução", "soluçã")
+	f("soluções", "soluçõ")
+	f("resolução", "resolu")
+	f("revolução", "revolu")
+	f("revoluções", "revolu")
+	f("poluição", "poluiçã")
+	f("diminuição", "diminuiçã")
+	f("formação", "formaçã")
+	f("formações", "formaçõ")
+	f("informação", "inform")
+	f("informações", "inform")
+	f("organização", "organiz")
+	f("organizações", "organiz")
+	f("comunicação", "comunic")
+	f("comunicações", "comunic")
+	f("educação", "educ")
+	f("população", "popul")
+	f("populações", "popul")
+	f("situação", "situaçã")
+	f("situações", "situaçõ")
+	f("criação", "criaçã")
+	f("criações", "criaçõ")
+	f("criador", "criador")
+	f("criadora", "criador")
+	f("criadores", "criador")
+	f("criadoras", "criador")
+	f("trabalhador", "trabalh")
+	f("trabalhadora", "trabalh")
+	f("trabalhadores", "trabalh")
+	f("jogador", "jogador")
+	f("jogadores", "jogador")
+	f("vendedor", "vendedor")
+	f("vendedora", "vendedor")
+	f("professor", "professor")
+	f("professora", "professor")
+	f("professores", "professor")
+	f("pensamento", "pensament")
+	f("pensamentos", "pensament")
+	f("conhecimento", "conhec")
+	f("conhecimentos", "conhec")
+	f("movimento", "moviment")
+	f("movimentos", "moviment")
+	f("casamento", "casament")
+	f("casamentos", "casament")
+	f("tratamento", "tratament")
+	f("sentimento", "sentiment")
+	f("sentimentos", "sentiment")
+	f("crescimento", "cresciment")
+	f("desenvolvimento", "desenvolv")
+	f("nascimento", "nasciment")
+	f("gostoso", "gostos")
+	f("gostosa", "gostos")
+	f("gostosos", "gostos")
+	f("famoso", "famos")
+	f("famosa", "famos")
+	f("famosos", "famos")
+	f("famosas", "famos")
+	f("perigoso", "perig")
+	f("perigosa", "perig")
+	f("maravilhoso", "maravilh")
+	f("maravilhosa", "maravilh")
+	f("curioso", "curios")
+	f("religioso", "religi")
+	f("beleza", "belez")
+	f("belezas", "belez")
+	f("tristeza", "tristez")
+	f("riqueza", "riquez")
+	f("pobreza", "pobrez")
+	f("natureza", "natur")
+	f("certeza", "certez")
+	f("certezas", "certez")
+	f("grandeza", "grandez")
+	f("turista", "turist")
+	f("turistas", "turist")
+	f("artista", "artist")
+	f("artistas", "artist")
+	f("jornalista", "jornal")
+	f("jornalistas", "jornal")
+	f("socialista", "social")
+	f("capitalismo", "capital")
+	f("socialismo", "social")
+	f("turismo", "turism")
+	f("realismo", "realism")
+	f("jornalismo", "jornal")
+	f("idealismo", "ideal")
+	f("público", "públic")
+	f("pública", "públic")
+	f("públicos", "públic")
+	f("públicas", "públic")
+	f("político", "polít")
+	f("política", "polít")
+	f("políticos", "polít")
+	f("políticas", "polít")
+	f("histórico", "histór")
+	f("histórica", "histór")
+	f("música", "músic")
+	f("músicas", "músic")
+	f("técnica", "técnic")
+	f("técnico", "técnic")
+	f("técnicos", "técnic")
+	f("lógica", "lógic")
+	f("prática", "prátic")
+	f("práticas", "prátic")
+	f("ativo", "ativ")
+	f("activo", "activ")
+	f("ativa", "ativ")
+	f("activa", "activ")
+	f("ativos", "ativ")
+	f("positivo", "posit")
+	f("positiva", "posit")
+	f("positivos", "posit")
+	f("negativo", "negat")
+	f("negativa", "negat")
+	f("criativo", "criativ")
+	f("criativa", "criativ")
+	f("educativo", "educ")
+	f("educativa", "educ")
+	f("legislativo", "legisl")
+	f("comparativo", "compar")
+	f("amável", "amável")
+	f("agradável", "agrad")
+	f("responsável", "respons")
+	f("responsáveis", "respons")
+	f("possível", "possível")
+	f("possíveis", "possív")
+	f("impossível", "imposs")
+	f("terrível", "terrível")
+	f("incrível", "incrível")
+	f("visível", "visível")
+	f("brasileira", "brasileir")
+	f("brasileiras", "brasileir")
+	f("brasileiro", "brasileir")
+	f("brasileiros", "brasileir")
+	f("carreira", "carreir")
+	f("carreiras", "carreir")
+	f("cadeira", "cadeir")
+	f("cadeiras", "cadeir")
+	f("bandeira", "bandeir")
+	f("bandeiras", "bandeir")
+	f("primeira", "primeir")
+	f("primeiro", "primeir")
+	f("falar", "fal")
+	f("falando", "fal")
+	f("falado", "fal")
+	f("falava", "fal")
+	f("falávamos", "fal")
+	f("falavam", "fal")
+	f("falaram", "fal")
+	f("falará", "fal")
+	f("falaria", "fal")
+	f("falaríamos", "fal")
+	f("falasse", "fal")
+	f("falássemos", "fal")
+	f("falou", "fal")
+	f("falei", "fal")
+	f("falamos", "fal")
+	f("falámos", "fal")
+	f("falaste", "fal")
+	f("falastes", "fal")
+	f("falais", "fal")
+	f("falareis", "fal")
+	f("falásseis", "fal")
+	f("falem", "fal")
+	f("falarem", "fal")
+	f("comer", "com")
+	f("comendo", "com")
+	f("comido", "com")
+	f("comia", "com")
+	f("comíamos", "com")
+	f("comeram", "com")
+	f("comerá", "com")
+	f("comeria", "com")
+	f("comesse", "com")
+	f("comêssemos", "com")
+	f("comeu", "com")
+	f("comi", "com")
+	f("comemos", "com")
+	f("comeis", "com")
+	f("comereis", "com")
+	f("comêsseis", "coméss")
+	f("partir", "part")
+	f("partindo", "part")
+	f("partido", "part")
+	f("partia", "part")
+	f("partíamos", "part")
+	f("partiram", "part")
+	f("partirá", "part")
+	f("partiria", "part")
+	f("partisse", "part")
+	f("partíssemos", "part")
+	f("partiu", "part")
+	f("parti", "part")
+	f("partimos", "part")
+	f("partis", "part")
+	f("partireis", "part")
+	f("partísseis", "part")
+	f("cantar", "cant")
+	f("cantando", "cant")
+	f("cantarão", "cant")
+	f("cantaram", "cant")
+	f("cantávamos", "cant")
+	f("venderam", "vend")
+	f("venderão", "vend")
+	f("abriram", "abrir")
+	f("abrirão", "abrirã")
+	f("seguir", "segu")
+	f("seguinte", "seguint")
+	f("seguindo", "segu")
+	f("conseguir", "consegu")
+	f("conseguimos", "consegu")
+	f("conseguiu", "consegu")
+	f("distinguir", "distingu")
+	f("distinguiu", "distingu")
+	f("guerra", "guerr")
+	f("guerras", "guerr")
+	f("guerreiro", "guerreir")
+	f("foguete", "foguet")
+	f("foguetes", "foguet")
+	f("alegue", "aleg")
+	f("alegues", "alegu")
+	f("pague", "pag")
+	f("pagues", "pagu")
+	f("chegue", "cheg")
+	f("cheguei", "chegu")
+	f("ninguém", "ninguém")
+	f("açúcar", "açúc")
+	f("almoço", "almoc")
+	f("almoços", "almoc")
+	f("começo", "comec")
+	f("começar", "comec")
+	f("começou", "comec")
+	f("começa", "comec")
+	f("dança", "danc")
+	f("dançar", "danc")
+	f("danças", "danc")
+	f("criança", "crianc")
+	f("crianças", "crianc")
+	f("esperança", "esperanc")
+	f("esperanças", "esperanc")
+	f("mudança", "mudanc")
+	f("mudanças", "mudanc")
+	f("força", "forc")
+	f("forças", "forc")
+	f("faça", "fac")
+	f("faço", "fac")
+	f("fiz", "fiz")
+	f("fez", "fez")
+	f("cabeça", "cabec")
+	f("cabeças", "cabec")
+	f("praça", "prac")
+	f("praças", "prac")
+	f("ameaça", "ameac")
+	f("ameaçar", "ameac")
+	f("voz", "voz")
+	f("vozes", "voz")
+	f("paz", "paz")
+	f("feliz", "feliz")
+	f("felizes", "feliz")
+	f("capaz", "capaz")
+	f("capazes", "capaz")
+	f("rapaz", "rapaz")
+	f("rapazes", "rapaz")
+	f("luz", "luz")
+	f("luzes", "luz")
+	f("vez", "vez")
+	f("vezes", "vez")
+	f("nariz", "nariz")
+	f("arroz", "arroz")
+	f("relógio", "relógi")
+	f("relógios", "relógi")
+	f("escritório", "escritóri")
+	f("escritórios", "escritóri")
+	f("notícia", "notíc")
+	f("notícias", "notíc")
+	f("polícia", "políc")
+	f("polícias", "políc")
+	f("família", "famíl")
+	f("famílias", "famíl")
+	f("história", "histór")
+	f("histórias", "histór")
+	f("memória", "memór")
+	f("memórias", "memór")
+	f("vitória", "vitór")
+	f("vitórias", "vitór")
+	f("farmácia", "farmác")
+	f("farmácias", "farmác")
+	f("indústria", "indústr")
+	f("indústrias", "indústr")
+	f("espécie", "espéc")
+	f("espécies", "espéc")
+	f("série", "séri")
+	f("séries", "séri")
+	f("superfície", "superfíc")
+	f("superfícies", "superfíc")
+	f("água", "águ")
+	f("águas", "águ")
+	f("língua", "língu")
+	f("línguas", "língu")
+	f("pinguim", "pinguim")
+	f("bilíngue", "bilíng")
+	f("bilingue", "biling")
+	f("frequentemente", "frequent")
+	f("freqüentemente", "freqüent")
+	f("lingüística", "lingüíst")
+	f("linguística", "linguíst")
+	f("cinquenta", "cinquent")
+	f("cinqüenta", "cinqüent")
+	f("tranquilo", "tranquil")
+	f("tranqüilo", "tranqüil")
+	f("sequência", "sequénc")
+	f("seqüência", "seqüénc")
+	f("aguentar", "aguent")
+	f("agüentar", "agüent")
+	f("ideia", "ide")
+	f("idéia", "idé")
+	f("ideias", "ide")
+	f("idéias", "idé")
+	f("assembleia", "assembl")
+	f("assembléia", "assembl")
+	f("europeu", "europ")
+	f("europeia", "europ")
+	f("européia", "europ")
+	f("heroico", "heroic")
+	f("heróico", "heróic")
+	f("estreia", "estre")
+	f("estréia", "estré")
+	f("jiboia", "jibo")
+	f("jibóia", "jibó")
+	f("voo", "voo")
+	f("vôo", "vóo")
+	f("voos", "voos")
+	f("vôos", "vóos")
+	f("enjoo", "enjo")
+	f("enjôo", "enjó")
+	f("creem", "cre")
+	f("crêem", "cré")
+	f("leem", "leem")
+	f("lêem", "léem")
+	f("veem", "veem")
+	f("vêem", "véem")
+	f("parabéns", "parabéns")
+	f("também", "também")
+	f("alguém", "alguém")
+	f("armazém", "armazém")
+	f("armazéns", "armazéns")
+	f("homem", "hom")
+	f("homens", "homens")
+	f("viagem", "viag")
+	f("viagens", "viagens")
+	f("imagem", "imag")
+	f("imagens", "imagens")
+	f("garagem", "garag")
+	f("garagens", "garagens")
+	f("jovem", "jov")
+	f("jovens", "jovens")
+	f("bom", "bom")
+	f("bons", "bons")
+	f("um", "um")
+	f("uns", "uns")
+	f("jardim", "jardim")
+	f("jardins", "jardins")
+	f("fim", "fim")
+	f("fins", "fins")
+	f("som", "som")
+	f("sons", "sons")
+	f("atum", "atum")
+}
+
+func TestPortugueseStemmer_regions(t *testing.T) {
+	s := NewPortugueseStemmer()
+
+	f := func(word, rv, r1, r2 string) {
+		t.Helper()
+		rvStart, r1Start, r2Start := s.regions(word)
+		require.Equal(t, rv, word[rvStart:])
+		require.Equal(t, r1, word[r1Start:])
+		require.Equal(t, r2, word[r2Start:])
+	}
+
+	f("macho", "ho", "ho", "")
+	f("oliva", "va", "iva", "a")
+	f("trabalho", "balho", "alho", "ho")
+	f("áureo", "eo", "eo", "")
+	f("coraca~o", "aca~o", "aca~o", "a~o")
+	f("ao", "", "", "")
+}
@@ -0,0 +1,658 @@
+package stemmer
+
+import (
+	"strings"
+	"unicode"
+)
+
+var (
+	roStopWords = map[string]struct{}{
+		"a":         {},
+		"abia":      {},
+		"acea":      {},
+		"aceasta":   {},
+		"această":   {},
+		"aceea":     {},
+		"aceeași":   {},
+		"acei":      {},
+		"aceia":     {},
+		"acel":      {},
+		"acela":     {},
+		"același":   {},
+		"acele":     {},
+		"acelea":    {},
+		"acest":     {},
+		"acesta":    {},
+		"aceste":    {},
+		"acestea":   {},
+		"acestei":   {},
+		"acestia":   {},
+		"acestora":  {},
+		"acestui":   {},
+		"acești":    {},
+		"acolo":     {},
+		"acum":      {},
+		"adică":     {},
+		"ai":        {},
+		"aia":       {},
+		"aibă":      {},
+		"aici":      {},
+		"al":        {},
+		"ale":       {},
+		"alea":      {},
+		"alt":       {},
+		"alta":      {},
+		"altceva":   {},
+		"altcineva": {},
+		"alte":      {},
+		"altfel":    {},
+		"altul":     {},
+		"alți":      {},
+		"am":        {},
+		"anume":     {},
+		"apoi":      {},
+		"ar":        {},
+		"are":       {},
+		"as":        {},
+		"asa":       {},
+		"asta":      {},
+		"astfel":    {},
+		"astăzi":    {},
+		"asupra":    {},
+		"atare":     {},
+		"atunci":    {},
+		"atât":      {},
+		"atâta":     {},
+		"atâtea":    {},
+		"atâția":    {},
+		"au":        {},
+		"avea":      {},
+		"avem":      {},
+		"aveți":     {},
+		"avut":      {},
+		"azi":       {},
+		"ba":        {},
+		"bine":      {},
+		"ca":        {},
+		"cand":      {},
+		"care":      {},
+		"carei":     {},
+		"ce":        {},
+		"cea":       {},
+		"ceea":      {},
+		"cei":       {},
+		"ceilalți":  {},
+		"cel":       {},
+		"cele":      {},
+		"celor":     {},
+		"ceva":      {},
+		"chiar":     {},
+		"ci":        {},
+		"cine":      {},
+		"cineva":    {},
+		"cu":        {},
+		"cum":       {},
+		"cumva":     {},
+		"când":      {},
+		"cât":       {},
+		"câte":      {},
+		"câți":      {},
+		"cît":       {},
+		"cîte":      {},
+		"cîți":      {},
+		"căci":      {},
+		"către":     {},
+		"da":        {},
+		"daca":      {},
+		"dacă":      {},
+		"dar":       {},
+		"de":        {},
+		"deasupra":  {},
+		"deci":      {},
+		"decât":     {},
+		"deja":      {},
+		"deoarece":  {},
+		"departe":   {},
+		"deși":      {},
+		"din":       {},
+		"dintr":     {},
+		"dintre":    {},
+		"doar":      {},
+		"după":      {},
+		"ea":        {},
+		"ei":        {},
+		"el":        {},
+		"ele":       {},
+		"eram":      {},
+		"este":      {},
+		"eu":        {},
+		"ești":      {},
+		"fi":        {},
+		"fie":       {},
+		"fiecare":   {},
+		"fiind":     {},
+		"foarte":    {},
+		"fost":      {},
+		"fără":      {},
+		"ia":        {},
+		"iar":       {},
+		"ii":        {},
+		"la":        {},
+		"le":        {},
+		"li":        {},
+		"lor":       {},
+		"lui":       {},
+		"mai":       {},
+		"mare":      {},
+		"mea":       {},
+		"mei":       {},
+		"mele":      {},
+		"mereu":     {},
+		"meu":       {},
+		"mi":        {},
+		"mie":       {},
+		"mine":      {},
+		"mult":      {},
+		"multă":     {},
+		"mulți":     {},
+		"ne":        {},
+		"nici":      {},
+		"nimic":     {},
+		"niște":     {},
+		"noi":       {},
+		"nostru":    {},
+		"nouă":      {},
+		"noștri":    {},
+		"nu":        {},
+		"numai":     {},
+		"o":         {},
+		"or":        {},
+		"ori":       {},
+		"oricare":   {},
+		"orice":     {},
+		"oricine":   {},
+		"oricum":    {},
+		"oricând":   {},
+		"oriunde":   {},
+		"pe":        {},
+		"pentru":    {},
+		"peste":     {},
+		"poate":     {},
+		"pot":       {},
+		"prea":      {},
+		"prin":      {},
+		"printr":    {},
+		"până":      {},
+		"pînă":      {},
+		"sa":        {},
+		"sale":      {},
+		"sau":       {},
+		"se":        {},
+		"sunt":      {},
+		"suntem":    {},
+		"sunteți":   {},
+		"sus":       {},
+		"sînt":      {},
+		"să":        {},
+		"și":        {},
+		"său":       {},
+		"ta":        {},
+		"tale":      {},
+		"te":        {},
+		"ti":        {},
+		"tine":      {},
+		"toate":     {},
+		"toată":     {},
+		"tot":       {},
+		"totuși":    {},
+		"toți":      {},
+		"tu":        {},
+		"tuturor":   {},
+		"un":        {},
+		"una":       {},
+		"unde":      {},
+		"unei":      {},
+		"unele":     {},
+		"uneori":    {},
+		"unii":      {},
+		"unor":      {},
+		"unui":      {},
+		"unul":      {},
+		"va":        {},
+		"voi":       {},
+		"vom":       {},
+		"vor":       {},
+		"vostru":    {},
+		"vouă":      {},
+		"voștri":    {},
+		"vreo":      {},
+		"vreun":     {},
+		"vă":        {},
+		"îi":        {},
+		"îl":        {},
+		"îmi":       {},
+		"împotriva": {},
+		"în":        {},
+		"înainte":   {},
+		"înaintea":  {},
+		"încât":     {},
+		"încă":      {},
+		"între":     {},
+		"întrucât":  {},
+		"îți":       {},
+	}
+
+	roStep0Suffixes = []string{
+		"iilor",
+		"atei",
+		"ația",
+		"ație",
+		"elor",
+		"iile",
+		"ilor",
+		"ului",
+		"aua",
+		"ele",
+		"iei",
+		"ile",
+		"iua",
+		"ea",
+		"ii",
+		"ul",
+	}
+
+	roComboSuffixes = []string{
+		"abilitate",
+		"abilitati",
+		"abilități",
+		"ibilitate",
+		"abilităi",
+		"icatori",
+		"icitate",
+		"icitati",
+		"icități",
+		"ivitate",
+		"ivitati",
+		"ivități",
+		"atoare",
+		"ațiune",
+		"icator",
+		"icităi",
+		"itoare",
+		"ivităi",
+		"ițiune",
+		"ătoare",
+		"ativa",
+		"ative",
+		"ativi",
+		"ativă",
+		"atori",
+		"icala",
+		"icale",
+		"icali",
+		"icală",
+		"iciva",
+		"icive",
+		"icivi",
+		"icivă",
+		"itiva",
+		"itive",
+		"itivi",
+		"itivă",
+		"itori",
+		"ători",
+		"ativ",
+		"ator",
+		"ical",
+		"iciv",
+		"itiv",
+		"itor",
+		"ător",
+	}
+
+	roStandardSuffixes = []string{
+		"abila",
+		"abile",
+		"abili",
+		"abilă",
+		"atori",
+		"ibila",
+		"ibile",
+		"ibili",
+		"ibilă",
+		"itate",
+		"itati",
+		"ități",
+		"abil",
+		"anta",
+		"ante",
+		"anti",
+		"antă",
+		"ator",
+		"ibil",
+		"isme",
+		"ista",
+		"iste",
+		"isti",
+		"istă",
+		"ităi",
+		"iune",
+		"iuni",
+		"iști",
+		"oasa",
+		"oase",
+		"oasă",
+		"ant",
+		"ata",
+		"ate",
+		"ati",
+		"ată",
+		"ica",
+		"ice",
+		"ici",
+		"ică",
+		"ism",
+		"ist",
+		"ita",
+		"ite",
+		"iti",
+		"ită",
+		"iva",
+		"ive",
+		"ivi",
+		"ivă",
+		"osi",
+		"oși",
+		"uta",
+		"ute",
+		"uti",
+		"ută",
+		"at",
+		"ic",
+		"it",
+		"iv",
+		"os",
+		"ut",
+	}
+
+	roVerbSuffixes = []string{
+		"seserăți",
+		"aserăți",
+		"iserăți",
+		"seserăm",
+		"userăți",
+		"âserăți",
+		"aserăm",
+		"iserăm",
+		"serăți",
+		"seseră",
+		"seseși",
+		"userăm",
+		"âserăm",
+		"arăți",
+		"aseră",
+		"aseși",
+		"ească",
+		"irăți",
+		"iseră",
+		"iseși",
+		"serăm",
+		"sesem",
+		"urăți",
+		"useră",
+		"useși",
+		"ârăți",
+		"âseră",
+		"âseși",
+		"arăm",
+		"asem",
+		"ează",
+		"eați",
+		"ește",
+		"ești",
+		"iați",
+		"indu",
+		"irăm",
+		"isem",
+		"seră",
+		"sese",
+		"seși",
+		"urăm",
+		"usem",
+		"ându",
+		"ârăm",
+		"âsem",
+		"ăște",
+		"ăști",
+		"are",
+		"ară",
+		"ase",
+		"ași",
+		"ați",
+		"eai",
+		"eam",
+		"eau",
+		"ere",
+		"esc",
+		"eze",
+		"ezi",
+		"eți",
+		"iai",
+		"iam",
+		"iau",
+		"ind",
+		"ire",
+		"iră",
+		"ise",
+		"iși",
+		"iți",
+		"sei",
+		"ură",
+		"use",
+		"uși",
+		"ând",
+		"âre",
+		"âră",
+		"âse",
+		"âși",
+		"âți",
+		"ăsc",
+		"ai",
+		"am",
+		"au",
+		"ea",
+		"em",
+		"ez",
+		"ia",
+		"im",
+		"se",
+		"ui",
+		"âi",
+		"âm",
+		"ăm",
+	}
+
+	roVowelSuffixes = []string{"ie", "a", "e", "i", "ă"}
+
+	// roCedillaReplacer maps the legacy cedilla letters ş and ţ to the
+	// comma-below letters ș and ț, which the suffix tables are written in.
+	roCedillaReplacer = strings.NewReplacer("ş", "ș", "ţ", "ț")
+	roMarkerReplacer  = strings.NewReplacer("I", "i", "U", "u")
+)
+
+type RomanianStemmer struct{}
+
+// NewRomanianStemmer creates a new RomanianStemmer.
+func NewRomanianStemmer() *RomanianStemmer {
+	return &RomanianStemmer{}
+}
+
+// Stem returns the stem of the given word. Both the cedilla (ş, ţ) and the
+// comma-below (ș, ț) spellings are accepted; stems use the comma-below form.
+func (s RomanianStemmer) Stem(word string) string {
+	word = roCedillaReplacer.Replace(strings.ToLower(word))
+	if s.isStopWord(word) {
+		return word
+	}
+
+	word = s.markVowels(word)
+	rv, r1, r2 := s.regions(word)
+
+	word = s.step0(word, r1)
+
+	var removed bool
+	if word, removed = s.standardSuffix(word, r1, r2); !removed {
+		word = s.verbSuffix(word, rv)
+	}
+
+	word = s.vowelSuffix(word, rv)
+
+	return roMarkerReplacer.Replace(word)
+}
+
+// step0 reduces plural and article endings in R1.
+func (s RomanianStemmer) step0(word string, r1 int) string {
+	suffix := longestSuffix(word, roStep0Suffixes)
+	if suffix == "" || !inRegion(word, suffix, r1) {
+		return word
+	}
+	stem := word[:len(word)-len(suffix)]
+
+	switch suffix {
+	case "ul", "ului":
+		return stem
+	case "aua":
+		return stem + "a"
+	case "ea", "ele", "elor":
+		return stem + "e"
+	case "ile":
+		if strings.HasSuffix(stem, "ab") {
+			return word
+		}
+		return stem + "i"
+	case "atei":
+		return stem + "at"
+	case "ație", "ația":
+		return stem + "ați"
+	}
+	return stem + "i"
+}
+
+// standardSuffix reduces combined suffixes in R1 for as long as possible,
+// then removes a standard suffix in R2. It reports whether any suffix was
+// removed or reduced.
+func (s RomanianStemmer) standardSuffix(word string, r1, r2 int) (string, bool) {
+	var removed bool
+	for {
+		stem, ok := s.comboSuffix(word, r1)
+		if !ok {
+			break
+		}
+		word, removed = stem, true
+	}
+
+	suffix := longestSuffix(word, roStandardSuffixes)
+	if suffix == "" || !inRegion(word, suffix, r2) {
+		return word, removed
+	}
+	stem := word[:len(word)-len(suffix)]
+
+	switch suffix {
+	case "iune", "iuni":
+		if !strings.HasSuffix(stem, "ț") {
+			return word, removed
+		}
+		return strings.TrimSuffix(stem, "ț") + "t", true
+	case "ism", "isme", "ist", "ista", "iste", "isti", "istă", "iști":
+		return stem + "ist", true
+	}
+	return stem, true
+}
+
+// comboSuffix reduces a combined suffix in R1 to its first part.
+func (s RomanianStemmer) comboSuffix(word string, r1 int) (string, bool) {
+	suffix := longestSuffix(word, roComboSuffixes)
+	if suffix == "" || !inRegion(word, suffix, r1) {
+		return word, false
+	}
+	stem := word[:len(word)-len(suffix)]
+
+	switch suffix {
+	case "abilitate", "abilitati", "abilități", "abilităi":
+		return stem + "abil", true
+	case "ibilitate":
+		return stem + "ibil", true
+	case "ivitate", "ivitati", "ivități", "ivităi":
+		return stem + "iv", true
+	case "itoare", "ițiune", "itiva", "itive", "itivi", "itivă", "itori", "itiv", "itor":
+		return stem + "it", true
+	case "atoare", "ațiune", "ătoare", "ativa", "ative", "ativi", "ativă", "atori", "ători", "ativ", "ator", "ător":
+		return stem + "at", true
+	}
+	return stem + "ic", true
+}
+
+// verbSuffix removes a verb suffix in RV. Most suffixes must also follow a
+// consonant or u inside RV.
+func (s RomanianStemmer) verbSuffix(word string, rv int) string {
+	suffix := longestSuffix(region(word, rv), roVerbSuffixes)
+	if suffix == "" {
+		return word
+	}
+	stem := word[:len(word)-len(suffix)]
+
+	switch suffix {
+	case "seserăți", "seserăm", "serăți", "seseră", "seseși", "serăm", "sesem", "seră", "sese", "seși",
+		"ați", "eți", "iți", "sei", "âți", "em", "im", "se", "âm", "ăm":
+		return stem
+	}
+
+	if len(stem) <= rv {
+		return word
+	}
+	if last := lastRune(stem); last != 'u' && s.isVowel(last) {
+		return word
+	}
+	return stem
+}
+
+// vowelSuffix removes a final vowel, or a final ie, in RV.
+func (s RomanianStemmer) vowelSuffix(word string, rv int) string {
+	if suffix := longestSuffix(word, roVowelSuffixes); suffix != "" && inRegion(word, suffix, rv) {
+		return word[:len(word)-len(suffix)]
+	}
+	return word
+}
+
+// markVowels upper-cases u and i between vowels, so that they are treated
+// as consonants.
+func (s RomanianStemmer) markVowels(word string) string {
+	runes := []rune(word)
+	for i := 1; i < len(runes)-1; i++ {
+		if (runes[i] == 'u' || runes[i] == 'i') && s.isVowel(runes[i-1]) && s.isVowel(runes[i+1]) {
+			runes[i] = unicode.ToUpper(runes[i])
+		}
+	}
+	return string(runes)
+}
+
+// isStopWord returns true if the given word is a stop word.
+func (s RomanianStemmer) isStopWord(word string) bool {
+	_, found := roStopWords[word]
+	return found
+}
+
+func (s RomanianStemmer) isVowel(r rune) bool {
+	switch r {
+	case 'a', 'e', 'i', 'o', 'u', 'â', 'î', 'ă':
+		return true
+	default:
+		return false
+	}
+}
+
+// regions returns the byte offsets of RV, R1 and R2.
+func (s RomanianStemmer) regions(word string) (int, int, int) {
+	r1, r2 := standardRegions(word, s.isVowel)
+	return romanceRV(word, s.isVowel), r1, r2
+}
@@ -0,0 +1,189 @@
+package stemmer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRomanianStemmer(t *testing.T) {
+	s := NewRomanianStemmer()
+	require.NotNil(t, s)
+}
+
+func TestRomanianStemmer_isStopWord(t *testing.T) {
+	s := NewRomanianStemmer()
+	require.True(t, s.isStopWord("și"))
+	require.False(t, s.isStopWord("casă"))
+}
+
+func TestRomanianStemmer_Stem(t *testing.T) {
+	s := NewRomanianStemmer()
+	t.Run("stop word", func(t *testing.T) {
+		require.Equal(t, "și", s.Stem("și"))
+		require.Equal(t, "și", s.Stem("ŞI"))
+	})
+
+	t.Run("cedilla", func(t *testing.T) {
+		require.Equal(t, "cunoștinț", s.Stem("cunoştinţe"))
+		require.Equal(t, s.Stem("țările"), s.Stem("ţările"))
+		require.Equal(t, s.Stem("Școlile"), s.Stem("Şcolile"))
+	})
+
+	f := func(word, stem string) {
+		t.Helper()
+		require.Equal(t, stem, s.Stem(word))
+	}
+
+	f("abilitate", "abil")
+	f("abilități", "abil")
+	f("absolut", "absol")
+	f("accesibilitate", "acces")
+	f("acțiune", "acțiun")
+	f("acțiunile", "acțiun")
+	f("actorului", "actor")
+	f("administrație", "administr")
+	f("administrația", "administr")
+	f("agricultură", "agricult")
+	f("alergând", "alerg")
+	f("alimentație", "aliment")
+	f("analizează", "analiz")
+	f("apartamentele", "apartament")
+	f("aplicațiile", "aplic")
+	f("argumentație", "argument")
+	f("arhitectură", "arhitect")
+	f("artistic", "artist")
+	f("artistului", "artist")
+	f("atenție", "atenț")
+	f("autoritatea", "autor")
+	f("autoritățile", "autor")
+	f("bibliotecă", "bibliotec")
+	f("bibliotecile", "bibliotec")
+	f("bucureștiului", "bucur")
+	f("calculatoare", "calcul")
+	f("capitalism", "capitalist")
+	f("caracteristică", "caracterist")
+	f("cărțile", "cărț")
+	f("casele", "cas")
+	f("câinele", "câin")
+	f("cetățenilor", "cetățen")
+	f("civilizație", "civiliz")
+	f("comunicare", "comunic")
+	f("comunismul", "comunist")
+	f("comuniști", "comunist")
+	f("constituțională", "constituțional")
+	f("construcțiilor", "construcț")
+	f("creativitate", "creativ")
+	f("cultural", "cultural")
+	f("culturale", "cultural")
+	f("cunoștințe", "cunoștinț")
+	f("deciziile", "deciz")
+	f("democrație", "democr")
+	f("dezvoltare", "dezvolt")
+	f("dezvoltarea", "dezvolt")
+	f("dezvoltăm", "dezvolt")
+	f("diferențele", "diferenț")
+	f("discuțiile", "discuț")
+	f("documentele", "document")
+	f("economică", "econom")
+	f("economiștilor", "economist")
+	f("educațional", "educațional")
+	f("elevilor", "elev")
+	f("europeană", "european")
+	f("explicații", "explic")
+	f("fabricație", "fabric")
+	f("familiile", "famil")
+	f("fericire", "feric")
+	f("fericiți", "feric")
+	f("frumoasă", "frumoas")
+	f("frumoase", "frumoas")
+	f("frumos", "frumos")
+	f("funcționează", "funcțion")
+	f("generație", "gener")
+	f("geografie", "geograf")
+	f("guvernului", "guvern")
+	f("istoricul", "istor")
+	f("învățământ", "învățământ")
+	f("învățătorii", "învăț")
+	f("jurnaliștii", "jurnalist")
+	f("lucrează", "lucr")
+	f("lucrătorilor", "lucrat")
+	f("manifestație", "manifest")
+	f("mergeau", "merg")
+	f("muncitorilor", "muncit")
+	f("munții", "munț")
+	f("naționalitate", "național")
+	f("naționalism", "naționalist")
+	f("națiunilor", "națiun")
+	f("normalitate", "normal")
+	f("oamenilor", "oamen")
+	f("orașele", "oraș")
+	f("organizațiile", "organiz")
+	f("pământul", "pământ")
+	f("personalitate", "personal")
+	f("pescarilor", "pescar")
+	f("politicieni", "politicien")
+	f("populației", "popul")
+	f("posibilitate", "posibil")
+	f("președintelui", "președintel")
+	f("prieteni", "prieten")
+	f("prietenilor", "prieten")
+	f("problemele", "problem")
+	f("profesorului", "profesor")
+	f("programatori", "program")
+	f("publicitate", "public")
+	f("realizat", "realiz")
+	f("regiunea", "regiun")
+	f("responsabilitate", "respons")
+	f("românească", "român")
+	f("românești", "român")
+	f("scriitorului", "scriitor")
+	f("sensibilitate", "sensibil")
+	f("socialiști", "socialist")
+	f("societatea", "societ")
+	f("spitalele", "spital")
+	f("studenții", "studenț")
+	f("școlile", "școl")
+	f("științifică", "științif")
+	f("țările", "țăr")
+	f("teatrul", "teatr")
+	f("televiziunea", "televiziun")
+	f("tradițională", "tradițional")
+	f("universității", "univers")
+	f("vorbeau", "vorb")
+	f("vorbesc", "vorb")
+	f("vorbește", "vorb")
+	f("vorbind", "vorb")
+}
+
+func TestRomanianStemmer_markVowels(t *testing.T) {
+	s := NewRomanianStemmer()
+
+	f := func(input, expected string) {
+		t.Helper()
+		require.Equal(t, expected, s.markVowels(input))
+	}
+
+	f("ziua", "ziUa")
+	f("femeie", "femeIe")
+	f("băiat", "băIat")
+	f("carte", "carte")
+	f("", "")
+}
+
+func TestRomanianStemmer_regions(t *testing.T) {
+	s := NewRomanianStemmer()
+
+	f := func(word, rv, r1, r2 string) {
+		t.Helper()
+		rvStart, r1Start, r2Start := s.regions(word)
+		require.Equal(t, rv, word[rvStart:])
+		require.Equal(t, r1, word[r1Start:])
+		require.Equal(t, r2, word[r2Start:])
+	}
+
+	f("frumoasă", "moasă", "oasă", "ă")
+	f("analizează", "lizează", "alizează", "izează")
+	f("teatrul", "trul", "rul", "")
+	f("eu", "", "", "")
+}
@@ -662,10 +662,18 @@ var (
 type RussianStemmer struct {
 	verbSuffixes2       []string
 	adjectivalSuffixes2 []string
+	level               Level
 }
 
 // NewRussianStemmer creates a new RussianStemmer.
 func NewRussianStemmer() *RussianStemmer {
+	return NewRussianStemmerWithLevel(LevelFull)
+}
+
+// NewRussianStemmerWithLevel creates a RussianStemmer with the given level.
+// LevelLight only removes noun and adjective case endings, and leaves
+// reflexive verbs alone.
+func NewRussianStemmerWithLevel(level Level) *RussianStemmer {
 	adjs2 := ruAdjectivalSuffixes2
 	slices.Sort(adjs2)
 
@@ -674,6 +682,7 @@ func NewRussianStemmer() *RussianStemmer {
 	return &RussianStemmer{
 		verbSuffixes2:       verb2,
 		adjectivalSuffixes2: adjs2,
+		level:               level,
 	}
 }
 
@@ -687,6 +696,10 @@ func (s RussianStemmer) Stem(word string) string {
 	word = cyrillicToRoman(word)
 	rv, r2 := s.regions(word)
 
+	if s.level == LevelLight {
+		return romanToCyrillic(s.caseEnding(word, rv))
+	}
+
 	word, rv, r2 = s.step1(word, rv, r2)
 	word, r2 = s.step2(word, rv, r2)
 	word = s.step3(word, r2)
@@ -716,40 +729,72 @@ func (s RussianStemmer) step1(word, rv, r2 string) (string, string, string) {
 		}
 	}
 
+	if suffix := s.adjectivalSuffix(rv); suffix != "" {
+		return s.trimSuffix(word, rv, r2, suffix)
+	}
+
+	if suffix := s.verbSuffix(rv); suffix != "" {
+		return s.trimSuffix(word, rv, r2, suffix)
+	}
+
+	for _, suffix := range ruNounSuffixes {
+		if strings.HasSuffix(rv, suffix) {
+			return s.trimSuffix(word, rv, r2, suffix)
+		}
+	}
+
+	return word, rv, r2
+}
+
+// adjectivalSuffix returns the first adjectival ending in rv that may be
+// removed. Endings from ruAdjectivalSuffixes2 must follow a or я.
+func (s RussianStemmer) adjectivalSuffix(rv string) string {
 	for _, suffix := range ruAdjectivalSuffixes {
 		if strings.HasSuffix(rv, suffix) {
 			suffixLen := len(suffix)
 			_, found := slices.BinarySearch(s.adjectivalSuffixes2, suffix)
 			if found {
 				if len(rv) >= suffixLen+1 && (strings.HasSuffix(rv[:len(rv)-suffixLen], "A") || strings.HasSuffix(rv[:len(rv)-suffixLen], "a")) {
-					return s.trimSuffix(word, rv, r2, suffix)
+					return suffix
 				}
 			} else {
-				return s.trimSuffix(word, rv, r2, suffix)
+				return suffix
 			}
 		}
 	}
+	return ""
+}
 
+// verbSuffix returns the first verb ending in rv that may be removed.
+// Endings from ruVerbSuffixes2 must follow a or я.
+func (s RussianStemmer) verbSuffix(rv string) string {
 	for _, suffix := range ruVerbSuffixes {
 		if strings.HasSuffix(rv, suffix) {
 			suffixLen := len(suffix)
 			if _, found := slices.BinarySearch(s.verbSuffixes2, suffix); found {
 				if len(rv) >= suffixLen+1 && (strings.HasSuffix(rv[:len(rv)-suffixLen], "A") || strings.HasSuffix(rv[:len(rv)-suffixLen], "a")) {
-					return s.trimSuffix(word, rv, r2, suffix)
+					return suffix
 				}
 			} else {
-				return s.trimSuffix(word, rv, r2, suffix)
+				return suffix
 			}
 		}
 	}
+	return ""
+}
 
-	for _, suffix := range ruNounSuffixes {
-		if strings.HasSuffix(rv, suffix) {
-			return s.trimSuffix(word, rv, r2, suffix)
-		}
+// caseEnding removes an adjectival or noun ending in rv, and nothing else.
+// It is all the light level does. Reflexive verbs are left whole, since their
+// ся and сь would otherwise pass for noun endings.
+func (s RussianStemmer) caseEnding(word, rv string) string {
+	if suffix := longestSuffix(rv, ruReflexiveSuffixes); suffix != "" && s.verbSuffix(rv[:len(rv)-len(suffix)]) != "" {
+		return word
 	}
-
-	return word, rv, r2
+	suffix := s.adjectivalSuffix(rv)
+	if suffix == "" {
+		suffix = longestSuffix(rv, ruNounSuffixes)
+	}
+	return word[:len(word)-len(suffix)]
 }
 
 func (s RussianStemmer) trimSuffix(word, rv, r2, suffix string) (string, string, string) {
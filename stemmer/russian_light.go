@@ -0,0 +1,99 @@
+package stemmer
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+var (
+	// ruLightCaseSuffixes are the noun and adjective case and number
+	// endings of Savoy's light stemmer.
+	ruLightCaseSuffixes = []string{
+		"иями", "оями",
+
+		"ами", "его", "ему", "иям", "иях", "ими", "ого", "ому", "оев", "оям",
+		"оях", "ыми", "ями",
+
+		"ам", "ах", "ая", "ев", "ее", "ей", "ем", "ею", "ие", "ий", "их", "ию",
+		"ия", "ов", "ое", "ой", "ом", "ою", "ую", "ые", "ый", "ым", "ых", "ью",
+		"юю", "ям", "ях", "яя",
+
+		"а", "е", "и", "й", "о", "у", "ы", "ь", "ю", "я",
+	}
+
+	// ruLightDiminutiveSuffixes are the diminutive suffixes left once the
+	// case ending or possessive suffix is gone, as in книжечка or бабушкин.
+	ruLightDiminutiveSuffixes = []string{"еньк", "оньк", "ечк", "очк", "ушк", "юшк"}
+
+	// ruLightPossessiveSuffixes form possessive adjectives such as
+	// бабушкин. They are only removed after a diminutive suffix, since
+	// they also end many plain stems such as магазин or столов.
+	ruLightPossessiveSuffixes = []string{"ев", "ин", "ов", "ын"}
+)
+
+// RussianLightStemmer implements Savoy's light stemmer for Russian. Unlike
+// RussianStemmer it keeps derivational suffixes and only removes case and
+// number endings and a few possessive and diminutive forms.
+type RussianLightStemmer struct{}
+
+// NewRussianLightStemmer creates a new RussianLightStemmer.
+func NewRussianLightStemmer() *RussianLightStemmer {
+	return &RussianLightStemmer{}
+}
+
+// Stem returns the stem of the given word.
+func (s RussianLightStemmer) Stem(word string) string {
+	word = strings.ToLower(word)
+	if s.isStopWord(word) {
+		return word
+	}
+	word = strings.ReplaceAll(word, "ё", "е")
+
+	word = s.trimSuffix(word, ruLightCaseSuffixes, 3)
+	word = s.possessive(word)
+	word = s.trimSuffix(word, ruLightDiminutiveSuffixes, 3)
+	return s.normalize(word)
+}
+
+// possessive removes a possessive suffix that follows a diminutive one, as
+// in бабушкин.
+func (s RussianLightStemmer) possessive(word string) string {
+	stem := s.trimSuffix(word, ruLightPossessiveSuffixes, 4)
+	if longestSuffix(stem, ruLightDiminutiveSuffixes) == "" {
+		return word
+	}
+	return stem
+}
+
+// trimSuffix removes the longest of suffixes that leaves at least minRunes
+// runes of word.
+func (s RussianLightStemmer) trimSuffix(word string, suffixes []string, minRunes int) string {
+	length := utf8.RuneCountInString(word)
+	for _, suffix := range suffixes {
+		if strings.HasSuffix(word, suffix) && length-utf8.RuneCountInString(suffix) >= minRunes {
+			return word[:len(word)-len(suffix)]
+		}
+	}
+	return word
+}
+
+// normalize undoubles a final нн and drops a final soft sign or и from
+// stems longer than three letters.
+func (s RussianLightStemmer) normalize(word string) string {
+	if utf8.RuneCountInString(word) <= 3 {
+		return word
+	}
+	if strings.HasSuffix(word, "нн") {
+		return strings.TrimSuffix(word, "н")
+	}
+	if strings.HasSuffix(word, "и") {
+		return strings.TrimSuffix(word, "и")
+	}
+	return strings.TrimSuffix(word, "ь")
+}
+
+// isStopWord returns true if the given word is a stop word.
+func (s RussianLightStemmer) isStopWord(word string) bool {
+	_, found := ruStopWords[word]
+	return found
+}
@@ -0,0 +1,78 @@
+package stemmer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRussianLightStemmer(t *testing.T) {
+	s := NewRussianLightStemmer()
+	require.NotNil(t, s)
+}
+
+func TestRussianLightStemmer_isStopWord(t *testing.T) {
+	s := NewRussianLightStemmer()
+	require.True(t, s.isStopWord("и"))
+	require.False(t, s.isStopWord("книга"))
+}
+
+func TestRussianLightStemmer_normalize(t *testing.T) {
+	s := NewRussianLightStemmer()
+
+	f := func(word, expected string) {
+		t.Helper()
+		require.Equal(t, expected, s.normalize(word))
+	}
+
+	f("ответственн", "ответствен")
+	f("лошадь", "лошад")
+	f("мать", "мат")
+	f("ель", "ель")
+	f("истори", "истор")
+	f("суд", "суд")
+}
+
+func TestRussianLightStemmer_Stem(t *testing.T) {
+	s := NewRussianLightStemmer()
+	t.Run("stop word", func(t *testing.T) {
+		require.Equal(t, "и", s.Stem("И"))
+	})
+
+	f := func(word, stem string) {
+		t.Helper()
+		require.Equal(t, stem, s.Stem(word))
+	}
+
+	f("книга", "книг")
+	f("книги", "книг")
+	f("книгами", "книг")
+	f("книжечка", "книж")
+	f("бабушка", "баб")
+	f("бабушкин", "баб")
+	f("мамина", "мамин")
+	f("путин", "путин")
+	f("путина", "путин")
+	f("бабушкина", "баб")
+	f("магазин", "магазин")
+	f("магазины", "магазин")
+	f("витамин", "витамин")
+	f("столовая", "столов")
+	f("здоровый", "здоров")
+	f("Москва", "москв")
+	f("москвой", "москв")
+	f("законность", "законност")
+	f("законности", "законност")
+	f("красивейший", "красивейш")
+	f("ответственный", "ответствен")
+	f("ответственного", "ответствен")
+	f("лошадью", "лошад")
+	f("ёлками", "елк")
+	f("зданий", "здан")
+	f("здание", "здан")
+	f("суд", "суд")
+	f("судов", "суд")
+	f("история", "истор")
+	f("историй", "истор")
+	f("историей", "истор")
+}
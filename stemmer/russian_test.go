@@ -231,6 +231,47 @@ func TestRussianStemmer_Stem(t *testing.T) {
 	f("куртке", "куртк")
 }
 
+func TestRussianStemmer_Stem_light(t *testing.T) {
+	s := NewRussianStemmerWithLevel(LevelLight)
+	t.Run("stop word", func(t *testing.T) {
+		require.Equal(t, "и", s.Stem("И"))
+	})
+
+	f := func(word, stem string) {
+		t.Helper()
+		require.Equal(t, stem, s.Stem(word))
+	}
+
+	f("книга", "книг")
+	f("книги", "книг")
+	f("книгами", "книг")
+	f("красивый", "красив")
+	f("красивая", "красив")
+	f("красивыми", "красив")
+	f("университет", "университет")
+	f("университетов", "университет")
+	f("радость", "радост")
+	f("радостью", "радост")
+	f("лучшие", "лучш")
+	f("вселенная", "вселенн")
+	f("делаться", "делаться")
+	f("учиться", "учиться")
+	f("смеялся", "смеялся")
+	f("занималась", "занималась")
+	f("гусь", "гус")
+	f("гуся", "гус")
+	f("гусю", "гус")
+	f("гусем", "гус")
+	f("лось", "лос")
+	f("лося", "лос")
+	f("лосем", "лос")
+	f("карась", "карас")
+	f("карася", "карас")
+	f("карасей", "карас")
+	f("ось", "ос")
+	f("оси", "ос")
+}
+
 func TestCyrillicToRoman(t *testing.T) {
 	f := func(cyrillic, roman string) {
 		t.Helper()
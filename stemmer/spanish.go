@@ -0,0 +1,516 @@
+package stemmer
+
+import "strings"
+
+var (
+	esStopWords = map[string]struct{}{
+		"de":       {},
+		"la":       {},
+		"que":      {},
+		"el":       {},
+		"en":       {},
+		"y":        {},
+		"a":        {},
+		"los":      {},
+		"del":      {},
+		"se":       {},
+		"las":      {},
+		"por":      {},
+		"un":       {},
+		"para":     {},
+		"con":      {},
+		"no":       {},
+		"una":      {},
+		"su":       {},
+		"al":       {},
+		"lo":       {},
+		"como":     {},
+		"más":      {},
+		"pero":     {},
+		"sus":      {},
+		"le":       {},
+		"ya":       {},
+		"o":        {},
+		"este":     {},
+		"sí":       {},
+		"porque":   {},
+		"esta":     {},
+		"entre":    {},
+		"cuando":   {},
+		"muy":      {},
+		"sin":      {},
+		"sobre":    {},
+		"también":  {},
+		"me":       {},
+		"hasta":    {},
+		"hay":      {},
+		"donde":    {},
+		"quien":    {},
+		"desde":    {},
+		"todo":     {},
+		"nos":      {},
+		"durante":  {},
+		"todos":    {},
+		"uno":      {},
+		"les":      {},
+		"ni":       {},
+		"contra":   {},
+		"otros":    {},
+		"ese":      {},
+		"eso":      {},
+		"ante":     {},
+		"ellos":    {},
+		"e":        {},
+		"esto":     {},
+		"mí":       {},
+		"antes":    {},
+		"algunos":  {},
+		"qué":      {},
+		"unos":     {},
+		"yo":       {},
+		"otro":     {},
+		"otras":    {},
+		"otra":     {},
+		"él":       {},
+		"tanto":    {},
+		"esa":      {},
+		"estos":    {},
+		"mucho":    {},
+		"quienes":  {},
+		"nada":     {},
+		"muchos":   {},
+		"cual":     {},
+		"poco":     {},
+		"ella":     {},
+		"estar":    {},
+		"estas":    {},
+		"algunas":  {},
+		"algo":     {},
+		"nosotros": {},
+		"mi":       {},
+		"mis":      {},
+		"tú":       {},
+		"te":       {},
+		"ti":       {},
+		"tu":       {},
+		"tus":      {},
+		"ellas":    {},
+		"nosotras": {},
+		"vosotros": {},
+		"vosotras": {},
+		"os":       {},
+		"mío":      {},
+		"mía":      {},
+		"míos":     {},
+		"mías":     {},
+		"tuyo":     {},
+		"tuya":     {},
+		"tuyos":    {},
+		"tuyas":    {},
+		"suyo":     {},
+		"suya":     {},
+		"suyos":    {},
+		"suyas":    {},
+		"nuestro":  {},
+		"nuestra":  {},
+		"nuestros": {},
+		"nuestras": {},
+		"vuestro":  {},
+		"vuestra":  {},
+		"vuestros": {},
+		"vuestras": {},
+		"esos":     {},
+		"esas":     {},
+		"estoy":    {},
+		"estás":    {},
+		"está":     {},
+		"estamos":  {},
+		"estáis":   {},
+		"están":    {},
+		"he":       {},
+		"has":      {},
+		"ha":       {},
+		"hemos":    {},
+		"habéis":   {},
+		"han":      {},
+		"soy":      {},
+		"eres":     {},
+		"es":       {},
+		"somos":    {},
+		"sois":     {},
+		"son":      {},
+		"fue":      {},
+		"era":      {},
+		"tengo":    {},
+		"tiene":    {},
+		"tienen":   {},
+	}
+
+	esPronounSuffixes = []string{
+		"selas",
+		"selos",
+		"sela",
+		"selo",
+		"las",
+		"les",
+		"los",
+		"nos",
+		"me",
+		"se",
+		"la",
+		"le",
+		"lo",
+	}
+
+	// esPronounVerbEndings are the verb endings an attached pronoun must
+	// follow.
+	esPronounVerbEndings = []string{
+		"iéndo",
+		"iendo",
+		"yendo",
+		"ándo",
+		"ando",
+		"ár",
+		"ér",
+		"ír",
+		"ar",
+		"er",
+		"ir",
+	}
+
+	esStandardSuffixes = []string{
+		"amientos",
+		"imientos",
+		"aciones",
+		"uciones",
+		"amiento",
+		"imiento",
+		"adoras",
+		"adores",
+		"ancias",
+		"encias",
+		"logías",
+		"idades",
+		"amente",
+		"adora",
+		"ación",
+		"ancia",
+		"antes",
+		"encia",
+		"ibles",
+		"istas",
+		"logía",
+		"mente",
+		"ables",
+		"anzas",
+		"ismos",
+		"ución",
+		"ador",
+		"able",
+		"ante",
+		"anza",
+		"icas",
+		"icos",
+		"ible",
+		"idad",
+		"ismo",
+		"ista",
+		"ivas",
+		"ivos",
+		"osas",
+		"osos",
+		"ica",
+		"ico",
+		"iva",
+		"ivo",
+		"osa",
+		"oso",
+	}
+
+	esYVerbSuffixes = []string{
+		"yeron",
+		"yamos",
+		"yendo",
+		"yais",
+		"yan",
+		"yas",
+		"yen",
+		"yes",
+		"ya",
+		"ye",
+		"yo",
+		"yó",
+	}
+
+	esVerbSuffixes = []string{
+		"aríamos",
+		"eríamos",
+		"iríamos",
+		"iéramos",
+		"iésemos",
+		"aríais",
+		"aremos",
+		"eríais",
+		"eremos",
+		"iríais",
+		"iremos",
+		"ierais",
+		"ieseis",
+		"asteis",
+		"isteis",
+		"ábamos",
+		"áramos",
+		"ásemos",
+		"arían",
+		"arías",
+		"aréis",
+		"erían",
+		"erías",
+		"eréis",
+		"irían",
+		"irías",
+		"iréis",
+		"ieran",
+		"iesen",
+		"ieron",
+		"iendo",
+		"ieras",
+		"ieses",
+		"abais",
+		"arais",
+		"aseis",
+		"íamos",
+		"arán",
+		"arás",
+		"aría",
+		"erán",
+		"erás",
+		"ería",
+		"irán",
+		"irás",
+		"iría",
+		"iera",
+		"iese",
+		"aste",
+		"iste",
+		"aban",
+		"aran",
+		"asen",
+		"aron",
+		"ando",
+		"abas",
+		"adas",
+		"idas",
+		"aras",
+		"ases",
+		"íais",
+		"ados",
+		"idos",
+		"amos",
+		"imos",
+		"emos",
+		"ará",
+		"aré",
+		"erá",
+		"eré",
+		"irá",
+		"iré",
+		"aba",
+		"ada",
+		"ida",
+		"ara",
+		"ase",
+		"ían",
+		"ado",
+		"ido",
+		"ías",
+		"áis",
+		"éis",
+		"ía",
+		"ad",
+		"ed",
+		"id",
+		"an",
+		"ió",
+		"ar",
+		"er",
+		"ir",
+		"as",
+		"ís",
+		"en",
+		"es",
+	}
+
+	esResidualSuffixes = []string{"os", "a", "o", "á", "í", "ó", "e", "é"}
+
+	esAccentReplacer = strings.NewReplacer("á", "a", "é", "e", "í", "i", "ó", "o", "ú", "u")
+)
+
+type SpanishStemmer struct{}
+
+// NewSpanishStemmer creates a new SpanishStemmer.
+func NewSpanishStemmer() *SpanishStemmer {
+	return &SpanishStemmer{}
+}
+
+// Stem returns the stem of the given word.
+func (s SpanishStemmer) Stem(word string) string {
+	word = strings.ToLower(word)
+	if s.isStopWord(word) {
+		return word
+	}
+
+	rv, r1, r2 := s.regions(word)
+
+	word = s.attachedPronoun(word, rv)
+
+	var removed bool
+	if word, removed = s.standardSuffix(word, r1, r2); !removed {
+		if word, removed = s.yVerbSuffix(word, rv); !removed {
+			word = s.verbSuffix(word, rv)
+		}
+	}
+
+	word = s.residualSuffix(word, rv)
+
+	return esAccentReplacer.Replace(word)
+}
+
+// attachedPronoun removes a pronoun attached to an infinitive or gerund,
+// dropping the written accent the verb carried because of it.
+func (s SpanishStemmer) attachedPronoun(word string, rv int) string {
+	pronoun := longestSuffix(word, esPronounSuffixes)
+	if pronoun == "" {
+		return word
+	}
+
+	stem := word[:len(word)-len(pronoun)]
+	ending := longestSuffix(stem, esPronounVerbEndings)
+	if ending == "" || !inRegion(stem, ending, rv) {
+		return word
+	}
+
+	switch ending {
+	case "iéndo", "ándo", "ár", "ér", "ír":
+		return stem[:len(stem)-len(ending)] + esAccentReplacer.Replace(ending)
+	case "yendo":
+		if !strings.HasSuffix(stem, "uyendo") {
+			return word
+		}
+	}
+	return stem
+}
+
+func (s SpanishStemmer) standardSuffix(word string, r1, r2 int) (string, bool) {
+	suffix := longestSuffix(word, esStandardSuffixes)
+	if suffix == "" {
+		return word, false
+	}
+
+	switch suffix {
+	case "amente":
+		if !inRegion(word, suffix, r1) {
+			return word, false
+		}
+		word = word[:len(word)-len(suffix)]
+		if next := longestSuffix(word, []string{"iv", "os", "ic", "ad"}); next != "" && inRegion(word, next, r2) {
+			word = word[:len(word)-len(next)]
+			if next == "iv" && strings.HasSuffix(word, "at") && inRegion(word, "at", r2) {
+				word = word[:len(word)-2]
+			}
+		}
+		return word, true
+	}
+
+	if !inRegion(word, suffix, r2) {
+		return word, false
+	}
+	word = word[:len(word)-len(suffix)]
+
+	switch suffix {
+	case "adora", "ador", "ación", "adoras", "adores", "aciones", "ante", "antes", "ancia", "ancias":
+		word = s.trimInR2(word, "ic", r2)
+	case "logía", "logías":
+		word += "log"
+	case "ución", "uciones":
+		word += "u"
+	case "encia", "encias":
+		word += "ente"
+	case "mente":
+		if next := longestSuffix(word, []string{"ante", "able", "ible"}); next != "" {
+			word = s.trimInR2(word, next, r2)
+		}
+	case "idad", "idades":
+		if next := longestSuffix(word, []string{"abil", "ic", "iv"}); next != "" {
+			word = s.trimInR2(word, next, r2)
+		}
+	case "iva", "ivo", "ivas", "ivos":
+		word = s.trimInR2(word, "at", r2)
+	}
+	return word, true
+}
+
+func (s SpanishStemmer) yVerbSuffix(word string, rv int) (string, bool) {
+	suffix := longestSuffix(region(word, rv), esYVerbSuffixes)
+	if suffix == "" || !strings.HasSuffix(word, "u"+suffix) {
+		return word, false
+	}
+	return word[:len(word)-len(suffix)], true
+}
+
+func (s SpanishStemmer) verbSuffix(word string, rv int) string {
+	suffix := longestSuffix(region(word, rv), esVerbSuffixes)
+	if suffix == "" {
+		return word
+	}
+
+	switch suffix {
+	case "en", "es", "éis", "emos":
+		if strings.HasSuffix(word, "gu"+suffix) {
+			return word[:len(word)-len(suffix)-1]
+		}
+	}
+	return word[:len(word)-len(suffix)]
+}
+
+func (s SpanishStemmer) residualSuffix(word string, rv int) string {
+	suffix := longestSuffix(word, esResidualSuffixes)
+	if suffix == "" || !inRegion(word, suffix, rv) {
+		return word
+	}
+	word = word[:len(word)-len(suffix)]
+
+	if (suffix == "e" || suffix == "é") && strings.HasSuffix(word, "gu") && inRegion(word, "u", rv) {
+		word = word[:len(word)-1]
+	}
+	return word
+}
+
+// trimInR2 removes suffix from word if word ends with it inside R2.
+func (s SpanishStemmer) trimInR2(word, suffix string, r2 int) string {
+	if strings.HasSuffix(word, suffix) && inRegion(word, suffix, r2) {
+		return word[:len(word)-len(suffix)]
+	}
+	return word
+}
+
+// isStopWord returns true if the given word is a stop word.
+func (s SpanishStemmer) isStopWord(word string) bool {
+	_, found := esStopWords[word]
+	return found
+}
+
+func (s SpanishStemmer) isVowel(r rune) bool {
+	switch r {
+	case 'a', 'e', 'i', 'o', 'u', 'á', 'é', 'í', 'ó', 'ú', 'ü':
+		return true
+	default:
+		return false
+	}
+}
+
+// regions returns the byte offsets of RV, R1 and R2.
+func (s SpanishStemmer) regions(word string) (int, int, int) {
+	r1, r2 := standardRegions(word, s.isVowel)
+	return romanceRV(word, s.isVowel), r1, r2
+}
@@ -0,0 +1,346 @@
+package stemmer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSpanishStemmer(t *testing.T) {
+	s := NewSpanishStemmer()
+	require.NotNil(t, s)
+}
+
+func TestSpanishStemmer_isStopWord(t *testing.T) {
+	s := NewSpanishStemmer()
+	require.True(t, s.isStopWord("de"))
+	require.False(t, s.isStopWord("manzana"))
+}
+
+func TestSpanishStemmer_Stem(t *testing.T) {
+	s := NewSpanishStemmer()
+	t.Run("stop word", func(t *testing.T) {
+		require.Equal(t, "de", s.Stem("de"))
+		require.Equal(t, "de", s.Stem("De"))
+	})
+
+	f := func(word, stem string) {
+		t.Helper()
+		require.Equal(t, stem, s.Stem(word))
+	}
+
+	f("abandonada", "abandon")
+	f("abandonado", "abandon")
+	f("abandonar", "abandon")
+	f("abarcaba", "abarc")
+	f("abogados", "abog")
+	f("abrazó", "abraz")
+	f("abriendo", "abriend")
+	f("abrió", "abri")
+	f("absoluta", "absolut")
+	f("absolutamente", "absolut")
+	f("acabar", "acab")
+	f("acciones", "accion")
+	f("aceptación", "acept")
+	f("acercándose", "acerc")
+	f("aclaración", "aclar")
+	f("actividades", "activ")
+	f("actualmente", "actual")
+	f("acuerdo", "acuerd")
+	f("adelante", "adel")
+	f("administración", "administr")
+	f("admirable", "admir")
+	f("advirtió", "advirt")
+	f("afirmaciones", "afirm")
+	f("agradecimiento", "agradec")
+	f("agua", "agu")
+	f("alcanzar", "alcanz")
+	f("alegría", "alegr")
+	f("almacenes", "almacen")
+	f("amable", "amabl")
+	f("amablemente", "amabl")
+	f("amigos", "amig")
+	f("amistad", "amist")
+	f("análisis", "analisis")
+	f("andaluces", "andaluc")
+	f("antigüedad", "antigüed")
+	f("anunciaron", "anunci")
+	f("apareciendo", "aparec")
+	f("aparición", "aparicion")
+	f("aplicación", "aplic")
+	f("aprendiendo", "aprend")
+	f("argumentos", "argument")
+	f("arreglarlo", "arregl")
+	f("asociaciones", "asoci")
+	f("atención", "atencion")
+	f("aumentaron", "aument")
+	f("autoridades", "autor")
+	f("avergonzado", "avergonz")
+	f("bailando", "bail")
+	f("bancarias", "bancari")
+	f("bebiendo", "beb")
+	f("bellísima", "bellisim")
+	f("biblioteca", "bibliotec")
+	f("buscándolas", "busc")
+	f("caballos", "caball")
+	f("cálidamente", "calid")
+	f("cambiaría", "cambi")
+	f("caminando", "camin")
+	f("campesinos", "campesin")
+	f("canciones", "cancion")
+	f("cantaban", "cant")
+	f("capacidad", "capac")
+	f("características", "caracterist")
+	f("carreteras", "carreter")
+	f("catástrofe", "catastrof")
+	f("celebración", "celebr")
+	f("ciudades", "ciudad")
+	f("claramente", "clar")
+	f("cocinaban", "cocin")
+	f("comenzaron", "comenz")
+	f("comerciales", "comercial")
+	f("comiendo", "com")
+	f("compañía", "compañ")
+	f("comprarla", "compr")
+	f("comunicación", "comun")
+	f("conocimiento", "conoc")
+	f("consecuencias", "consecuent")
+	f("construcción", "construccion")
+	f("contaminación", "contamin")
+	f("continuamente", "continu")
+	f("corriendo", "corr")
+	f("creatividad", "creativ")
+	f("cualidades", "cualidad")
+	f("cuidadosamente", "cuidad")
+	f("dándole", "dandol")
+	f("decidieron", "decid")
+	f("decírselo", "dec")
+	f("defendiendo", "defend")
+	f("democracia", "democraci")
+	f("derechos", "derech")
+	f("desarrollo", "desarroll")
+	f("descubrimiento", "descubr")
+	f("desesperación", "desesper")
+	f("destrucción", "destruccion")
+	f("diciéndoles", "dic")
+	f("dificultades", "dificultad")
+	f("dirigiéndose", "dirig")
+	f("discusiones", "discusion")
+	f("divertidos", "divert")
+	f("doloroso", "dolor")
+	f("económicamente", "econom")
+	f("educación", "educ")
+	f("efectivamente", "efect")
+	f("elegancia", "eleg")
+	f("emocionante", "emocion")
+	f("empresarios", "empresari")
+	f("encontraron", "encontr")
+	f("enfermedades", "enfermedad")
+	f("enseñanza", "enseñ")
+	f("entendimiento", "entend")
+	f("entregarles", "entreg")
+	f("escribiendo", "escrib")
+	f("escuchándolo", "escuch")
+	f("esperanzas", "esper")
+	f("estabilidad", "estabil")
+	f("estudiantes", "estudi")
+	f("evidentemente", "evident")
+	f("explicaciones", "explic")
+	f("facilidad", "facil")
+	f("fácilmente", "facil")
+	f("felicidad", "felic")
+	f("ferrocarriles", "ferrocarril")
+	f("finalmente", "final")
+	f("formación", "formacion")
+	f("fortaleza", "fortalez")
+	f("fotografías", "fotograf")
+	f("fundamentales", "fundamental")
+	f("ganaderos", "ganader")
+	f("generosidad", "gener")
+	f("gobiernos", "gobi")
+	f("grandeza", "grandez")
+	f("guerras", "guerr")
+	f("habilidades", "habil")
+	f("habitaciones", "habit")
+	f("hablándonos", "habl")
+	f("históricamente", "histor")
+	f("hospitales", "hospital")
+	f("identidad", "ident")
+	f("iglesias", "iglesi")
+	f("igualdad", "iguald")
+	f("imaginación", "imagin")
+	f("importancia", "import")
+	f("incapacidad", "incapac")
+	f("independencia", "independent")
+	f("industriales", "industrial")
+	f("inteligencia", "inteligent")
+	f("investigaciones", "investig")
+	f("jardines", "jardin")
+	f("jugando", "jug")
+	f("juventud", "juventud")
+	f("lamentablemente", "lament")
+	f("lecturas", "lectur")
+	f("legislación", "legisl")
+	f("lentamente", "lent")
+	f("libertad", "libert")
+	f("limpiando", "limpi")
+	f("llamándola", "llam")
+	f("llegaron", "lleg")
+	f("lógicamente", "logic")
+	f("luchadores", "luchador")
+	f("maravillosas", "maravill")
+	f("matemáticas", "matemat")
+	f("médicos", "medic")
+	f("mejoramiento", "mejor")
+	f("memorias", "memori")
+	f("mercados", "merc")
+	f("ministerios", "ministeri")
+	f("modernización", "moderniz")
+	f("movimientos", "movimient")
+	f("municipalidad", "municipal")
+	f("nacionales", "nacional")
+	f("naturalmente", "natural")
+	f("necesidades", "neces")
+	f("negociaciones", "negoci")
+	f("nerviosamente", "nervi")
+	f("nuevamente", "nuev")
+	f("obligaciones", "oblig")
+	f("ocupaciones", "ocup")
+	f("oficinas", "oficin")
+	f("oportunidades", "oportun")
+	f("organizaciones", "organiz")
+	f("orgullosos", "orgull")
+	f("paciencia", "pacienci")
+	f("países", "pais")
+	f("palabras", "palabr")
+	f("participación", "particip")
+	f("pensamientos", "pensamient")
+	f("perfectamente", "perfect")
+	f("periodistas", "period")
+	f("personalidad", "personal")
+	f("pescadores", "pescador")
+	f("pintores", "pintor")
+	f("pobreza", "pobrez")
+	f("poderosos", "poder")
+	f("posibilidades", "posibil")
+	f("preguntándose", "pregunt")
+	f("presidencia", "president")
+	f("probablemente", "probabl")
+	f("producción", "produccion")
+	f("profesores", "profesor")
+	f("programas", "program")
+	f("propiedades", "propiedad")
+	f("protección", "proteccion")
+	f("provincias", "provinci")
+	f("publicaciones", "public")
+	f("rápidamente", "rapid")
+	f("realidad", "realid")
+	f("recomendaciones", "recomend")
+	f("recuerdos", "recuerd")
+	f("relaciones", "relacion")
+	f("religiosos", "religi")
+	f("representantes", "represent")
+	f("responsabilidad", "respons")
+	f("revoluciones", "revolu")
+	f("riqueza", "riquez")
+	f("sabiduría", "sabidur")
+	f("seguridad", "segur")
+	f("sencillamente", "sencill")
+	f("sentimientos", "sentimient")
+	f("seriamente", "seri")
+	f("servicios", "servici")
+	f("sinceridad", "sincer")
+	f("sociedades", "sociedad")
+	f("soledad", "soled")
+	f("soluciones", "solucion")
+	f("sorprendentemente", "sorprendent")
+	f("tecnología", "tecnolog")
+	f("televisión", "television")
+	f("tradiciones", "tradicion")
+	f("tranquilidad", "tranquil")
+	f("transformación", "transform")
+	f("universidades", "univers")
+	f("utilización", "utiliz")
+	f("valientes", "valient")
+	f("velocidad", "veloc")
+	f("verdaderamente", "verdader")
+	f("viajando", "viaj")
+	f("vidas", "vid")
+	f("voluntad", "volunt")
+	f("zapatos", "zapat")
+	f("arqueología", "arqueolog")
+	f("biología", "biolog")
+	f("cantaría", "cant")
+	f("comeríamos", "com")
+	f("viviríais", "viv")
+	f("hablaremos", "habl")
+	f("leeríais", "leer")
+	f("partieron", "part")
+	f("comprasteis", "compr")
+	f("dijeseis", "dijeseis")
+	f("huyendo", "huyend")
+	f("construyendo", "constru")
+	f("oyendo", "oyend")
+	f("leyendo", "leyend")
+	f("cayeron", "cayeron")
+	f("huyeron", "huyeron")
+	f("destruyó", "destru")
+	f("contribuyen", "contribu")
+	f("sigue", "sig")
+	f("siguen", "sig")
+	f("distinguen", "disting")
+	f("llegue", "lleg")
+	f("lleguemos", "lleg")
+	f("averigüé", "averigü")
+	f("averiguar", "averigu")
+	f("averigües", "averigü")
+	f("pagué", "pag")
+	f("pagues", "pag")
+	f("dándoselo", "dandosel")
+	f("comiéndoselas", "com")
+	f("poniéndose", "pon")
+	f("ponerlos", "pon")
+	f("mírame", "miram")
+	f("decirte", "decirt")
+	f("irse", "irse")
+	f("ayudarnos", "ayud")
+	f("sentarse", "sent")
+	f("ofreciéndolas", "ofrec")
+	f("activamente", "activ")
+	f("creativo", "creativ")
+	f("creativas", "creativ")
+	f("administrativo", "administr")
+	f("conservadora", "conserv")
+	f("conservadores", "conserv")
+	f("agotamiento", "agot")
+	f("lamentaciones", "lament")
+	f("aproximadamente", "aproxim")
+	f("inteligentes", "inteligent")
+	f("transparencia", "transparent")
+	f("excelencia", "excelent")
+	f("complejidad", "complej")
+	f("posibilidad", "posibil")
+	f("viabilidad", "viabil")
+	f("comunicativas", "comunic")
+	f("informativos", "inform")
+}
+
+func TestSpanishStemmer_regions(t *testing.T) {
+	s := NewSpanishStemmer()
+
+	f := func(word, rv, r1, r2 string) {
+		t.Helper()
+		rvStart, r1Start, r2Start := s.regions(word)
+		require.Equal(t, rv, word[rvStart:])
+		require.Equal(t, r1, word[r1Start:])
+		require.Equal(t, r2, word[r2Start:])
+	}
+
+	f("macho", "ho", "ho", "")
+	f("oliva", "va", "iva", "a")
+	f("trabajo", "bajo", "ajo", "o")
+	f("áureo", "eo", "eo", "")
+	f("animadversión", "madversión", "imadversión", "adversión")
+	f("chapaleta", "paleta", "aleta", "eta")
+	f("ao", "", "", "")
+}
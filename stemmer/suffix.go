@@ -0,0 +1,123 @@
+package stemmer
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// longestSuffix returns the first suffix from suffixes that word ends with.
+// Suffix tables are ordered from the longest entry to the shortest, so the
+// first match is also the longest one. An empty string means no match.
+func longestSuffix(word string, suffixes []string) string {
+	for _, suffix := range suffixes {
+		if strings.HasSuffix(word, suffix) {
+			return suffix
+		}
+	}
+	return ""
+}
+
+// inRegion reports whether suffix, which word ends with, starts at or after
+// the byte offset start.
+func inRegion(word, suffix string, start int) bool {
+	return len(word)-len(suffix) >= start
+}
+
+// region returns the part of word starting at the byte offset start, or an
+// empty string if word has become shorter than start.
+func region(word string, start int) string {
+	if start >= len(word) {
+		return ""
+	}
+	return word[start:]
+}
+
+// lastRune returns the last rune of word, or utf8.RuneError for an empty word.
+func lastRune(word string) rune {
+	r, _ := utf8.DecodeLastRuneInString(word)
+	return r
+}
+
+// standardRegions returns the byte offsets of R1 and R2. R1 is the region
+// after the first non-vowel following a vowel, R2 is the same region found
+// inside R1. A region that does not exist starts at len(word).
+func standardRegions(word string, isVowel func(rune) bool) (int, int) {
+	r1 := regionAfterVowelConsonant(word, 0, isVowel)
+	r2 := regionAfterVowelConsonant(word, r1, isVowel)
+	return r1, r2
+}
+
+// regionAfterVowelConsonant scans word from the byte offset start and returns
+// the offset just past the first non-vowel that follows a vowel.
+func regionAfterVowelConsonant(word string, start int, isVowel func(rune) bool) int {
+	prevVowel := false
+	for i, r := range word[start:] {
+		vowel := isVowel(r)
+		if prevVowel && !vowel {
+			_, size := utf8.DecodeRuneInString(word[start+i:])
+			return start + i + size
+		}
+		prevVowel = vowel
+	}
+	return len(word)
+}
+
+// romanceRV returns the byte offset of RV as the Spanish, Italian and
+// Portuguese algorithms define it. If the second letter is a consonant, RV
+// starts after the next vowel; if the first two letters are vowels, after
+// the next consonant; otherwise after the third letter.
+func romanceRV(word string, isVowel func(rune) bool) int {
+	runes := []rune(word)
+	if len(runes) < 2 {
+		return len(word)
+	}
+
+	pos := len(runes) + 1
+	switch {
+	case !isVowel(runes[1]):
+		pos = indexFunc(runes, 2, isVowel) + 1
+	case isVowel(runes[0]):
+		pos = indexFunc(runes, 2, func(r rune) bool { return !isVowel(r) }) + 1
+	case len(runes) >= 3:
+		pos = 3
+	}
+
+	if pos <= 0 || pos > len(runes) {
+		return len(word)
+	}
+	return len(string(runes[:pos]))
+}
+
+// indexFunc returns the index of the first rune at or after from that
+// satisfies f, or -1 if there is none.
+func indexFunc(runes []rune, from int, f func(rune) bool) int {
+	for i := from; i < len(runes); i++ {
+		if f(runes[i]) {
+			return i
+		}
+	}
+	return -1
+}
+
+// atLeastLetters returns start, moved forward if needed so that at least n
+// letters of word precede it.
+func atLeastLetters(word string, start, n int) int {
+	offset := 0
+	for i := 0; i < n && offset < len(word); i++ {
+		_, size := utf8.DecodeRuneInString(word[offset:])
+		offset += size
+	}
+	return max(start, offset)
+}
+
+// longestPrefix returns the first prefix from prefixes that word starts with.
+// Like suffix tables, prefix tables are ordered from the longest entry to the
+// shortest. An empty string means no match.
+func longestPrefix(word string, prefixes []string) string {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(word, prefix) {
+			return prefix
+		}
+	}
+	return ""
+}
@@ -0,0 +1,210 @@
+package stemmer
+
+import "strings"
+
+var (
+	svStopWords = map[string]struct{}{
+		"och":    {},
+		"det":    {},
+		"att":    {},
+		"i":      {},
+		"en":     {},
+		"jag":    {},
+		"hon":    {},
+		"som":    {},
+		"han":    {},
+		"på":     {},
+		"den":    {},
+		"med":    {},
+		"var":    {},
+		"sig":    {},
+		"för":    {},
+		"så":     {},
+		"till":   {},
+		"är":     {},
+		"men":    {},
+		"ett":    {},
+		"om":     {},
+		"hade":   {},
+		"de":     {},
+		"av":     {},
+		"icke":   {},
+		"mig":    {},
+		"du":     {},
+		"henne":  {},
+		"då":     {},
+		"sin":    {},
+		"nu":     {},
+		"har":    {},
+		"inte":   {},
+		"hans":   {},
+		"honom":  {},
+		"skulle": {},
+		"hennes": {},
+		"där":    {},
+		"min":    {},
+		"man":    {},
+		"ej":     {},
+		"vid":    {},
+		"kunde":  {},
+		"något":  {},
+		"från":   {},
+		"ut":     {},
+		"när":    {},
+		"efter":  {},
+		"upp":    {},
+		"vi":     {},
+		"dem":    {},
+		"vara":   {},
+		"vad":    {},
+		"över":   {},
+		"än":     {},
+		"dig":    {},
+		"kan":    {},
+		"sina":   {},
+		"här":    {},
+		"ha":     {},
+		"mot":    {},
+		"alla":   {},
+		"under":  {},
+		"någon":  {},
+		"eller":  {},
+		"allt":   {},
+		"mycket": {},
+		"sedan":  {},
+		"ju":     {},
+		"denna":  {},
+		"själv":  {},
+		"detta":  {},
+		"åt":     {},
+		"utan":   {},
+		"varit":  {},
+		"hur":    {},
+		"ingen":  {},
+		"mitt":   {},
+		"ni":     {},
+		"bli":    {},
+		"blev":   {},
+		"oss":    {},
+		"din":    {},
+		"dessa":  {},
+		"några":  {},
+		"deras":  {},
+		"blir":   {},
+		"mina":   {},
+		"samma":  {},
+		"vilken": {},
+		"er":     {},
+		"sådan":  {},
+		"vår":    {},
+		"blivit": {},
+		"dess":   {},
+		"inom":   {},
+		"mellan": {},
+		"sådant": {},
+		"varför": {},
+		"varje":  {},
+		"vilka":  {},
+		"ditt":   {},
+		"vem":    {},
+		"vilket": {},
+		"sitta":  {},
+		"sådana": {},
+		"vart":   {},
+		"dina":   {},
+		"vars":   {},
+		"vårt":   {},
+		"våra":   {},
+		"ert":    {},
+		"era":    {},
+		"vilkas": {},
+	}
+
+	svMainSuffixes = []string{
+		"heterna", "hetens", "anden", "andes", "andet", "arens", "arnas", "ernas",
+		"heten", "heter", "ornas", "ades", "ande", "aren", "arna", "arne", "aste",
+		"erna", "erns", "orna", "ade", "are", "ast", "ens", "ern", "het", "ad", "ar",
+		"as", "at", "en", "er", "es", "or", "a", "e", "s",
+	}
+
+	svConsonantPairs = []string{"dd", "gd", "nn", "dt", "gt", "kt", "tt"}
+	svOtherSuffixes  = []string{"fullt", "löst", "els", "lig", "ig"}
+	svValidSEndings  = "bcdfghjklmnoprtvy"
+)
+
+type SwedishStemmer struct{}
+
+// NewSwedishStemmer creates a new SwedishStemmer.
+func NewSwedishStemmer() *SwedishStemmer {
+	return &SwedishStemmer{}
+}
+
+// Stem returns the stem of the given word.
+func (s SwedishStemmer) Stem(word string) string {
+	word = strings.ToLower(word)
+	if s.isStopWord(word) {
+		return word
+	}
+
+	r1 := s.regions(word)
+
+	word = s.mainSuffix(word, r1)
+	word = s.consonantPair(word, r1)
+	word = s.otherSuffix(word, r1)
+
+	return word
+}
+
+func (s SwedishStemmer) mainSuffix(word string, r1 int) string {
+	suffix := longestSuffix(region(word, r1), svMainSuffixes)
+	if suffix == "" {
+		return word
+	}
+	stem := word[:len(word)-len(suffix)]
+
+	if suffix == "s" && !strings.ContainsRune(svValidSEndings, lastRune(stem)) {
+		return word
+	}
+	return stem
+}
+
+// consonantPair removes the last letter of a final consonant pair in R1.
+func (s SwedishStemmer) consonantPair(word string, r1 int) string {
+	if longestSuffix(region(word, r1), svConsonantPairs) == "" {
+		return word
+	}
+	return word[:len(word)-1]
+}
+
+func (s SwedishStemmer) otherSuffix(word string, r1 int) string {
+	suffix := longestSuffix(region(word, r1), svOtherSuffixes)
+	switch suffix {
+	case "":
+		return word
+	case "fullt", "löst":
+		return strings.TrimSuffix(word, "t")
+	}
+	return word[:len(word)-len(suffix)]
+}
+
+// isStopWord returns true if the given word is a stop word.
+func (s SwedishStemmer) isStopWord(word string) bool {
+	_, found := svStopWords[word]
+	return found
+}
+
+func (s SwedishStemmer) isVowel(r rune) bool {
+	switch r {
+	case 'a', 'e', 'i', 'o', 'u', 'y', 'ä', 'å', 'ö':
+		return true
+	default:
+		return false
+	}
+}
+
+// regions returns the byte offset of R1, adjusted so that at least 3
+// letters precede it.
+func (s SwedishStemmer) regions(word string) int {
+	r1, _ := standardRegions(word, s.isVowel)
+	return atLeastLetters(word, r1, 3)
+}
@@ -0,0 +1,234 @@
+package stemmer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSwedishStemmer(t *testing.T) {
+	s := NewSwedishStemmer()
+	require.NotNil(t, s)
+}
+
+func TestSwedishStemmer_isStopWord(t *testing.T) {
+	s := NewSwedishStemmer()
+	require.True(t, s.isStopWord("och"))
+	require.False(t, s.isStopWord("huset"))
+}
+
+func TestSwedishStemmer_Stem(t *testing.T) {
+	s := NewSwedishStemmer()
+	t.Run("stop word", func(t *testing.T) {
+		require.Equal(t, "och", s.Stem("och"))
+		require.Equal(t, "och", s.Stem("Och"))
+	})
+
+	f := func(word, stem string) {
+		t.Helper()
+		require.Equal(t, stem, s.Stem(word))
+	}
+
+	f("jaktkarlarne", "jaktkarl")
+	f("klokheten", "klok")
+	f("klokhetens", "klok")
+	f("flickorna", "flick")
+	f("flickornas", "flick")
+	f("flickan", "flickan")
+	f("flickans", "flickan")
+	f("flickor", "flick")
+	f("pojkarna", "pojk")
+	f("pojkar", "pojk")
+	f("pojken", "pojk")
+	f("pojkens", "pojk")
+	f("bilarna", "bil")
+	f("bilar", "bil")
+	f("bilen", "bil")
+	f("huset", "huset")
+	f("husets", "huset")
+	f("husen", "hus")
+	f("barnen", "barn")
+	f("barnens", "barn")
+	f("äpplen", "äppl")
+	f("äpplet", "äpplet")
+	f("gatorna", "gat")
+	f("gatan", "gatan")
+	f("städerna", "städ")
+	f("staden", "stad")
+	f("landet", "landet")
+	f("landets", "landet")
+	f("länderna", "länd")
+	f("kärlek", "kärlek")
+	f("kärleken", "kärlek")
+	f("kärleksfull", "kärleksfull")
+	f("kärleksfullt", "kärleksfull")
+	f("vänlig", "vän")
+	f("vänligt", "vän")
+	f("vänliga", "vän")
+	f("vänligheten", "vän")
+	f("möjlig", "möj")
+	f("möjliga", "möj")
+	f("möjligheten", "möj")
+	f("möjligheterna", "möj")
+	f("fullt", "fullt")
+	f("hopplöst", "hopplös")
+	f("hopplös", "hopplös")
+	f("tröstlöst", "tröstlös")
+	f("arbete", "arbet")
+	f("arbetet", "arbetet")
+	f("arbetare", "arbet")
+	f("arbetarna", "arbet")
+	f("arbetade", "arbet")
+	f("arbetande", "arbet")
+	f("arbetar", "arbet")
+	f("springa", "spring")
+	f("springande", "spring")
+	f("sprungit", "sprungit")
+	f("sprang", "sprang")
+	f("springer", "spring")
+	f("läsa", "läs")
+	f("läser", "läs")
+	f("läste", "läst")
+	f("läsande", "läs")
+	f("läsarna", "läs")
+	f("skriva", "skriv")
+	f("skriver", "skriv")
+	f("skrev", "skrev")
+	f("skrivande", "skriv")
+	f("skrivit", "skrivit")
+	f("skrivna", "skrivn")
+	f("kunna", "kunn")
+	f("kunnat", "kunn")
+	f("kanske", "kansk")
+	f("sagt", "sagt")
+	f("sade", "sad")
+	f("säger", "säg")
+	f("haft", "haft")
+	f("hemligt", "hem")
+	f("hemlighet", "hem")
+	f("hemligheten", "hem")
+	f("hemligheterna", "hem")
+	f("rädd", "rädd")
+	f("rädda", "rädd")
+	f("räddad", "rädd")
+	f("räddade", "rädd")
+	f("glädje", "glädj")
+	f("glädjen", "glädj")
+	f("fröjd", "fröjd")
+	f("fröjdefull", "fröjdefull")
+	f("stolt", "stolt")
+	f("stolta", "stolt")
+	f("stoltheten", "stolt")
+	f("tillsammans", "tillsamman")
+	f("sällskap", "sällskap")
+	f("sällskapet", "sällskapet")
+	f("sällskapets", "sällskapet")
+	f("regeringen", "regering")
+	f("regeringar", "regering")
+	f("regeringens", "regering")
+	f("ansvar", "ansv")
+	f("ansvarig", "ansvar")
+	f("ansvariga", "ansvar")
+	f("ansvarigt", "ansvar")
+	f("universitetet", "universitetet")
+	f("universiteten", "universitet")
+	f("studenterna", "student")
+	f("student", "student")
+	f("studenters", "studenter")
+	f("lärare", "lär")
+	f("lärarna", "lär")
+	f("lärarens", "lär")
+	f("undervisning", "undervisning")
+	f("undervisningen", "undervisning")
+	f("utbildning", "utbildning")
+	f("utbildningar", "utbildning")
+	f("utbildningens", "utbildning")
+	f("ekonomi", "ekonomi")
+	f("ekonomisk", "ekonomisk")
+	f("ekonomiska", "ekonomisk")
+	f("ekonomiskt", "ekonomisk")
+	f("politik", "politik")
+	f("politisk", "politisk")
+	f("politiska", "politisk")
+	f("politiskt", "politisk")
+	f("samhälle", "samhäll")
+	f("samhället", "samhället")
+	f("samhällets", "samhället")
+	f("samhällena", "samhällen")
+	f("människa", "människ")
+	f("människan", "människan")
+	f("människor", "människ")
+	f("människorna", "människ")
+	f("världen", "värld")
+	f("världens", "värld")
+	f("världar", "värld")
+	f("historia", "histori")
+	f("historien", "histori")
+	f("historiska", "historisk")
+	f("kvinnor", "kvinn")
+	f("kvinnan", "kvinnan")
+	f("kvinnornas", "kvinn")
+	f("männen", "männ")
+	f("mannen", "mann")
+	f("mannens", "mann")
+	f("vägen", "väg")
+	f("vägar", "väg")
+	f("vägarna", "väg")
+	f("fönster", "fönst")
+	f("fönstret", "fönstret")
+	f("fönstren", "fönstr")
+	f("trädgård", "trädgård")
+	f("trädgården", "trädgård")
+	f("trädgårdar", "trädgård")
+	f("byggnad", "byggn")
+	f("byggnaden", "byggnad")
+	f("byggnader", "byggnad")
+	f("byggnaderna", "byggnad")
+	f("bygga", "bygg")
+	f("byggde", "bygg")
+	f("byggt", "bygg")
+	f("hund", "hund")
+	f("hunden", "hund")
+	f("hundar", "hund")
+	f("hundarna", "hund")
+	f("katt", "katt")
+	f("katten", "katt")
+	f("katter", "katt")
+	f("katterna", "katt")
+	f("stad", "stad")
+	f("stadens", "stad")
+	f("stort", "stort")
+	f("större", "störr")
+	f("störst", "störst")
+	f("största", "störst")
+	f("liten", "lit")
+	f("litet", "litet")
+	f("lilla", "lill")
+	f("små", "små")
+	f("mindre", "mindr")
+	f("minst", "minst")
+	f("nytt", "nytt")
+	f("nya", "nya")
+	f("nyare", "nyar")
+	f("nyaste", "nyast")
+	f("gammal", "gammal")
+	f("gammalt", "gammalt")
+	f("gamla", "gaml")
+	f("äldre", "äldr")
+	f("äldst", "äldst")
+}
+
+func TestSwedishStemmer_regions(t *testing.T) {
+	s := NewSwedishStemmer()
+
+	f := func(word, r1 string) {
+		t.Helper()
+		require.Equal(t, r1, word[s.regions(word):])
+	}
+
+	f("klokheten", "heten")
+	f("flickorna", "korna")
+	f("arbetare", "etare")
+	f("bilar", "ar")
+	f("åt", "")
+}
@@ -0,0 +1,483 @@
+package stemmer
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// trSuffix is a class of Turkish suffixes: its forms, longest first, whether
+// it must agree with the stem in vowel harmony, and the letters that may join
+// it to the stem. A joining consonant (n, s or y) is only there after a
+// vowel, a joining vowel (ı, i, u or ü) only after a consonant.
+type trSuffix struct {
+	forms   []string
+	harmony bool
+	join    string
+}
+
+var (
+	trStopWords = map[string]struct{}{
+		"acaba":   {},
+		"ama":     {},
+		"aslında": {},
+		"az":      {},
+		"bazı":    {},
+		"belki":   {},
+		"biri":    {},
+		"birkaç":  {},
+		"birşey":  {},
+		"biz":     {},
+		"bu":      {},
+		"çok":     {},
+		"çünkü":   {},
+		"da":      {},
+		"daha":    {},
+		"de":      {},
+		"defa":    {},
+		"diye":    {},
+		"eğer":    {},
+		"en":      {},
+		"gibi":    {},
+		"hem":     {},
+		"hep":     {},
+		"hepsi":   {},
+		"her":     {},
+		"hiç":     {},
+		"için":    {},
+		"ile":     {},
+		"ise":     {},
+		"kez":     {},
+		"ki":      {},
+		"kim":     {},
+		"mı":      {},
+		"mu":      {},
+		"mü":      {},
+		"nasıl":   {},
+		"ne":      {},
+		"neden":   {},
+		"nerde":   {},
+		"nerede":  {},
+		"nereye":  {},
+		"niçin":   {},
+		"niye":    {},
+		"o":       {},
+		"sanki":   {},
+		"şey":     {},
+		"siz":     {},
+		"şu":      {},
+		"tüm":     {},
+		"ve":      {},
+		"veya":    {},
+		"ya":      {},
+		"yani":    {},
+	}
+
+	// Noun suffixes.
+	trPossessives = trSuffix{forms: []string{"miz", "niz", "muz", "nuz", "mız", "nız", "müz", "nüz", "m", "n"}, join: "ıiuü"}
+	trSU          = trSuffix{forms: []string{"ı", "i", "u", "ü"}, harmony: true, join: "s"}
+	trLArI        = trSuffix{forms: []string{"leri", "ları"}}
+	trYU          = trSuffix{forms: []string{"ı", "i", "u", "ü"}, harmony: true, join: "y"}
+	trNU          = trSuffix{forms: []string{"nı", "ni", "nu", "nü"}, harmony: true}
+	trNUn         = trSuffix{forms: []string{"ın", "in", "un", "ün"}, harmony: true, join: "n"}
+	trYA          = trSuffix{forms: []string{"a", "e"}, harmony: true, join: "y"}
+	trNA          = trSuffix{forms: []string{"na", "ne"}, harmony: true}
+	trDA          = trSuffix{forms: []string{"da", "de", "ta", "te"}, harmony: true}
+	trNdA         = trSuffix{forms: []string{"nda", "nde"}, harmony: true}
+	trDAn         = trSuffix{forms: []string{"dan", "den", "tan", "ten"}, harmony: true}
+	trNdAn        = trSuffix{forms: []string{"ndan", "nden"}, harmony: true}
+	trYlA         = trSuffix{forms: []string{"la", "le"}, harmony: true, join: "y"}
+	trKi          = trSuffix{forms: []string{"ki"}}
+	trNcA         = trSuffix{forms: []string{"ca", "ce"}, harmony: true, join: "n"}
+
+	// Verb and nominal predicate suffixes.
+	trYUm    = trSuffix{forms: []string{"ım", "im", "um", "üm"}, harmony: true, join: "y"}
+	trSUn    = trSuffix{forms: []string{"sın", "sin", "sun", "sün"}, harmony: true}
+	trYUz    = trSuffix{forms: []string{"ız", "iz", "uz", "üz"}, harmony: true, join: "y"}
+	trSUnUz  = trSuffix{forms: []string{"sınız", "siniz", "sunuz", "sünüz"}}
+	trLAr    = trSuffix{forms: []string{"lar", "ler"}, harmony: true}
+	trNUz    = trSuffix{forms: []string{"nız", "niz", "nuz", "nüz"}, harmony: true}
+	trDUr    = trSuffix{forms: []string{"dır", "dir", "dur", "dür", "tır", "tir", "tur", "tür"}, harmony: true}
+	trCAsInA = trSuffix{forms: []string{"casına", "cesine"}}
+	trYDU    = trSuffix{forms: []string{
+		"dık", "dik", "duk", "dük", "tık", "tik", "tuk", "tük",
+		"dım", "dim", "dum", "düm", "tım", "tim", "tum", "tüm",
+		"dın", "din", "dun", "dün", "tın", "tin", "tun", "tün",
+		"dı", "di", "du", "dü", "tı", "ti", "tu", "tü",
+	}, harmony: true, join: "y"}
+	trYsA  = trSuffix{forms: []string{"sak", "sek", "sam", "sem", "san", "sen", "sa", "se"}, join: "y"}
+	trYmUs = trSuffix{forms: []string{"mış", "miş", "muş", "müş"}, harmony: true, join: "y"}
+	trYken = trSuffix{forms: []string{"ken"}, join: "y"}
+
+	trLastConsonants = map[rune]string{'b': "p", 'c': "ç", 'd': "t", 'ğ': "k"}
+)
+
+// TurkishStemmer implements the Snowball Turkish stemmer. Unlike the other
+// stemmers it lower-cases words with the Turkish rules, so I becomes ı and
+// İ becomes i.
+type TurkishStemmer struct{}
+
+// NewTurkishStemmer creates a new TurkishStemmer.
+func NewTurkishStemmer() *TurkishStemmer {
+	return &TurkishStemmer{}
+}
+
+// Stem returns the stem of the given word.
+func (s TurkishStemmer) Stem(word string) string {
+	word = strings.ToLowerSpecial(unicode.TurkishCase, word)
+	if s.isStopWord(word) {
+		return word
+	}
+
+	if s.countVowels(word) < 2 {
+		return word
+	}
+
+	word, cont := s.nominalVerbSuffixes(word)
+	if !cont {
+		return word
+	}
+	word = s.nounSuffixes(word)
+
+	if word == "ad" || word == "soyad" {
+		return word
+	}
+	word = s.appendU(word)
+	return s.lastConsonant(word)
+}
+
+// nominalVerbSuffixes removes the personal and predicate suffixes of verbs
+// and nominal predicates. It reports whether noun suffixes should be
+// removed next.
+func (s TurkishStemmer) nominalVerbSuffixes(word string) (string, bool) {
+	end := len(word)
+
+	if p, ok := s.matchAny(word, end, trYmUs, trYDU, trYsA, trYken); ok {
+		return word[:p], true
+	}
+
+	if p, ok := s.match(word, end, trCAsInA); ok {
+		if q, ok := s.matchAny(word, p, trSUnUz, trLAr, trYUm, trSUn, trYUz); ok {
+			p = q
+		}
+		if q, ok := s.match(word, p, trYmUs); ok {
+			return word[:q], true
+		}
+	}
+
+	if p, ok := s.match(word, end, trLAr); ok {
+		word = word[:p]
+		if q, ok := s.matchAny(word, p, trDUr, trYDU, trYsA, trYmUs); ok {
+			word = word[:q]
+		}
+		return word, false
+	}
+
+	if p, ok := s.match(word, end, trNUz); ok {
+		if q, ok := s.matchAny(word, p, trYDU, trYsA); ok {
+			return word[:q], true
+		}
+	}
+
+	if p, ok := s.matchAny(word, end, trSUnUz, trYUz, trSUn, trYUm); ok {
+		word = word[:p]
+		if q, ok := s.match(word, p, trYmUs); ok {
+			word = word[:q]
+		}
+		return word, true
+	}
+
+	if p, ok := s.match(word, end, trDUr); ok {
+		word = word[:p]
+		q := p
+		if r, ok := s.matchAny(word, p, trSUnUz, trLAr, trYUm, trSUn, trYUz); ok {
+			q = r
+		}
+		if r, ok := s.match(word, q, trYmUs); ok {
+			word = word[:r]
+		}
+		return word, true
+	}
+
+	return word, true
+}
+
+// nounSuffixes removes the case, possessive and plural suffixes of nouns.
+func (s TurkishStemmer) nounSuffixes(word string) string {
+	end := len(word)
+
+	if p, ok := s.match(word, end, trLAr); ok {
+		word, _ = s.suffixChainBeforeKi(word[:p], p)
+		return word
+	}
+
+	if p, ok := s.match(word, end, trNcA); ok {
+		word = word[:p]
+		if q, ok := s.match(word, p, trLArI); ok {
+			return word[:q]
+		}
+		if q, ok := s.matchAny(word, p, trPossessives, trSU); ok {
+			return s.pluralBeforeKi(word[:q], q)
+		}
+		if q, ok := s.match(word, p, trLAr); ok {
+			word, _ = s.suffixChainBeforeKi(word[:q], q)
+		}
+		return word
+	}
+
+	if p, ok := s.matchAny(word, end, trNdA, trNA); ok {
+		if q, ok := s.match(word, p, trLArI); ok {
+			return word[:q]
+		}
+		if q, ok := s.match(word, p, trSU); ok {
+			return s.pluralBeforeKi(word[:q], q)
+		}
+		if w, ok := s.suffixChainBeforeKi(word, p); ok {
+			return w
+		}
+	}
+
+	if p, ok := s.matchAny(word, end, trNdAn, trNU); ok {
+		if q, ok := s.match(word, p, trSU); ok {
+			return s.pluralBeforeKi(word[:q], q)
+		}
+		if _, ok := s.match(word, p, trLArI); ok {
+			return word
+		}
+	}
+
+	if p, ok := s.match(word, end, trDAn); ok {
+		word = word[:p]
+		if q, ok := s.match(word, p, trPossessives); ok {
+			return s.pluralBeforeKi(word[:q], q)
+		}
+		if q, ok := s.match(word, p, trLAr); ok {
+			word, _ = s.suffixChainBeforeKi(word[:q], q)
+			return word
+		}
+		word, _ = s.suffixChainBeforeKi(word, p)
+		return word
+	}
+
+	if p, ok := s.matchAny(word, end, trNUn, trYlA); ok {
+		word = word[:p]
+		if q, ok := s.match(word, p, trLAr); ok {
+			// The plural stays removed even if no chain follows it.
+			word = word[:q]
+			if w, ok := s.suffixChainBeforeKi(word, q); ok {
+				return w
+			}
+			p = q
+		}
+		if q, ok := s.matchAny(word, p, trPossessives, trSU); ok {
+			return s.pluralBeforeKi(word[:q], q)
+		}
+		word, _ = s.suffixChainBeforeKi(word, p)
+		return word
+	}
+
+	if p, ok := s.match(word, end, trLArI); ok {
+		return word[:p]
+	}
+
+	if w, ok := s.suffixChainBeforeKi(word, end); ok {
+		return w
+	}
+
+	if p, ok := s.matchAny(word, end, trDA, trYU, trYA); ok {
+		word = word[:p]
+		if q, ok := s.match(word, p, trPossessives); ok {
+			word = word[:q]
+			if r, ok := s.match(word, q, trLAr); ok {
+				word, q = word[:r], r
+			}
+			word, _ = s.suffixChainBeforeKi(word, q)
+		} else if q, ok := s.match(word, p, trLAr); ok {
+			word, _ = s.suffixChainBeforeKi(word[:q], q)
+		}
+		return word
+	}
+
+	if p, ok := s.matchAny(word, end, trPossessives, trSU); ok {
+		return s.pluralBeforeKi(word[:p], p)
+	}
+
+	return word
+}
+
+// suffixChainBeforeKi removes the chain of suffixes that ends with the
+// relative suffix ki right before the byte offset pos. It reports whether
+// a chain was found.
+func (s TurkishStemmer) suffixChainBeforeKi(word string, pos int) (string, bool) {
+	p, ok := s.match(word, pos, trKi)
+	if !ok {
+		return word, false
+	}
+
+	if q, ok := s.match(word, p, trDA); ok {
+		word = word[:q] + word[pos:]
+		if r, ok := s.match(word, q, trLAr); ok {
+			word, _ = s.suffixChainBeforeKi(word[:r]+word[q:], r)
+		} else if r, ok := s.match(word, q, trPossessives); ok {
+			word = s.pluralBeforeKi(word[:r]+word[q:], r)
+		}
+		return word, true
+	}
+
+	if q, ok := s.match(word, p, trNUn); ok {
+		word = word[:q] + word[pos:]
+		if r, ok := s.match(word, q, trLArI); ok {
+			word = word[:r] + word[q:]
+		} else if r, ok := s.matchAny(word, q, trPossessives, trSU); ok {
+			word = s.pluralBeforeKi(word[:r]+word[q:], r)
+		} else {
+			word, _ = s.suffixChainBeforeKi(word, q)
+		}
+		return word, true
+	}
+
+	q, ok := s.match(word, p, trNdA)
+	if !ok {
+		return word, false
+	}
+	if r, ok := s.match(word, q, trLArI); ok {
+		return word[:r] + word[pos:], true
+	}
+	if r, ok := s.match(word, q, trSU); ok {
+		return s.pluralBeforeKi(word[:r]+word[pos:], r), true
+	}
+	return s.suffixChainBeforeKi(word, q)
+}
+
+// pluralBeforeKi removes a plural suffix right before the byte offset pos,
+// and then the chain of suffixes before it.
+func (s TurkishStemmer) pluralBeforeKi(word string, pos int) string {
+	if p, ok := s.match(word, pos, trLAr); ok {
+		word, _ = s.suffixChainBeforeKi(word[:p]+word[pos:], p)
+	}
+	return word
+}
+
+// match reports whether one of the forms of suffix ends right before the
+// byte offset pos, and returns the offset where the suffix, with its
+// joining letter if any, starts.
+func (s TurkishStemmer) match(word string, pos int, suffix trSuffix) (int, bool) {
+	if suffix.harmony && !s.hasVowelHarmony(word[:pos]) {
+		return pos, false
+	}
+	form := longestSuffix(word[:pos], suffix.forms)
+	if form == "" {
+		return pos, false
+	}
+	pos -= len(form)
+	if suffix.join == "" {
+		return pos, true
+	}
+
+	prev, size := utf8.DecodeLastRuneInString(word[:pos])
+	if size == 0 {
+		return pos, false
+	}
+	before, beforeSize := utf8.DecodeLastRuneInString(word[:pos-size])
+	joinsVowel := s.isVowel([]rune(suffix.join)[0])
+	ok := beforeSize > 0 && s.isVowel(before) != joinsVowel
+	if strings.ContainsRune(suffix.join, prev) {
+		return pos - size, ok
+	}
+	return pos, ok
+}
+
+// matchAny tries the suffixes in order and returns the first match.
+func (s TurkishStemmer) matchAny(word string, pos int, suffixes ...trSuffix) (int, bool) {
+	for _, suffix := range suffixes {
+		if p, ok := s.match(word, pos, suffix); ok {
+			return p, true
+		}
+	}
+	return pos, false
+}
+
+// hasVowelHarmony reports whether the last vowel of word is preceded by a
+// vowel it is in harmony with.
+func (s TurkishStemmer) hasVowelHarmony(word string) bool {
+	i := strings.LastIndexFunc(word, s.isVowel)
+	if i < 0 {
+		return false
+	}
+	last, _ := utf8.DecodeRuneInString(word[i:])
+
+	var group string
+	switch last {
+	case 'a':
+		group = "aıou"
+	case 'e':
+		group = "eiöü"
+	case 'ı':
+		group = "aı"
+	case 'i':
+		group = "ei"
+	case 'o', 'u':
+		group = "ou"
+	case 'ö', 'ü':
+		group = "öü"
+	}
+	return strings.ContainsAny(word[:i], group)
+}
+
+// appendU appends to a stem ending with d or g the high vowel that agrees
+// with its last vowel.
+func (s TurkishStemmer) appendU(word string) string {
+	if !strings.HasSuffix(word, "d") && !strings.HasSuffix(word, "g") {
+		return word
+	}
+	i := strings.LastIndexFunc(word, s.isVowel)
+	if i < 0 {
+		return word
+	}
+	last, _ := utf8.DecodeRuneInString(word[i:])
+
+	switch last {
+	case 'a', 'ı':
+		return word + "ı"
+	case 'e', 'i':
+		return word + "i"
+	case 'o', 'u':
+		return word + "u"
+	default:
+		return word + "ü"
+	}
+}
+
+// lastConsonant turns a final b, c, d or ğ into p, ç, t or k.
+func (s TurkishStemmer) lastConsonant(word string) string {
+	last, size := utf8.DecodeLastRuneInString(word)
+	if replacement, ok := trLastConsonants[last]; ok {
+		return word[:len(word)-size] + replacement
+	}
+	return word
+}
+
+func (s TurkishStemmer) countVowels(word string) int {
+	n := 0
+	for _, r := range word {
+		if s.isVowel(r) {
+			n++
+		}
+	}
+	return n
+}
+
+// isStopWord returns true if the given word is a stop word.
+func (s TurkishStemmer) isStopWord(word string) bool {
+	_, found := trStopWords[word]
+	return found
+}
+
+func (s TurkishStemmer) isVowel(r rune) bool {
+	switch r {
+	case 'a', 'e', 'ı', 'i', 'o', 'ö', 'u', 'ü':
+		return true
+	default:
+		return false
+	}
+}
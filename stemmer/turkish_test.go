@@ -0,0 +1,252 @@
+package stemmer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTurkishStemmer(t *testing.T) {
+	s := NewTurkishStemmer()
+	require.NotNil(t, s)
+}
+
+func TestTurkishStemmer_isStopWord(t *testing.T) {
+	s := NewTurkishStemmer()
+	require.True(t, s.isStopWord("için"))
+	require.False(t, s.isStopWord("kitap"))
+}
+
+func TestTurkishStemmer_Stem(t *testing.T) {
+	s := NewTurkishStemmer()
+	t.Run("stop word", func(t *testing.T) {
+		require.Equal(t, "için", s.Stem("için"))
+		require.Equal(t, "için", s.Stem("İÇİN"))
+	})
+
+	t.Run("turkish case", func(t *testing.T) {
+		require.Equal(t, "ışık", s.Stem("IŞIKLAR"))
+		require.Equal(t, "istanbul", s.Stem("İSTANBULDA"))
+		require.Equal(t, s.Stem("kapıları"), s.Stem("KAPILARI"))
+	})
+
+	f := func(word, stem string) {
+		t.Helper()
+		require.Equal(t, stem, s.Stem(word))
+	}
+
+	f("kitap", "kitap")
+	f("kitaplar", "kitap")
+	f("kitapları", "kitap")
+	f("kitaplarımız", "kitap")
+	f("kitabı", "kitap")
+	f("kitabın", "kitap")
+	f("kitapta", "kitap")
+	f("kitaptan", "kitap")
+	f("kitaplardan", "kitap")
+	f("kitaplarındaki", "kitap")
+	f("evler", "ev")
+	f("evlerimiz", "ev")
+	f("evdeki", "ev")
+	f("evden", "ev")
+	f("evlerinden", "ev")
+	f("evinizdeki", "ev")
+	f("arkadaş", "arkadaş")
+	f("arkadaşlar", "arkadaş")
+	f("arkadaşlarımızla", "arkadaş")
+	f("arkadaşımın", "arkadaş")
+	f("çocuk", "çocuk")
+	f("çocuklar", "çocuk")
+	f("çocuğun", "çocuk")
+	f("çocuğa", "çocuk")
+	f("çocukları", "çocuk")
+	f("çocuklarımızın", "çocuk")
+	f("kalem", "kale")
+	f("kalemler", "kalem")
+	f("kalemimi", "kalem")
+	f("kalemlerinizden", "kalem")
+	f("okul", "okul")
+	f("okula", "okul")
+	f("okulda", "okul")
+	f("okuldan", "okul")
+	f("okullarda", "okul")
+	f("okullardaki", "okul")
+	f("gelmiş", "gel")
+	f("gelmişti", "gelmiş")
+	f("gelmişsiniz", "gel")
+	f("gidiyorum", "gidiyor")
+	f("gidiyorlar", "gidiyor")
+	f("geldim", "gel")
+	f("geldiler", "gel")
+	f("geldik", "gel")
+	f("yapacaksınız", "yapacak")
+	f("yaptılar", "yap")
+	f("yapmışlar", "yap")
+	f("okudum", "okudu")
+	f("okuyorsunuz", "okuyor")
+	f("okumuşuz", "okumuş")
+	f("güzel", "güzel")
+	f("güzeldir", "güzel")
+	f("güzellik", "güzellik")
+	f("güzelleştirmek", "güzelleştirmek")
+	f("kitapçı", "kitapçı")
+	f("kitapçılar", "kitapçı")
+	f("adam", "ada")
+	f("adamlar", "adam")
+	f("adamın", "ada")
+	f("adamdan", "ada")
+	f("kadın", "kadı")
+	f("kadınlar", "kadın")
+	f("kadınlara", "kadın")
+	f("kadınların", "kadı")
+	f("şehir", "şehir")
+	f("şehirde", "şehir")
+	f("şehirdeki", "şehir")
+	f("şehirlerden", "şehir")
+	f("ağaç", "ağaç")
+	f("ağaçlar", "ağaç")
+	f("ağacın", "ağaç")
+	f("ağaçta", "ağaç")
+	f("köpek", "köpek")
+	f("köpekler", "köpek")
+	f("köpeği", "köpek")
+	f("köpeğin", "köpek")
+	f("kedi", "kedi")
+	f("kediler", "kedi")
+	f("kedinin", "kedi")
+	f("kedisi", "kedis")
+	f("öğretmen", "öğretme")
+	f("öğretmenler", "öğretmen")
+	f("öğretmenimiz", "öğretmen")
+	f("öğrenci", "öğrenci")
+	f("öğrenciler", "öğrenci")
+	f("öğrencilerin", "öğrenci")
+	f("öğrencilerimizden", "öğrenci")
+	f("masa", "mas")
+	f("masalar", "masa")
+	f("masada", "masa")
+	f("masadaki", "masa")
+	f("masanın", "masa")
+	f("yol", "yol")
+	f("yollar", "yol")
+	f("yolda", "yol")
+	f("yoldan", "yol")
+	f("yolculuk", "yolculuk")
+	f("ülke", "ülke")
+	f("ülkeler", "ülke")
+	f("ülkemiz", "ülke")
+	f("ülkelerde", "ülke")
+	f("ülkenin", "ülke")
+	f("dünya", "dünya")
+	f("dünyada", "dünya")
+	f("dünyanın", "dünya")
+	f("insan", "in")
+	f("insanlar", "in")
+	f("insanların", "insa")
+	f("insanlık", "insanlık")
+	f("hayat", "hayat")
+	f("hayatı", "hayat")
+	f("hayatımız", "hayat")
+	f("zaman", "zama")
+	f("zamanlar", "zaman")
+	f("zamanında", "zaman")
+	f("gün", "gün")
+	f("günler", "gün")
+	f("günlerde", "gün")
+	f("güneş", "güneş")
+	f("ışık", "ışık")
+	f("ışıklar", "ışık")
+	f("ılık", "ılık")
+	f("ıslak", "ıslak")
+	f("istanbul", "istanbul")
+	f("istanbulda", "istanbul")
+	f("ankara", "ankar")
+	f("ankaradan", "ankara")
+	f("türkiye", "türki")
+	f("türkiyenin", "türkiye")
+	f("türkçe", "türkçe")
+	f("soyad", "soyad")
+	f("soyadı", "soyad")
+	f("adı", "ad")
+	f("adlar", "ad")
+	f("bilgisayar", "bilgisayar")
+	f("bilgisayarlar", "bilgisayar")
+	f("bilgisayarımda", "bilgisayar")
+	f("telefon", "telefo")
+	f("telefonlar", "telefon")
+	f("telefonumu", "telefon")
+	f("araba", "arap")
+	f("arabalar", "araba")
+	f("arabamız", "araba")
+	f("arabasında", "araba")
+	f("geliyorsun", "geliyor")
+	f("gelecekler", "gelecek")
+	f("gelirse", "gelir")
+	f("gelince", "gel")
+	f("gelirken", "gelir")
+	f("okurken", "okur")
+	f("sevgi", "sevgi")
+	f("sevgili", "sevgil")
+	f("sevgilim", "sevgil")
+	f("sevgilerimle", "sevgi")
+	f("su", "su")
+	f("suyu", "su")
+	f("sular", "su")
+	f("ağabey", "ağabey")
+	f("kardeş", "kardeş")
+	f("kardeşler", "kardeş")
+	f("kardeşimin", "kardeş")
+	f("anne", "anne")
+	f("annem", "anne")
+	f("annesi", "annes")
+	f("anneler", "anne")
+	f("baba", "bap")
+	f("babam", "baba")
+	f("babası", "babas")
+	f("babalar", "baba")
+	f("dergi", "dergi")
+	f("dergiler", "dergi")
+	f("dergide", "dergi")
+	f("gazete", "gaze")
+	f("gazeteler", "gazete")
+	f("gazetedeki", "gazete")
+	f("mutluluk", "mutluluk")
+	f("mutluyum", "mutlu")
+	f("mutlusun", "mutlu")
+	f("mutludur", "mutlu")
+	f("hastane", "hastane")
+	f("hastaneler", "hastane")
+	f("hastanede", "hastane")
+	f("hastanedeki", "hastane")
+	f("doktor", "doktor")
+	f("doktorlar", "doktor")
+	f("doktorumuz", "doktor")
+	f("yemek", "yemek")
+	f("yemekler", "yemek")
+	f("yemeği", "yemek")
+	f("yemekte", "yemek")
+	f("çalışmak", "çalışmak")
+	f("çalışıyor", "çalışıyor")
+	f("çalıştık", "çalış")
+	f("çalışmışlar", "çalış")
+	f("yazılım", "yazıl")
+	f("yazılımlar", "yazılım")
+	f("kapı", "kap")
+	f("kapılar", "kapı")
+	f("kapının", "kap")
+	f("kapıda", "kapı")
+	f("pencere", "pencer")
+	f("pencereler", "pencere")
+	f("penceresinden", "pencere")
+}
+
+func TestTurkishStemmer_hasVowelHarmony(t *testing.T) {
+	s := NewTurkishStemmer()
+	require.True(t, s.hasVowelHarmony("kitapla"))
+	require.True(t, s.hasVowelHarmony("evle"))
+	require.True(t, s.hasVowelHarmony("okulu"))
+	require.False(t, s.hasVowelHarmony("evla"))
+	require.False(t, s.hasVowelHarmony("kale"))
+	require.False(t, s.hasVowelHarmony("ev"))
+	require.False(t, s.hasVowelHarmony("krt"))
+}
@@ -0,0 +1,429 @@
+package stemmer
+
+import (
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+var (
+	ukStopWords = map[string]struct{}{
+		"і":      {},
+		"й":      {},
+		"та":     {},
+		"а":      {},
+		"але":    {},
+		"або":    {},
+		"в":      {},
+		"у":      {},
+		"на":     {},
+		"з":      {},
+		"із":     {},
+		"зі":     {},
+		"зо":     {},
+		"до":     {},
+		"від":    {},
+		"для":    {},
+		"по":     {},
+		"при":    {},
+		"про":    {},
+		"що":     {},
+		"як":     {},
+		"це":     {},
+		"цей":    {},
+		"ця":     {},
+		"ці":     {},
+		"цього":  {},
+		"цьому":  {},
+		"цим":    {},
+		"цих":    {},
+		"той":    {},
+		"ті":     {},
+		"того":   {},
+		"тому":   {},
+		"тим":    {},
+		"тих":    {},
+		"він":    {},
+		"вона":   {},
+		"воно":   {},
+		"вони":   {},
+		"я":      {},
+		"ти":     {},
+		"ми":     {},
+		"ви":     {},
+		"мене":   {},
+		"тебе":   {},
+		"його":   {},
+		"її":     {},
+		"їх":     {},
+		"нас":    {},
+		"вас":    {},
+		"мені":   {},
+		"тобі":   {},
+		"йому":   {},
+		"їй":     {},
+		"їм":     {},
+		"нам":    {},
+		"вам":    {},
+		"ним":    {},
+		"нею":    {},
+		"ними":   {},
+		"нього":  {},
+		"неї":    {},
+		"них":    {},
+		"не":     {},
+		"ні":     {},
+		"так":    {},
+		"же":     {},
+		"ж":      {},
+		"би":     {},
+		"б":      {},
+		"бо":     {},
+		"чи":     {},
+		"щоб":    {},
+		"якщо":   {},
+		"коли":   {},
+		"де":     {},
+		"тут":    {},
+		"там":    {},
+		"вже":    {},
+		"ще":     {},
+		"лише":   {},
+		"тільки": {},
+		"також":  {},
+		"теж":    {},
+		"був":    {},
+		"була":   {},
+		"було":   {},
+		"були":   {},
+		"є":      {},
+		"бути":   {},
+		"буде":   {},
+		"будуть": {},
+		"без":    {},
+		"над":    {},
+		"під":    {},
+		"між":    {},
+		"через":  {},
+		"за":     {},
+		"перед":  {},
+		"після":  {},
+		"навіть": {},
+		"дуже":   {},
+		"свій":   {},
+		"своя":   {},
+		"своє":   {},
+		"свої":   {},
+		"свого":  {},
+		"своєї":  {},
+		"мій":    {},
+		"моя":    {},
+		"моє":    {},
+		"мої":    {},
+		"твій":   {},
+		"твоя":   {},
+		"твоє":   {},
+		"твої":   {},
+		"наш":    {},
+		"наша":   {},
+		"наше":   {},
+		"наші":   {},
+		"ваш":    {},
+		"ваша":   {},
+		"ваше":   {},
+		"ваші":   {},
+		"хто":    {},
+		"чому":   {},
+		"який":   {},
+		"яка":    {},
+		"яке":    {},
+		"які":    {},
+		"якого":  {},
+		"якої":   {},
+		"яким":   {},
+		"весь":   {},
+		"вся":    {},
+		"все":    {},
+		"всі":    {},
+		"усі":    {},
+		"сам":    {},
+		"сама":   {},
+		"само":   {},
+		"самі":   {},
+		"себе":   {},
+		"собі":   {},
+	}
+
+	ukPerfectiveSuffixes = []string{
+		"ившись",
+		"івшись",
+		"ївшись",
+		"ачись",
+		"вшись",
+		"учись",
+		"ючись",
+		"ячись",
+		"ивши",
+		"івши",
+		"ївши",
+		"ачи",
+		"вши",
+		"учи",
+		"ючи",
+		"ячи",
+		"в",
+	}
+
+	// ukPerfectiveSuffixesAfterA are the perfective gerund suffixes that
+	// must follow а or я. The slice is sorted for binary search.
+	ukPerfectiveSuffixesAfterA = []string{"в", "вши", "вшись"}
+
+	ukReflexiveSuffixes = []string{"ся", "сь"}
+
+	ukAdjectiveSuffixes = []string{
+		"ього",
+		"ьому",
+		"ими",
+		"ого",
+		"ому",
+		"ьою",
+		"ьої",
+		"іми",
+		"ий",
+		"им",
+		"их",
+		"ою",
+		"ої",
+		"ій",
+		"ім",
+		"іх",
+	}
+
+	ukParticipleSuffixes = []string{"уч", "юч", "н"}
+
+	// ukParticipleSuffixesAfterA are the participle suffixes that must
+	// follow а or я. The slice is sorted for binary search.
+	ukParticipleSuffixesAfterA = []string{"н"}
+
+	ukVerbSuffixes = []string{
+		"итимемо",
+		"итимете",
+		"итимуть",
+		"итимеш",
+		"тимемо",
+		"тимете",
+		"тимуть",
+		"итиме",
+		"итиму",
+		"тимеш",
+		"тиме",
+		"тиму",
+		"ать",
+		"емо",
+		"ете",
+		"ила",
+		"или",
+		"ило",
+		"имо",
+		"ите",
+		"ити",
+		"ить",
+		"ймо",
+		"йте",
+		"уть",
+		"ють",
+		"ять",
+		"ємо",
+		"єте",
+		"іла",
+		"іли",
+		"іло",
+		"імо",
+		"іти",
+		"іть",
+		"еш",
+		"ив",
+		"иш",
+		"ла",
+		"ли",
+		"ло",
+		"ти",
+		"ть",
+		"єш",
+		"ів",
+		"в",
+		"й",
+	}
+
+	// ukVerbSuffixesAfterA are the verb suffixes that must follow а or я.
+	// The slice is sorted for binary search.
+	ukVerbSuffixesAfterA = []string{
+		"в", "й", "ймо", "йте", "ла", "ли", "ло", "ти", "тиме", "тимемо", "тимете",
+		"тимеш", "тиму", "тимуть", "ть", "ємо", "єте", "єш",
+	}
+
+	ukNounSuffixes = []string{
+		"ією",
+		"ами",
+		"еві",
+		"ові",
+		"ями",
+		"єві",
+		"ам",
+		"ах",
+		"ей",
+		"ем",
+		"ею",
+		"ом",
+		"ою",
+		"ям",
+		"ях",
+		"єм",
+		"єю",
+		"ів",
+		"ій",
+		"ію",
+		"ія",
+		"ії",
+		"їв",
+		"а",
+		"е",
+		"и",
+		"й",
+		"о",
+		"у",
+		"ь",
+		"ю",
+		"я",
+		"є",
+		"і",
+		"ї",
+	}
+
+	ukDerivationalSuffixes = []string{"ість", "іст", "ост"}
+
+	// ukApostropheReplacer drops the apostrophe, which Ukrainian writes
+	// before я, ю, є and ї after a labial or р, in any of its forms.
+	ukApostropheReplacer = strings.NewReplacer("'", "", "’", "", "ʼ", "")
+)
+
+type UkrainianStemmer struct{}
+
+// NewUkrainianStemmer creates a new UkrainianStemmer.
+func NewUkrainianStemmer() *UkrainianStemmer {
+	return &UkrainianStemmer{}
+}
+
+// Stem returns the stem of the given word.
+func (s UkrainianStemmer) Stem(word string) string {
+	word = strings.ToLower(word)
+	if s.isStopWord(word) {
+		return word
+	}
+
+	word = ukApostropheReplacer.Replace(word)
+	rv, r2 := s.regions(word)
+
+	word = s.step1(word, rv)
+
+	// Step 2: remove a final и.
+	if strings.HasSuffix(region(word, rv), "и") {
+		word = word[:len(word)-len("и")]
+	}
+
+	// Step 3: remove a derivational suffix in R2.
+	if suffix := longestSuffix(region(word, r2), ukDerivationalSuffixes); suffix != "" {
+		word = word[:len(word)-len(suffix)]
+	}
+
+	return s.step4(word, rv)
+}
+
+// step1 removes a perfective gerund ending or, failing that, a reflexive
+// ending followed by an adjectival, verb or noun ending, all inside RV.
+func (s UkrainianStemmer) step1(word string, rv int) string {
+	if stem, ok := s.trimAfterA(word, rv, ukPerfectiveSuffixes, ukPerfectiveSuffixesAfterA); ok {
+		return stem
+	}
+
+	if suffix := longestSuffix(region(word, rv), ukReflexiveSuffixes); suffix != "" {
+		word = word[:len(word)-len(suffix)]
+	}
+
+	if suffix := longestSuffix(region(word, rv), ukAdjectiveSuffixes); suffix != "" {
+		word = word[:len(word)-len(suffix)]
+		if stem, ok := s.trimAfterA(word, rv, ukParticipleSuffixes, ukParticipleSuffixesAfterA); ok {
+			word = stem
+		}
+		return word
+	}
+
+	if stem, ok := s.trimAfterA(word, rv, ukVerbSuffixes, ukVerbSuffixesAfterA); ok {
+		return stem
+	}
+
+	if suffix := longestSuffix(region(word, rv), ukNounSuffixes); suffix != "" {
+		word = word[:len(word)-len(suffix)]
+	}
+	return word
+}
+
+// trimAfterA removes the longest of suffixes found in RV. Suffixes listed in
+// afterA are only removed when they follow а or я inside RV.
+func (s UkrainianStemmer) trimAfterA(word string, rv int, suffixes, afterA []string) (string, bool) {
+	suffix := longestSuffix(region(word, rv), suffixes)
+	if suffix == "" {
+		return word, false
+	}
+	stem := word[:len(word)-len(suffix)]
+
+	if _, found := slices.BinarySearch(afterA, suffix); found {
+		before := region(stem, rv)
+		if !strings.HasSuffix(before, "а") && !strings.HasSuffix(before, "я") {
+			return word, false
+		}
+	}
+	return stem, true
+}
+
+// step4 removes a final soft sign, or the comparative іш and then one н of
+// a final нн.
+func (s UkrainianStemmer) step4(word string, rv int) string {
+	if strings.HasSuffix(region(word, rv), "іш") {
+		word = word[:len(word)-len("іш")]
+	} else if strings.HasSuffix(region(word, rv), "ь") {
+		return word[:len(word)-len("ь")]
+	}
+
+	if strings.HasSuffix(region(word, rv), "нн") {
+		word = word[:len(word)-len("н")]
+	}
+	return word
+}
+
+// isStopWord returns true if the given word is a stop word.
+func (s UkrainianStemmer) isStopWord(word string) bool {
+	_, found := ukStopWords[word]
+	return found
+}
+
+func (s UkrainianStemmer) isVowel(r rune) bool {
+	switch r {
+	case 'а', 'е', 'є', 'и', 'і', 'ї', 'о', 'у', 'ю', 'я':
+		return true
+	default:
+		return false
+	}
+}
+
+// regions returns the byte offsets of RV and R2. RV is the region after the
+// first vowel.
+func (s UkrainianStemmer) regions(word string) (int, int) {
+	rv := len(word)
+	if i := strings.IndexFunc(word, s.isVowel); i >= 0 {
+		_, size := utf8.DecodeRuneInString(word[i:])
+		rv = i + size
+	}
+	_, r2 := standardRegions(word, s.isVowel)
+	return rv, r2
+}
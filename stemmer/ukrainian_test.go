@@ -0,0 +1,266 @@
+package stemmer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewUkrainianStemmer(t *testing.T) {
+	s := NewUkrainianStemmer()
+	require.NotNil(t, s)
+}
+
+func TestUkrainianStemmer_isStopWord(t *testing.T) {
+	s := NewUkrainianStemmer()
+	require.True(t, s.isStopWord("і"))
+	require.False(t, s.isStopWord("книга"))
+}
+
+func TestUkrainianStemmer_Stem(t *testing.T) {
+	s := NewUkrainianStemmer()
+	t.Run("stop word", func(t *testing.T) {
+		require.Equal(t, "та", s.Stem("та"))
+		require.Equal(t, "та", s.Stem("Та"))
+	})
+
+	t.Run("apostrophe", func(t *testing.T) {
+		require.Equal(t, s.Stem("сім'я"), s.Stem("сім’я"))
+		require.Equal(t, s.Stem("сім'я"), s.Stem("сімʼя"))
+	})
+
+	f := func(word, stem string) {
+		t.Helper()
+		require.Equal(t, stem, s.Stem(word))
+	}
+
+	f("книга", "книг")
+	f("книги", "книг")
+	f("книзі", "книз")
+	f("книгу", "книг")
+	f("книгою", "книг")
+	f("книжок", "книжок")
+	f("книгами", "книг")
+	f("книгах", "книг")
+	f("будинок", "будинок")
+	f("будинку", "будинк")
+	f("будинки", "будинк")
+	f("будинків", "будинк")
+	f("будинками", "будинк")
+	f("місто", "міст")
+	f("міста", "міст")
+	f("містом", "міст")
+	f("містах", "міст")
+	f("місті", "міст")
+	f("вода", "вод")
+	f("води", "вод")
+	f("воду", "вод")
+	f("водою", "вод")
+	f("людина", "людин")
+	f("людини", "людин")
+	f("людиною", "людин")
+	f("люди", "люд")
+	f("людей", "люд")
+	f("людям", "люд")
+	f("людьми", "людьм")
+	f("студент", "студент")
+	f("студента", "студент")
+	f("студентові", "студент")
+	f("студентом", "студент")
+	f("студенти", "студент")
+	f("студентів", "студент")
+	f("студентам", "студент")
+	f("студентами", "студент")
+	f("вчитель", "вчител")
+	f("вчителя", "вчител")
+	f("вчителеві", "вчител")
+	f("вчителем", "вчител")
+	f("вчителі", "вчител")
+	f("вчителів", "вчител")
+	f("станція", "станц")
+	f("станції", "станц")
+	f("станцію", "станц")
+	f("станцією", "станц")
+	f("станцій", "станц")
+	f("пісня", "пісн")
+	f("пісні", "пісн")
+	f("пісню", "пісн")
+	f("піснею", "пісн")
+	f("пісень", "пісен")
+	f("поле", "пол")
+	f("поля", "пол")
+	f("полем", "пол")
+	f("полів", "пол")
+	f("земля", "земл")
+	f("землі", "земл")
+	f("землю", "земл")
+	f("землею", "земл")
+	f("край", "кра")
+	f("краю", "кра")
+	f("краєм", "кра")
+	f("краї", "кра")
+	f("країна", "країн")
+	f("країни", "країн")
+	f("країною", "країн")
+	f("країн", "країн")
+	f("радість", "радіст")
+	f("радості", "радост")
+	f("радістю", "радіст")
+	f("можливість", "можлив")
+	f("можливості", "можлив")
+	f("можливостей", "можлив")
+	f("можливостями", "можлив")
+	f("новий", "нов")
+	f("нова", "нов")
+	f("нове", "нов")
+	f("нові", "нов")
+	f("нового", "нов")
+	f("новому", "нов")
+	f("новим", "нов")
+	f("новою", "нов")
+	f("нової", "нов")
+	f("нових", "нов")
+	f("новими", "нов")
+	f("новіший", "нов")
+	f("найновіший", "найнов")
+	f("синій", "син")
+	f("синя", "син")
+	f("синього", "син")
+	f("синьому", "син")
+	f("синіми", "син")
+	f("великий", "велик")
+	f("велика", "велик")
+	f("великого", "велик")
+	f("великих", "велик")
+	f("український", "українськ")
+	f("українська", "українськ")
+	f("української", "українськ")
+	f("українського", "українськ")
+	f("українських", "українськ")
+	f("прочитаний", "прочита")
+	f("прочитана", "прочитан")
+	f("прочитаного", "прочита")
+	f("зроблений", "зроблен")
+	f("зроблена", "зроблен")
+	f("керуючий", "керу")
+	f("читати", "чита")
+	f("читаю", "чита")
+	f("читаєш", "чита")
+	f("читає", "чита")
+	f("читаємо", "чита")
+	f("читаєте", "чита")
+	f("читають", "чита")
+	f("читав", "чита")
+	f("читала", "чита")
+	f("читало", "чита")
+	f("читали", "чита")
+	f("читай", "чита")
+	f("читайте", "чита")
+	f("читатиму", "чита")
+	f("читатимеш", "чита")
+	f("читатимуть", "чита")
+	f("читаючи", "чита")
+	f("прочитавши", "прочита")
+	f("робити", "роб")
+	f("роблю", "робл")
+	f("робиш", "роб")
+	f("робить", "роб")
+	f("робимо", "роб")
+	f("робите", "роб")
+	f("роблять", "робл")
+	f("робив", "роб")
+	f("робила", "роб")
+	f("робили", "роб")
+	f("роби", "роб")
+	f("робіть", "роб")
+	f("робитиму", "роб")
+	f("зробивши", "зроб")
+	f("роблячи", "робл")
+	f("писати", "писа")
+	f("пишу", "пиш")
+	f("пишеш", "пиш")
+	f("пише", "пиш")
+	f("пишемо", "пиш")
+	f("пишете", "пиш")
+	f("пишуть", "пиш")
+	f("писав", "писа")
+	f("писала", "писа")
+	f("сидіти", "сид")
+	f("сидів", "сид")
+	f("сиділа", "сид")
+	f("сиділи", "сид")
+	f("вчитися", "вчит")
+	f("вчуся", "вчу")
+	f("вчишся", "вчиш")
+	f("вчиться", "вчит")
+	f("вчилися", "вчил")
+	f("вчилась", "вчил")
+	f("навчання", "навчан")
+	f("навчанням", "навчан")
+	f("навчальний", "навчальн")
+	f("навчальна", "навчальн")
+	f("знання", "знан")
+	f("знаннями", "знан")
+	f("питання", "питан")
+	f("питань", "питан")
+	f("зустріч", "зустріч")
+	f("зустрічі", "зустріч")
+	f("зустрічами", "зустріч")
+	f("сім'я", "сім")
+	f("сім'ї", "сім")
+	f("сім’єю", "сім")
+	f("м'ясо", "мяс")
+	f("м'яса", "мяс")
+	f("п'ять", "пят")
+	f("ґанок", "ґанок")
+	f("ґанку", "ґанк")
+	f("Україна", "україн")
+	f("України", "україн")
+	f("Україною", "україн")
+	f("уряд", "уряд")
+	f("уряду", "уряд")
+	f("урядом", "уряд")
+	f("урядові", "уряд")
+	f("президент", "президент")
+	f("президента", "президент")
+	f("президентом", "президент")
+	f("новини", "новин")
+	f("новин", "новин")
+	f("новинами", "новин")
+	f("війна", "війн")
+	f("війни", "війн")
+	f("війну", "війн")
+	f("війною", "війн")
+	f("мир", "мир")
+	f("миру", "мир")
+	f("життя", "житт")
+	f("життям", "житт")
+	f("щастя", "щаст")
+	f("любов", "любов")
+	f("любові", "люб")
+	f("гарний", "гарн")
+	f("гарна", "гарн")
+	f("гарного", "гарн")
+	f("гарно", "гарн")
+	f("швидко", "швидк")
+	f("швидкий", "швидк")
+	f("швидкість", "швидкіст")
+	f("швидкості", "швидкост")
+}
+
+func TestUkrainianStemmer_regions(t *testing.T) {
+	s := NewUkrainianStemmer()
+
+	f := func(word, rv, r2 string) {
+		t.Helper()
+		rvStart, r2Start := s.regions(word)
+		require.Equal(t, rv, word[rvStart:])
+		require.Equal(t, r2, word[r2Start:])
+	}
+
+	f("можливість", "жливість", "ість")
+	f("читати", "тати", "и")
+	f("книга", "га", "")
+	f("вчуся", "ся", "")
+	f("сквер", "р", "")
+}
@@ -0,0 +1,69 @@
+package ugustemmer
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/machine23/ugu-stemmer/snowball/interp"
+)
+
+func TestNew(t *testing.T) {
+	s, err := New("en")
+	require.NoError(t, err)
+	require.Equal(t, "run", s.Stem("running"))
+
+	s, err = New("xx")
+	require.Nil(t, s)
+	var unsupported ErrUnsupportedLanguage
+	require.True(t, errors.As(err, &unsupported))
+	require.Equal(t, "xx", unsupported.Lang)
+}
+
+func TestNewSnowballStemmer(t *testing.T) {
+	s := NewSnowballStemmer("xx")
+	require.Nil(t, s)
+	require.Equal(t, "running", s.Stem("running"))
+}
+
+type upperStemmer struct{}
+
+func (upperStemmer) Stem(word string) string {
+	return strings.ToUpper(word)
+}
+
+func TestRegister(t *testing.T) {
+	Register("x-upper", func() Stemmer { return upperStemmer{} })
+	require.Contains(t, Languages(), "x-upper")
+
+	s, err := New("x-upper")
+	require.NoError(t, err)
+	require.Equal(t, "WORD", s.Stem("word"))
+
+	require.Panics(t, func() { Register("x-nil", nil) })
+}
+
+func TestRegister_interpreted(t *testing.T) {
+	program, err := interp.NewFromFile("snowball/algorithms/russian.sbl")
+	require.NoError(t, err)
+	Register("x-ru-sbl", func() Stemmer { return program })
+
+	s, err := New("x-ru-sbl")
+	require.NoError(t, err)
+	require.Equal(t, "красив", s.Stem("красивейшими"))
+}
+
+func TestLanguages(t *testing.T) {
+	langs := Languages()
+	require.Contains(t, langs, "en")
+	require.Contains(t, langs, "ru")
+	require.IsNonDecreasing(t, langs)
+}
+
+func TestNew_turkishCase(t *testing.T) {
+	s, err := New("tr")
+	require.NoError(t, err)
+	require.Equal(t, "ışık", s.Stem("IŞIKLAR"))
+}